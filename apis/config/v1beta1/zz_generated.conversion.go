@@ -438,6 +438,8 @@ func autoConvert_v1beta2_Configuration_To_v1beta1_Configuration(in *v1beta2.Conf
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.ObjectRetentionPolicies = (*ObjectRetentionPolicies)(unsafe.Pointer(in.ObjectRetentionPolicies))
 	// WARNING: in.VisibilityServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdmissionDecisionLog requires manual conversion: does not exist in peer-type
+	// WARNING: in.TASDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -739,6 +741,9 @@ func autoConvert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in *v1beta2.MultiKueue
 	out.ExternalFrameworks = *(*[]MultiKueueExternalFramework)(unsafe.Pointer(&in.ExternalFrameworks))
 	// WARNING: in.ClusterProfile requires manual conversion: does not exist in peer-type
 	// WARNING: in.IncrementalDispatcherConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.CapacityAwareDispatcherConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.MigrationCooldown requires manual conversion: does not exist in peer-type
+	// WARNING: in.ClusterHealth requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -112,6 +112,38 @@ type Configuration struct {
 	// VisibilityServer configures the visibility server.
 	// +optional
 	VisibilityServer *VisibilityServerConfiguration `json:"visibilityServer,omitempty"`
+
+	// AdmissionDecisionLog configures a structured record of every admission
+	// attempt made by the scheduler. A nil value disables the log.
+	// +optional
+	AdmissionDecisionLog *AdmissionDecisionLog `json:"admissionDecisionLog,omitempty"`
+
+	// TASDefragmentation configures the evictions of low-priority workloads
+	// which free whole topology domains for pending workloads requiring a
+	// topology level. It is used when the TASDefragmentation feature gate
+	// is enabled.
+	// +optional
+	TASDefragmentation *TASDefragmentation `json:"tasDefragmentation,omitempty"`
+}
+
+// TASDefragmentation configures the defragmentation of topology domains.
+type TASDefragmentation struct {
+	// Interval is the period between the defragmentation rounds.
+	// Defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// MaxEvictionsPerRound is the maximum number of workloads evicted in a
+	// single defragmentation round.
+	// Defaults to 5.
+	// +optional
+	MaxEvictionsPerRound *int32 `json:"maxEvictionsPerRound,omitempty"`
+
+	// MaxVictimPriority is the highest priority of the workloads which can be
+	// evicted. When not set, any workload with a priority lower than the
+	// pending workload can be evicted.
+	// +optional
+	MaxVictimPriority *int32 `json:"maxVictimPriority,omitempty"`
 }
 
 type ControllerManager struct {
@@ -371,6 +403,59 @@ type MultiKueue struct {
 	// Note: This field is going to be ignored when the MultiKueueIncrementalDispatcherConfig feature gate is disabled.
 	// +optional
 	IncrementalDispatcherConfig *IncrementalDispatcherConfig `json:"incrementalDispatcherConfig,omitempty"`
+
+	// CapacityAwareDispatcherConfig contains the configuration for the capacity-aware dispatcher.
+	// This field is only valid when DispatcherName is set to the capacity-aware dispatcher.
+	// +optional
+	CapacityAwareDispatcherConfig *CapacityAwareDispatcherConfig `json:"capacityAwareDispatcherConfig,omitempty"`
+
+	// MigrationCooldown defines the time a worker cluster is excluded from the
+	// nomination of a workload after it evicted the workload.
+	// Only used when the MultiKueueWorkloadMigration feature gate is enabled.
+	//
+	// Defaults to 10 minutes.
+	// +optional
+	MigrationCooldown *metav1.Duration `json:"migrationCooldown,omitempty"`
+
+	// ClusterHealth contains the configuration for tracking the health of the
+	// worker clusters, and the thresholds above which they are cordoned.
+	// Only used when the MultiKueueClusterHealth feature gate is enabled.
+	// +optional
+	ClusterHealth *ClusterHealthConfig `json:"clusterHealth,omitempty"`
+}
+
+// ClusterHealthConfig holds configuration for tracking the health of the
+// MultiKueue worker clusters.
+// A worker cluster crossing any of the thresholds is cordoned, meaning that
+// no new workloads are dispatched to it, until its health recovers.
+// If no threshold is set, worker clusters are only cordoned manually.
+type ClusterHealthConfig struct {
+	// Window defines the time during which the dispatches, admissions and
+	// evictions are taken into account.
+	// Defaults to 1 hour.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// MinSamples defines the number of dispatches, or admissions, observed in
+	// the window before the corresponding thresholds are checked.
+	// Minimum value is 1. If not set, it defaults to 10.
+	// +optional
+	MinSamples *int32 `json:"minSamples,omitempty"`
+
+	// MinDispatchSuccessPercent defines the lowest acceptable percentage
+	// of workloads successfully created in the worker cluster.
+	// +optional
+	MinDispatchSuccessPercent *int32 `json:"minDispatchSuccessPercent,omitempty"`
+
+	// MaxAverageAdmissionLatency defines the highest acceptable average time
+	// between the creation of a workload in the worker cluster and its admission.
+	// +optional
+	MaxAverageAdmissionLatency *metav1.Duration `json:"maxAverageAdmissionLatency,omitempty"`
+
+	// MaxEvictionPercent defines the highest acceptable percentage of the
+	// admitted workloads evicted by the worker cluster.
+	// +optional
+	MaxEvictionPercent *int32 `json:"maxEvictionPercent,omitempty"`
 }
 
 // IncrementalDispatcherConfig holds configuration for the MultiKueue Incremental Dispatcher.
@@ -382,6 +467,15 @@ type IncrementalDispatcherConfig struct {
 	StepSize *int32 `json:"stepSize,omitempty"`
 }
 
+// CapacityAwareDispatcherConfig holds configuration for the MultiKueue Capacity-Aware Dispatcher.
+type CapacityAwareDispatcherConfig struct {
+	// MaxNominatedClusters defines the number of best scored worker clusters
+	// the Capacity-Aware Dispatcher nominates in every round.
+	// Minimum value is 1. If not set, it defaults to 2.
+	// +optional
+	MaxNominatedClusters *int32 `json:"maxNominatedClusters,omitempty"`
+}
+
 // MultiKueueExternalFramework defines a framework that is not built-in.
 type MultiKueueExternalFramework struct {
 	// Name is the GVK of the resource that are
@@ -398,6 +492,11 @@ const (
 	// MultiKueueDispatcherModeIncremental is the name of dispatcher mode where worker clusters are incrementally added to the pool of nominated clusters.
 	// The process begins with up to 3 initial clusters and expands the pool by up to 3 clusters at a time (if fewer remain, all are added).
 	MultiKueueDispatcherModeIncremental = "kueue.x-k8s.io/multikueue-dispatcher-incremental"
+
+	// MultiKueueDispatcherModeCapacityAware is the name of dispatcher mode where worker clusters are scored by the free quota
+	// and the pending workloads of their ClusterQueue, and only the best scored clusters are nominated.
+	// Requires enabling the MultiKueueCapacityAwareDispatcher feature gate.
+	MultiKueueDispatcherModeCapacityAware = "kueue.x-k8s.io/multikueue-dispatcher-capacity-aware"
 )
 
 type RequeuingStrategy struct {
@@ -822,3 +921,13 @@ type VisibilityServerConfiguration struct {
 	// +optional
 	BindPort *int32 `json:"bindPort,omitempty"`
 }
+
+// AdmissionDecisionLog configures the sink for admission decision records.
+type AdmissionDecisionLog struct {
+	// Path is the absolute path of a local file to which the scheduler appends
+	// one JSON object per line for every workload evaluated in a scheduling
+	// cycle. Each record contains the workload, the flavors tried, the
+	// borrowing decision, the preemption targets and the final outcome.
+	// The file is created if it doesn't exist.
+	Path string `json:"path"`
+}
@@ -141,6 +141,10 @@ func SetDefaults_Configuration(cfg *Configuration) {
 		cfg.MultiKueue.IncrementalDispatcherConfig = cmp.Or(cfg.MultiKueue.IncrementalDispatcherConfig, &IncrementalDispatcherConfig{})
 		cfg.MultiKueue.IncrementalDispatcherConfig.StepSize = cmp.Or(cfg.MultiKueue.IncrementalDispatcherConfig.StepSize, new(int32(3)))
 	}
+	if ptr.Deref(cfg.MultiKueue.DispatcherName, "") == MultiKueueDispatcherModeCapacityAware {
+		cfg.MultiKueue.CapacityAwareDispatcherConfig = cmp.Or(cfg.MultiKueue.CapacityAwareDispatcherConfig, &CapacityAwareDispatcherConfig{})
+		cfg.MultiKueue.CapacityAwareDispatcherConfig.MaxNominatedClusters = cmp.Or(cfg.MultiKueue.CapacityAwareDispatcherConfig.MaxNominatedClusters, new(int32(2)))
+	}
 
 	if afs := cfg.AdmissionFairSharing; afs != nil {
 		afs.UsageSamplingInterval.Duration = cmp.Or(afs.UsageSamplingInterval.Duration, 5*time.Minute)
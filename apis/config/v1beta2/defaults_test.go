@@ -592,6 +592,40 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				WaitForPodsReady:             defaultWaitForPodsReady,
 			},
 		},
+		"multiKueue with capacity-aware dispatcher": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: new(false),
+				},
+				MultiKueue: &MultiKueue{
+					GCInterval:        &metav1.Duration{Duration: time.Second},
+					Origin:            new("multikueue-manager1"),
+					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
+					DispatcherName:    new(MultiKueueDispatcherModeCapacityAware),
+				},
+			},
+			want: &Configuration{
+				Namespace:         new(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: new(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations:     defaultIntegrations,
+				MultiKueue: &MultiKueue{
+					GCInterval:        &metav1.Duration{Duration: time.Second},
+					Origin:            new("multikueue-manager1"),
+					WorkerLostTimeout: &metav1.Duration{Duration: time.Minute},
+					DispatcherName:    new(MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &CapacityAwareDispatcherConfig{
+						MaxNominatedClusters: new(int32(2)),
+					},
+				},
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				VisibilityServer:             defaultVisibilityServer,
+				WaitForPodsReady:             defaultWaitForPodsReady,
+			},
+		},
 		"multiKueue origin is an empty value": {
 			original: &Configuration{
 				InternalCertManagement: &InternalCertManagement{
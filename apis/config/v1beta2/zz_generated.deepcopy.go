@@ -28,6 +28,21 @@ import (
 	timex "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionDecisionLog) DeepCopyInto(out *AdmissionDecisionLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionDecisionLog.
+func (in *AdmissionDecisionLog) DeepCopy() *AdmissionDecisionLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionDecisionLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdmissionFairSharing) DeepCopyInto(out *AdmissionFairSharing) {
 	*out = *in
@@ -52,6 +67,26 @@ func (in *AdmissionFairSharing) DeepCopy() *AdmissionFairSharing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAwareDispatcherConfig) DeepCopyInto(out *CapacityAwareDispatcherConfig) {
+	*out = *in
+	if in.MaxNominatedClusters != nil {
+		in, out := &in.MaxNominatedClusters, &out.MaxNominatedClusters
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAwareDispatcherConfig.
+func (in *CapacityAwareDispatcherConfig) DeepCopy() *CapacityAwareDispatcherConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAwareDispatcherConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -77,6 +112,46 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthConfig) DeepCopyInto(out *ClusterHealthConfig) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinSamples != nil {
+		in, out := &in.MinSamples, &out.MinSamples
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinDispatchSuccessPercent != nil {
+		in, out := &in.MinDispatchSuccessPercent, &out.MinDispatchSuccessPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAverageAdmissionLatency != nil {
+		in, out := &in.MaxAverageAdmissionLatency, &out.MaxAverageAdmissionLatency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEvictionPercent != nil {
+		in, out := &in.MaxEvictionPercent, &out.MaxEvictionPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthConfig.
+func (in *ClusterHealthConfig) DeepCopy() *ClusterHealthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProfile) DeepCopyInto(out *ClusterProfile) {
 	*out = *in
@@ -194,6 +269,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(VisibilityServerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdmissionDecisionLog != nil {
+		in, out := &in.AdmissionDecisionLog, &out.AdmissionDecisionLog
+		*out = new(AdmissionDecisionLog)
+		**out = **in
+	}
+	if in.TASDefragmentation != nil {
+		in, out := &in.TASDefragmentation, &out.TASDefragmentation
+		*out = new(TASDefragmentation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -603,6 +688,21 @@ func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 		*out = new(IncrementalDispatcherConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityAwareDispatcherConfig != nil {
+		in, out := &in.CapacityAwareDispatcherConfig, &out.CapacityAwareDispatcherConfig
+		*out = new(CapacityAwareDispatcherConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MigrationCooldown != nil {
+		in, out := &in.MigrationCooldown, &out.MigrationCooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterHealth != nil {
+		in, out := &in.ClusterHealth, &out.ClusterHealth
+		*out = new(ClusterHealthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueue.
@@ -751,6 +851,36 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TASDefragmentation) DeepCopyInto(out *TASDefragmentation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEvictionsPerRound != nil {
+		in, out := &in.MaxEvictionsPerRound, &out.MaxEvictionsPerRound
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxVictimPriority != nil {
+		in, out := &in.MaxVictimPriority, &out.MaxVictimPriority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TASDefragmentation.
+func (in *TASDefragmentation) DeepCopy() *TASDefragmentation {
+	if in == nil {
+		return nil
+	}
+	out := new(TASDefragmentation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSOptions) DeepCopyInto(out *TLSOptions) {
 	*out = *in
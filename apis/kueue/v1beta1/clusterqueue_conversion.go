@@ -76,9 +76,30 @@ func Convert_v1beta1_ClusterQueueSpec_To_v1beta2_ClusterQueueSpec(in *ClusterQue
 
 func Convert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(in *v1beta2.ClusterQueueSpec, out *ClusterQueueSpec, s conversionapi.Scope) error {
 	out.Cohort = CohortReference(in.CohortName)
+	// LocalQueueTemplate is intentionally dropped during conversion to v1beta1
+	// as it has no equivalent field.
 	return autoConvert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(in, out, s)
 }
 
 func Convert_v1beta1_ClusterQueueStatus_To_v1beta2_ClusterQueueStatus(in *ClusterQueueStatus, out *v1beta2.ClusterQueueStatus, s conversionapi.Scope) error {
 	return autoConvert_v1beta1_ClusterQueueStatus_To_v1beta2_ClusterQueueStatus(in, out, s)
 }
+
+func Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(in *v1beta2.ResourceQuota, out *ResourceQuota, s conversionapi.Scope) error {
+	// Schedules is intentionally dropped during conversion to v1beta1
+	// as it has no equivalent field.
+	return autoConvert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(in, out, s)
+}
+
+func Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(in *v1beta2.ClusterQueuePreemption, out *ClusterQueuePreemption, s conversionapi.Scope) error {
+	// PreemptionBudget, MinimumRuntimeSeconds, MinimumRuntimeExemption and
+	// PriorityDecay are intentionally dropped during conversion to v1beta1 as
+	// they have no equivalent fields.
+	return autoConvert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(in, out, s)
+}
+
+func Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in *v1beta2.ClusterQueueStatus, out *ClusterQueueStatus, s conversionapi.Scope) error {
+	// PreemptionBudget is intentionally dropped during conversion to v1beta1
+	// as it has no equivalent field.
+	return autoConvert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in, out, s)
+}
@@ -45,6 +45,8 @@ func Convert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(in *v1beta2.Lo
 			}
 		}
 	}
+	// Budget and ResourceRecommendations are intentionally dropped during
+	// conversion to v1beta1 as they have no equivalent field.
 	return autoConvert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(in, out, s)
 }
 
@@ -59,3 +61,9 @@ func Convert_v1beta1_LocalQueueStatus_To_v1beta2_LocalQueueStatus(in *LocalQueue
 	}
 	return autoConvert_v1beta1_LocalQueueStatus_To_v1beta2_LocalQueueStatus(in, out, s)
 }
+
+func Convert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(in *v1beta2.LocalQueueSpec, out *LocalQueueSpec, s conversionapi.Scope) error {
+	// Budget is intentionally dropped during conversion to v1beta1
+	// as it has no equivalent field.
+	return autoConvert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(in, out, s)
+}
@@ -48,9 +48,17 @@ func Convert_v1beta2_MultiKueueClusterSpec_To_v1beta1_MultiKueueClusterSpec(in *
 			Name: in.ClusterSource.ClusterProfileRef.Name,
 		}
 	}
+	// Cordoned is intentionally dropped during conversion to v1beta1 as it has
+	// no equivalent field.
 	return autoConvert_v1beta2_MultiKueueClusterSpec_To_v1beta1_MultiKueueClusterSpec(in, out, s)
 }
 
+func Convert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in *v1beta2.MultiKueueClusterStatus, out *MultiKueueClusterStatus, s conversionapi.Scope) error {
+	// Health is intentionally dropped during conversion to v1beta1 as it has
+	// no equivalent field.
+	return autoConvert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in, out, s)
+}
+
 func Convert_v1beta1_MultiKueueClusterSpec_To_v1beta2_MultiKueueClusterSpec(in *MultiKueueClusterSpec, out *v1beta2.MultiKueueClusterSpec, s conversionapi.Scope) error {
 	if in.KubeConfig.Location != "" {
 		out.ClusterSource = v1beta2.ClusterSource{
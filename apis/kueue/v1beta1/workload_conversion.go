@@ -17,6 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
 	conversionapi "k8s.io/apimachinery/pkg/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
@@ -27,18 +31,84 @@ import (
 //lint:file-ignore ST1003 "generated Convert_* calls below use underscores"
 //revive:disable:var-naming
 
+// PodSetAssignmentClusterQueuesAnnotation keeps the ClusterQueues charged for
+// the PodSets of a multi-queue admission, keyed by PodSet name, as v1beta1
+// has no field to hold them. It is only set on v1beta1 objects, so that status
+// writes through v1beta1 don't move the usage back to the primary ClusterQueue.
+const PodSetAssignmentClusterQueuesAnnotation = "kueue.x-k8s.io/podset-assignment-cluster-queues"
+
 func (src *Workload) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1beta2.Workload)
-	return Convert_v1beta1_Workload_To_v1beta2_Workload(src, dst, nil)
+	if err := Convert_v1beta1_Workload_To_v1beta2_Workload(src, dst, nil); err != nil {
+		return err
+	}
+	return restorePodSetAssignmentClusterQueues(dst)
 }
 
 func (dst *Workload) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1beta2.Workload)
-	return Convert_v1beta2_Workload_To_v1beta1_Workload(src, dst, nil)
+	if err := Convert_v1beta2_Workload_To_v1beta1_Workload(src, dst, nil); err != nil {
+		return err
+	}
+	return preservePodSetAssignmentClusterQueues(src, dst)
+}
+
+func preservePodSetAssignmentClusterQueues(src *v1beta2.Workload, dst *Workload) error {
+	clusterQueues := make(map[v1beta2.PodSetReference]v1beta2.ClusterQueueReference)
+	if src.Status.Admission != nil {
+		for _, psa := range src.Status.Admission.PodSetAssignments {
+			if psa.ClusterQueue != nil {
+				clusterQueues[psa.Name] = *psa.ClusterQueue
+			}
+		}
+	}
+	if len(clusterQueues) == 0 {
+		return nil
+	}
+	value, err := json.Marshal(clusterQueues)
+	if err != nil {
+		return err
+	}
+	// The annotations map is shared with the source object.
+	dst.Annotations = maps.Clone(dst.Annotations)
+	if dst.Annotations == nil {
+		dst.Annotations = make(map[string]string, 1)
+	}
+	dst.Annotations[PodSetAssignmentClusterQueuesAnnotation] = string(value)
+	return nil
+}
+
+func restorePodSetAssignmentClusterQueues(dst *v1beta2.Workload) error {
+	value, found := dst.Annotations[PodSetAssignmentClusterQueuesAnnotation]
+	if !found {
+		return nil
+	}
+	// The annotations map is shared with the source object.
+	dst.Annotations = maps.Clone(dst.Annotations)
+	delete(dst.Annotations, PodSetAssignmentClusterQueuesAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+	var clusterQueues map[v1beta2.PodSetReference]v1beta2.ClusterQueueReference
+	if err := json.Unmarshal([]byte(value), &clusterQueues); err != nil {
+		return fmt.Errorf("parsing the %s annotation: %w", PodSetAssignmentClusterQueuesAnnotation, err)
+	}
+	if dst.Status.Admission == nil {
+		return nil
+	}
+	for i := range dst.Status.Admission.PodSetAssignments {
+		psa := &dst.Status.Admission.PodSetAssignments[i]
+		if cq, found := clusterQueues[psa.Name]; found {
+			psa.ClusterQueue = &cq
+		}
+	}
+	return nil
 }
 
 func Convert_v1beta2_WorkloadStatus_To_v1beta1_WorkloadStatus(in *v1beta2.WorkloadStatus, out *WorkloadStatus, s conversionapi.Scope) error {
 	out.AccumulatedPastExexcutionTimeSeconds = in.AccumulatedPastExecutionTimeSeconds
+	// ResourceRecommendations and MigrationHistory are intentionally dropped
+	// during conversion to v1beta1 as they have no equivalent field.
 	return autoConvert_v1beta2_WorkloadStatus_To_v1beta1_WorkloadStatus(in, out, s)
 }
 
@@ -77,6 +147,18 @@ func Convert_v1beta2_PodSetTopologyRequest_To_v1beta1_PodSetTopologyRequest(in *
 	return autoConvert_v1beta2_PodSetTopologyRequest_To_v1beta1_PodSetTopologyRequest(in, out, s)
 }
 
+func Convert_v1beta2_PodSet_To_v1beta1_PodSet(in *v1beta2.PodSet, out *PodSet, s conversionapi.Scope) error {
+	// QueueName is intentionally dropped during conversion to v1beta1
+	// as it has no equivalent field.
+	return autoConvert_v1beta2_PodSet_To_v1beta1_PodSet(in, out, s)
+}
+
+func Convert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(in *v1beta2.PodSetAssignment, out *PodSetAssignment, s conversionapi.Scope) error {
+	// ClusterQueue has no equivalent field in v1beta1; it is kept in the
+	// PodSetAssignmentClusterQueuesAnnotation of the Workload instead.
+	return autoConvert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(in, out, s)
+}
+
 func Convert_v1beta1_TopologyAssignment_To_v1beta2_TopologyAssignment(in *TopologyAssignment, out *v1beta2.TopologyAssignment, s conversionapi.Scope) error {
 	ta := &tas.TopologyAssignment{
 		Levels:  in.Levels,
@@ -610,3 +610,47 @@ func TestWorkloadConversion_RoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadConversion_PodSetAssignmentClusterQueues(t *testing.T) {
+	secondaryCQ := v1beta2.ClusterQueueReference("secondary")
+	v1beta2Obj := &v1beta2.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-workload",
+			Namespace:   "default",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+		Status: v1beta2.WorkloadStatus{
+			Admission: &v1beta2.Admission{
+				ClusterQueue: "primary",
+				PodSetAssignments: []v1beta2.PodSetAssignment{
+					{Name: "driver"},
+					{Name: "workers", ClusterQueue: &secondaryCQ},
+				},
+			},
+		},
+	}
+	original := v1beta2Obj.DeepCopy()
+
+	v1beta1Obj := &Workload{}
+	if err := v1beta1Obj.ConvertFrom(v1beta2Obj); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	wantAnnotations := map[string]string{
+		"foo":                                   "bar",
+		PodSetAssignmentClusterQueuesAnnotation: `{"workers":"secondary"}`,
+	}
+	if diff := cmp.Diff(wantAnnotations, v1beta1Obj.Annotations); diff != "" {
+		t.Errorf("unexpected annotations (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(original, v1beta2Obj); diff != "" {
+		t.Errorf("ConvertFrom modified the source object (-want +got):\n%s", diff)
+	}
+
+	roundTripped := &v1beta2.Workload{}
+	if err := v1beta1Obj.ConvertTo(roundTripped); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if diff := cmp.Diff(original, roundTripped); diff != "" {
+		t.Errorf("round-trip conversion produced diff (-original +roundtripped):\n%s", diff)
+	}
+}
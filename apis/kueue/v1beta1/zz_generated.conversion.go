@@ -179,16 +179,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterQueuePreemption)(nil), (*ClusterQueuePreemption)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(a.(*v1beta2.ClusterQueuePreemption), b.(*ClusterQueuePreemption), scope)
-	}); err != nil {
-		return err
-	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterQueueStatus)(nil), (*ClusterQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(a.(*v1beta2.ClusterQueueStatus), b.(*ClusterQueueStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Cohort)(nil), (*v1beta2.Cohort)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Cohort_To_v1beta2_Cohort(a.(*Cohort), b.(*v1beta2.Cohort), scope)
 	}); err != nil {
@@ -329,11 +319,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.LocalQueueSpec)(nil), (*LocalQueueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(a.(*v1beta2.LocalQueueSpec), b.(*LocalQueueSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MultiKueueCluster)(nil), (*v1beta2.MultiKueueCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MultiKueueCluster_To_v1beta2_MultiKueueCluster(a.(*MultiKueueCluster), b.(*v1beta2.MultiKueueCluster), scope)
 	}); err != nil {
@@ -359,11 +344,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.MultiKueueClusterStatus)(nil), (*MultiKueueClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(a.(*v1beta2.MultiKueueClusterStatus), b.(*MultiKueueClusterStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MultiKueueConfig)(nil), (*v1beta2.MultiKueueConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MultiKueueConfig_To_v1beta2_MultiKueueConfig(a.(*MultiKueueConfig), b.(*v1beta2.MultiKueueConfig), scope)
 	}); err != nil {
@@ -394,21 +374,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.PodSet)(nil), (*PodSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_PodSet_To_v1beta1_PodSet(a.(*v1beta2.PodSet), b.(*PodSet), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PodSetAssignment)(nil), (*v1beta2.PodSetAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PodSetAssignment_To_v1beta2_PodSetAssignment(a.(*PodSetAssignment), b.(*v1beta2.PodSetAssignment), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.PodSetAssignment)(nil), (*PodSetAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(a.(*v1beta2.PodSetAssignment), b.(*PodSetAssignment), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PodSetRequest)(nil), (*v1beta2.PodSetRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PodSetRequest_To_v1beta2_PodSetRequest(a.(*PodSetRequest), b.(*v1beta2.PodSetRequest), scope)
 	}); err != nil {
@@ -539,11 +509,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResourceFlavorSpec)(nil), (*ResourceFlavorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(a.(*v1beta2.ResourceFlavorSpec), b.(*ResourceFlavorSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*v1beta2.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(a.(*ResourceGroup), b.(*v1beta2.ResourceGroup), scope)
 	}); err != nil {
@@ -559,11 +524,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResourceQuota)(nil), (*ResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(a.(*v1beta2.ResourceQuota), b.(*ResourceQuota), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceUsage)(nil), (*v1beta2.ResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceUsage_To_v1beta2_ResourceUsage(a.(*ResourceUsage), b.(*v1beta2.ResourceUsage), scope)
 	}); err != nil {
@@ -609,11 +569,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.TopologyLevel)(nil), (*TopologyLevel)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(a.(*v1beta2.TopologyLevel), b.(*TopologyLevel), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TopologyList)(nil), (*v1beta2.TopologyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_TopologyList_To_v1beta2_TopologyList(a.(*TopologyList), b.(*v1beta2.TopologyList), scope)
 	}); err != nil {
@@ -739,11 +694,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterQueuePreemption)(nil), (*ClusterQueuePreemption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(a.(*v1beta2.ClusterQueuePreemption), b.(*ClusterQueuePreemption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ClusterQueueSpec)(nil), (*ClusterQueueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(a.(*v1beta2.ClusterQueueSpec), b.(*ClusterQueueSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterQueueStatus)(nil), (*ClusterQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(a.(*v1beta2.ClusterQueueStatus), b.(*ClusterQueueStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.LocalQueueSpec)(nil), (*LocalQueueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(a.(*v1beta2.LocalQueueSpec), b.(*LocalQueueSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.LocalQueueStatus)(nil), (*LocalQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(a.(*v1beta2.LocalQueueStatus), b.(*LocalQueueStatus), scope)
 	}); err != nil {
@@ -754,21 +724,51 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.MultiKueueClusterStatus)(nil), (*MultiKueueClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(a.(*v1beta2.MultiKueueClusterStatus), b.(*MultiKueueClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.MultiKueueConfigSpec)(nil), (*MultiKueueConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_MultiKueueConfigSpec_To_v1beta1_MultiKueueConfigSpec(a.(*v1beta2.MultiKueueConfigSpec), b.(*MultiKueueConfigSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.PodSetAssignment)(nil), (*PodSetAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(a.(*v1beta2.PodSetAssignment), b.(*PodSetAssignment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.PodSetTopologyRequest)(nil), (*PodSetTopologyRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_PodSetTopologyRequest_To_v1beta1_PodSetTopologyRequest(a.(*v1beta2.PodSetTopologyRequest), b.(*PodSetTopologyRequest), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.PodSet)(nil), (*PodSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PodSet_To_v1beta1_PodSet(a.(*v1beta2.PodSet), b.(*PodSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ResourceFlavorSpec)(nil), (*ResourceFlavorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(a.(*v1beta2.ResourceFlavorSpec), b.(*ResourceFlavorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ResourceQuota)(nil), (*ResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(a.(*v1beta2.ResourceQuota), b.(*ResourceQuota), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.TopologyAssignment)(nil), (*TopologyAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_TopologyAssignment_To_v1beta1_TopologyAssignment(a.(*v1beta2.TopologyAssignment), b.(*TopologyAssignment), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.TopologyLevel)(nil), (*TopologyLevel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(a.(*v1beta2.TopologyLevel), b.(*TopologyLevel), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.WorkloadSpec)(nil), (*WorkloadSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_WorkloadSpec_To_v1beta1_WorkloadSpec(a.(*v1beta2.WorkloadSpec), b.(*WorkloadSpec), scope)
 	}); err != nil {
@@ -1186,21 +1186,38 @@ func autoConvert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemptio
 	out.ReclaimWithinCohort = PreemptionPolicy(in.ReclaimWithinCohort)
 	out.BorrowWithinCohort = (*BorrowWithinCohort)(unsafe.Pointer(in.BorrowWithinCohort))
 	out.WithinClusterQueue = PreemptionPolicy(in.WithinClusterQueue)
+	// WARNING: in.PreemptionBudget requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinimumRuntimeSeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinimumRuntimeExemption requires manual conversion: does not exist in peer-type
+	// WARNING: in.PriorityDecay requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption is an autogenerated conversion function.
-func Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(in *v1beta2.ClusterQueuePreemption, out *ClusterQueuePreemption, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(in, out, s)
-}
-
 func autoConvert_v1beta1_ClusterQueueSpec_To_v1beta2_ClusterQueueSpec(in *ClusterQueueSpec, out *v1beta2.ClusterQueueSpec, s conversion.Scope) error {
-	out.ResourceGroups = *(*[]v1beta2.ResourceGroup)(unsafe.Pointer(&in.ResourceGroups))
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]v1beta2.ResourceGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceGroups = nil
+	}
 	// WARNING: in.Cohort requires manual conversion: does not exist in peer-type
 	out.QueueingStrategy = v1beta2.QueueingStrategy(in.QueueingStrategy)
 	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	out.FlavorFungibility = (*v1beta2.FlavorFungibility)(unsafe.Pointer(in.FlavorFungibility))
-	out.Preemption = (*v1beta2.ClusterQueuePreemption)(unsafe.Pointer(in.Preemption))
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(v1beta2.ClusterQueuePreemption)
+		if err := Convert_v1beta1_ClusterQueuePreemption_To_v1beta2_ClusterQueuePreemption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Preemption = nil
+	}
 	// WARNING: in.AdmissionChecks requires manual conversion: does not exist in peer-type
 	out.AdmissionChecksStrategy = (*v1beta2.AdmissionChecksStrategy)(unsafe.Pointer(in.AdmissionChecksStrategy))
 	out.StopPolicy = (*v1beta2.StopPolicy)(unsafe.Pointer(in.StopPolicy))
@@ -1210,17 +1227,36 @@ func autoConvert_v1beta1_ClusterQueueSpec_To_v1beta2_ClusterQueueSpec(in *Cluste
 }
 
 func autoConvert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(in *v1beta2.ClusterQueueSpec, out *ClusterQueueSpec, s conversion.Scope) error {
-	out.ResourceGroups = *(*[]ResourceGroup)(unsafe.Pointer(&in.ResourceGroups))
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceGroup_To_v1beta1_ResourceGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceGroups = nil
+	}
 	// WARNING: in.CohortName requires manual conversion: does not exist in peer-type
 	out.QueueingStrategy = QueueingStrategy(in.QueueingStrategy)
 	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	out.FlavorFungibility = (*FlavorFungibility)(unsafe.Pointer(in.FlavorFungibility))
-	out.Preemption = (*ClusterQueuePreemption)(unsafe.Pointer(in.Preemption))
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(ClusterQueuePreemption)
+		if err := Convert_v1beta2_ClusterQueuePreemption_To_v1beta1_ClusterQueuePreemption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Preemption = nil
+	}
 	out.AdmissionChecksStrategy = (*AdmissionChecksStrategy)(unsafe.Pointer(in.AdmissionChecksStrategy))
 	out.StopPolicy = (*StopPolicy)(unsafe.Pointer(in.StopPolicy))
 	out.FairSharing = (*FairSharing)(unsafe.Pointer(in.FairSharing))
 	out.AdmissionScope = (*AdmissionScope)(unsafe.Pointer(in.AdmissionScope))
 	// WARNING: in.ConcurrentAdmissionPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.LocalQueueTemplate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1260,14 +1296,10 @@ func autoConvert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in *v1
 	} else {
 		out.FairSharing = nil
 	}
+	// WARNING: in.PreemptionBudget requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus is an autogenerated conversion function.
-func Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in *v1beta2.ClusterQueueStatus, out *ClusterQueueStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_Cohort_To_v1beta2_Cohort(in *Cohort, out *v1beta2.Cohort, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CohortSpec_To_v1beta2_CohortSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1344,7 +1376,17 @@ func Convert_v1beta2_CohortList_To_v1beta1_CohortList(in *v1beta2.CohortList, ou
 
 func autoConvert_v1beta1_CohortSpec_To_v1beta2_CohortSpec(in *CohortSpec, out *v1beta2.CohortSpec, s conversion.Scope) error {
 	out.ParentName = v1beta2.CohortReference(in.ParentName)
-	out.ResourceGroups = *(*[]v1beta2.ResourceGroup)(unsafe.Pointer(&in.ResourceGroups))
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]v1beta2.ResourceGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceGroups = nil
+	}
 	out.FairSharing = (*v1beta2.FairSharing)(unsafe.Pointer(in.FairSharing))
 	return nil
 }
@@ -1356,7 +1398,17 @@ func Convert_v1beta1_CohortSpec_To_v1beta2_CohortSpec(in *CohortSpec, out *v1bet
 
 func autoConvert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in *v1beta2.CohortSpec, out *CohortSpec, s conversion.Scope) error {
 	out.ParentName = CohortReference(in.ParentName)
-	out.ResourceGroups = *(*[]ResourceGroup)(unsafe.Pointer(&in.ResourceGroups))
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceGroup_To_v1beta1_ResourceGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceGroups = nil
+	}
 	out.FairSharing = (*FairSharing)(unsafe.Pointer(in.FairSharing))
 	return nil
 }
@@ -1464,7 +1516,17 @@ func Convert_v1beta2_FlavorFungibility_To_v1beta1_FlavorFungibility(in *v1beta2.
 
 func autoConvert_v1beta1_FlavorQuotas_To_v1beta2_FlavorQuotas(in *FlavorQuotas, out *v1beta2.FlavorQuotas, s conversion.Scope) error {
 	out.Name = v1beta2.ResourceFlavorReference(in.Name)
-	out.Resources = *(*[]v1beta2.ResourceQuota)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]v1beta2.ResourceQuota, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ResourceQuota_To_v1beta2_ResourceQuota(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	return nil
 }
 
@@ -1475,7 +1537,17 @@ func Convert_v1beta1_FlavorQuotas_To_v1beta2_FlavorQuotas(in *FlavorQuotas, out
 
 func autoConvert_v1beta2_FlavorQuotas_To_v1beta1_FlavorQuotas(in *v1beta2.FlavorQuotas, out *FlavorQuotas, s conversion.Scope) error {
 	out.Name = ResourceFlavorReference(in.Name)
-	out.Resources = *(*[]ResourceQuota)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceQuota, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	return nil
 }
 
@@ -1662,14 +1734,10 @@ func autoConvert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(in *v1beta2.Lo
 	out.ClusterQueue = ClusterQueueReference(in.ClusterQueue)
 	out.StopPolicy = (*StopPolicy)(unsafe.Pointer(in.StopPolicy))
 	out.FairSharing = (*FairSharing)(unsafe.Pointer(in.FairSharing))
+	// WARNING: in.Budget requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec is an autogenerated conversion function.
-func Convert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(in *v1beta2.LocalQueueSpec, out *LocalQueueSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_LocalQueueSpec_To_v1beta1_LocalQueueSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_LocalQueueStatus_To_v1beta2_LocalQueueStatus(in *LocalQueueStatus, out *v1beta2.LocalQueueStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingWorkloads = in.PendingWorkloads
@@ -1690,6 +1758,8 @@ func autoConvert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(in *v1beta
 	out.FlavorsReservation = *(*[]LocalQueueFlavorUsage)(unsafe.Pointer(&in.FlavorsReservation))
 	// WARNING: in.FlavorsUsage requires manual conversion: does not exist in peer-type
 	out.FairSharing = (*FairSharingStatus)(unsafe.Pointer(in.FairSharing))
+	// WARNING: in.Budget requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceRecommendations requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1775,6 +1845,7 @@ func autoConvert_v1beta1_MultiKueueClusterSpec_To_v1beta2_MultiKueueClusterSpec(
 
 func autoConvert_v1beta2_MultiKueueClusterSpec_To_v1beta1_MultiKueueClusterSpec(in *v1beta2.MultiKueueClusterSpec, out *MultiKueueClusterSpec, s conversion.Scope) error {
 	// WARNING: in.ClusterSource requires manual conversion: does not exist in peer-type
+	// WARNING: in.Cordoned requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1790,14 +1861,10 @@ func Convert_v1beta1_MultiKueueClusterStatus_To_v1beta2_MultiKueueClusterStatus(
 
 func autoConvert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in *v1beta2.MultiKueueClusterStatus, out *MultiKueueClusterStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Health requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in *v1beta2.MultiKueueClusterStatus, out *MultiKueueClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_MultiKueueClusterStatus_To_v1beta1_MultiKueueClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_MultiKueueConfig_To_v1beta2_MultiKueueConfig(in *MultiKueueConfig, out *v1beta2.MultiKueueConfig, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_MultiKueueConfigSpec_To_v1beta2_MultiKueueConfigSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1918,14 +1985,10 @@ func autoConvert_v1beta2_PodSet_To_v1beta1_PodSet(in *v1beta2.PodSet, out *PodSe
 	} else {
 		out.TopologyRequest = nil
 	}
+	// WARNING: in.QueueName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_PodSet_To_v1beta1_PodSet is an autogenerated conversion function.
-func Convert_v1beta2_PodSet_To_v1beta1_PodSet(in *v1beta2.PodSet, out *PodSet, s conversion.Scope) error {
-	return autoConvert_v1beta2_PodSet_To_v1beta1_PodSet(in, out, s)
-}
-
 func autoConvert_v1beta1_PodSetAssignment_To_v1beta2_PodSetAssignment(in *PodSetAssignment, out *v1beta2.PodSetAssignment, s conversion.Scope) error {
 	out.Name = v1beta2.PodSetReference(in.Name)
 	out.Flavors = *(*map[corev1.ResourceName]v1beta2.ResourceFlavorReference)(unsafe.Pointer(&in.Flavors))
@@ -1964,14 +2027,10 @@ func autoConvert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(in *v1beta
 		out.TopologyAssignment = nil
 	}
 	out.DelayedTopologyRequest = (*DelayedTopologyRequestState)(unsafe.Pointer(in.DelayedTopologyRequest))
+	// WARNING: in.ClusterQueue requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment is an autogenerated conversion function.
-func Convert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(in *v1beta2.PodSetAssignment, out *PodSetAssignment, s conversion.Scope) error {
-	return autoConvert_v1beta2_PodSetAssignment_To_v1beta1_PodSetAssignment(in, out, s)
-}
-
 func autoConvert_v1beta1_PodSetRequest_To_v1beta2_PodSetRequest(in *PodSetRequest, out *v1beta2.PodSetRequest, s conversion.Scope) error {
 	out.Name = v1beta2.PodSetReference(in.Name)
 	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
@@ -2270,7 +2329,17 @@ func Convert_v1beta2_ResourceFlavor_To_v1beta1_ResourceFlavor(in *v1beta2.Resour
 
 func autoConvert_v1beta1_ResourceFlavorList_To_v1beta2_ResourceFlavorList(in *ResourceFlavorList, out *v1beta2.ResourceFlavorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.ResourceFlavor)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ResourceFlavor, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ResourceFlavor_To_v1beta2_ResourceFlavor(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2281,7 +2350,17 @@ func Convert_v1beta1_ResourceFlavorList_To_v1beta2_ResourceFlavorList(in *Resour
 
 func autoConvert_v1beta2_ResourceFlavorList_To_v1beta1_ResourceFlavorList(in *v1beta2.ResourceFlavorList, out *ResourceFlavorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ResourceFlavor)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceFlavor, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceFlavor_To_v1beta1_ResourceFlavor(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2308,17 +2387,23 @@ func autoConvert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in *v1
 	out.NodeTaints = *(*[]corev1.Taint)(unsafe.Pointer(&in.NodeTaints))
 	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.TopologyName = (*TopologyReference)(unsafe.Pointer(in.TopologyName))
+	// WARNING: in.Prices requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec is an autogenerated conversion function.
-func Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in *v1beta2.ResourceFlavorSpec, out *ResourceFlavorSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(in *ResourceGroup, out *v1beta2.ResourceGroup, s conversion.Scope) error {
 	out.CoveredResources = *(*[]corev1.ResourceName)(unsafe.Pointer(&in.CoveredResources))
-	out.Flavors = *(*[]v1beta2.FlavorQuotas)(unsafe.Pointer(&in.Flavors))
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]v1beta2.FlavorQuotas, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_FlavorQuotas_To_v1beta2_FlavorQuotas(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Flavors = nil
+	}
 	return nil
 }
 
@@ -2329,7 +2414,17 @@ func Convert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(in *ResourceGroup, o
 
 func autoConvert_v1beta2_ResourceGroup_To_v1beta1_ResourceGroup(in *v1beta2.ResourceGroup, out *ResourceGroup, s conversion.Scope) error {
 	out.CoveredResources = *(*[]corev1.ResourceName)(unsafe.Pointer(&in.CoveredResources))
-	out.Flavors = *(*[]FlavorQuotas)(unsafe.Pointer(&in.Flavors))
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]FlavorQuotas, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_FlavorQuotas_To_v1beta1_FlavorQuotas(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Flavors = nil
+	}
 	return nil
 }
 
@@ -2356,14 +2451,10 @@ func autoConvert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(in *v1beta2.Reso
 	out.NominalQuota = in.NominalQuota
 	out.BorrowingLimit = (*resource.Quantity)(unsafe.Pointer(in.BorrowingLimit))
 	out.LendingLimit = (*resource.Quantity)(unsafe.Pointer(in.LendingLimit))
+	// WARNING: in.Schedules requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota is an autogenerated conversion function.
-func Convert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(in *v1beta2.ResourceQuota, out *ResourceQuota, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResourceQuota_To_v1beta1_ResourceQuota(in, out, s)
-}
-
 func autoConvert_v1beta1_ResourceUsage_To_v1beta2_ResourceUsage(in *ResourceUsage, out *v1beta2.ResourceUsage, s conversion.Scope) error {
 	out.Name = corev1.ResourceName(in.Name)
 	out.Total = in.Total
@@ -2480,17 +2571,23 @@ func Convert_v1beta1_TopologyLevel_To_v1beta2_TopologyLevel(in *TopologyLevel, o
 
 func autoConvert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(in *v1beta2.TopologyLevel, out *TopologyLevel, s conversion.Scope) error {
 	out.NodeLabel = in.NodeLabel
+	// WARNING: in.DeviceAttribute requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel is an autogenerated conversion function.
-func Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(in *v1beta2.TopologyLevel, out *TopologyLevel, s conversion.Scope) error {
-	return autoConvert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(in, out, s)
-}
-
 func autoConvert_v1beta1_TopologyList_To_v1beta2_TopologyList(in *TopologyList, out *v1beta2.TopologyList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.Topology)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.Topology, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Topology_To_v1beta2_Topology(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2501,7 +2598,17 @@ func Convert_v1beta1_TopologyList_To_v1beta2_TopologyList(in *TopologyList, out
 
 func autoConvert_v1beta2_TopologyList_To_v1beta1_TopologyList(in *v1beta2.TopologyList, out *TopologyList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Topology)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Topology, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_Topology_To_v1beta1_Topology(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2511,7 +2618,17 @@ func Convert_v1beta2_TopologyList_To_v1beta1_TopologyList(in *v1beta2.TopologyLi
 }
 
 func autoConvert_v1beta1_TopologySpec_To_v1beta2_TopologySpec(in *TopologySpec, out *v1beta2.TopologySpec, s conversion.Scope) error {
-	out.Levels = *(*[]v1beta2.TopologyLevel)(unsafe.Pointer(&in.Levels))
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]v1beta2.TopologyLevel, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_TopologyLevel_To_v1beta2_TopologyLevel(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Levels = nil
+	}
 	return nil
 }
 
@@ -2521,7 +2638,17 @@ func Convert_v1beta1_TopologySpec_To_v1beta2_TopologySpec(in *TopologySpec, out
 }
 
 func autoConvert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(in *v1beta2.TopologySpec, out *TopologySpec, s conversion.Scope) error {
-	out.Levels = *(*[]TopologyLevel)(unsafe.Pointer(&in.Levels))
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]TopologyLevel, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Levels = nil
+	}
 	return nil
 }
 
@@ -2732,7 +2859,9 @@ func autoConvert_v1beta2_WorkloadSpec_To_v1beta1_WorkloadSpec(in *v1beta2.Worklo
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.Active = (*bool)(unsafe.Pointer(in.Active))
 	out.MaximumExecutionTimeSeconds = (*int32)(unsafe.Pointer(in.MaximumExecutionTimeSeconds))
+	// WARNING: in.PreemptionGracePeriodSeconds requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreemptionGates requires manual conversion: does not exist in peer-type
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2780,5 +2909,7 @@ func autoConvert_v1beta2_WorkloadStatus_To_v1beta1_WorkloadStatus(in *v1beta2.Wo
 	out.ClusterName = (*string)(unsafe.Pointer(in.ClusterName))
 	out.UnhealthyNodes = *(*[]UnhealthyNode)(unsafe.Pointer(&in.UnhealthyNodes))
 	// WARNING: in.PreemptionGates requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceRecommendations requires manual conversion: does not exist in peer-type
+	// WARNING: in.MigrationHistory requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -100,10 +100,17 @@ type ClusterQueueSpec struct {
 	// - BestEffortFIFO: workloads are ordered by creation time,
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
+	// - EarliestDeadlineFirst: workloads are ordered by the deadline declared
+	// in their kueue.x-k8s.io/deadline annotation, earliest first, with
+	// priority and creation time as tie-breakers. Workloads without a deadline
+	// are ordered after the ones with a deadline. As in BestEffortFIFO, workloads
+	// that can't be admitted will not block admitting the next ones.
+	// This strategy requires the DeadlineAwareQueueing feature gate, otherwise
+	// it behaves as BestEffortFIFO.
 	//
 	// +optional
 	// +kubebuilder:default=BestEffortFIFO
-	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO
+	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO;EarliestDeadlineFirst
 	QueueingStrategy QueueingStrategy `json:"queueingStrategy,omitempty"`
 
 	// namespaceSelector defines which namespaces are allowed to submit workloads to
@@ -160,6 +167,37 @@ type ClusterQueueSpec struct {
 	//
 	// +optional
 	ConcurrentAdmissionPolicy *ConcurrentAdmissionPolicy `json:"concurrentAdmissionPolicy,omitempty"`
+
+	// localQueueTemplate defines the LocalQueues that Kueue provisions for
+	// this ClusterQueue in every namespace matching its namespaceSelector.
+	// The provisioned LocalQueues are deleted once their namespace stops
+	// matching, the template is removed or the ClusterQueue is deleted, and
+	// they have no unfinished Workloads.
+	// This field is in alpha stage. To use this field, the
+	// LocalQueueProvisioning feature gate must be enabled.
+	// +optional
+	LocalQueueTemplate *LocalQueueTemplate `json:"localQueueTemplate,omitempty"`
+}
+
+// LocalQueueTemplate defines the LocalQueues provisioned for a ClusterQueue.
+type LocalQueueTemplate struct {
+	// namePattern is the name of the provisioned LocalQueues, in which
+	// `{clusterQueue}` is replaced by the name of the ClusterQueue and
+	// `{namespace}` by the name of the namespace.
+	// Defaults to `{clusterQueue}`.
+	// +kubebuilder:default="{clusterQueue}"
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// stopPolicy is set on the provisioned LocalQueues.
+	// +kubebuilder:validation:Enum=None;Hold;HoldAndDrain
+	// +optional
+	StopPolicy *StopPolicy `json:"stopPolicy,omitempty"`
+
+	// fairSharing is set on the provisioned LocalQueues.
+	// +optional
+	FairSharing *FairSharing `json:"fairSharing,omitempty"`
 }
 
 // AdmissionChecksStrategy defines a strategy for a AdmissionCheck.
@@ -199,6 +237,11 @@ const (
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
 	BestEffortFIFO QueueingStrategy = "BestEffortFIFO"
+
+	// EarliestDeadlineFirst means that workloads are ordered by their deadline,
+	// with priority and creation time as tie-breakers. Workloads that can't be
+	// admitted will not block admitting the next workloads.
+	EarliestDeadlineFirst QueueingStrategy = "EarliestDeadlineFirst"
 )
 
 type ConcurrentAdmissionPolicy struct {
@@ -340,6 +383,64 @@ type ResourceQuota struct {
 	// This field is in beta stage and is enabled by default.
 	// +optional
 	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+
+	// schedules is a list of recurring time windows during which the
+	// nominalQuota, borrowingLimit and lendingLimit of this [flavor, resource]
+	// combination are overridden. When several windows are active at the same
+	// time, the first one in the list takes precedence. Outside of any window,
+	// the values above apply.
+	// There could be up to 8 schedules.
+	//
+	// This field is in alpha stage and requires the QuotaSchedules feature gate.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Schedules []QuotaSchedule `json:"schedules,omitempty"`
+}
+
+// QuotaSchedule defines a recurring time window during which the quota of a
+// [flavor, resource] combination is overridden.
+// +kubebuilder:validation:XValidation:rule="has(self.nominalQuota) || has(self.borrowingLimit) || has(self.lendingLimit)", message="at least one of nominalQuota, borrowingLimit or lendingLimit must be set"
+type QuotaSchedule struct {
+	// schedule is the start of the window in the Cron format, that is:
+	// minute, hour, day of month, month and day of week.
+	// For example, "0 20 * * 1-5" starts the window at 20:00 on every weekday.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Schedule string `json:"schedule,omitempty"`
+
+	// timeZone is the name of the time zone, from the IANA Time Zone database,
+	// in which the schedule is interpreted. For example, "Europe/Warsaw".
+	// If not set, the schedule is interpreted in UTC.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// durationSeconds is the length of the window, in seconds.
+	// +kubebuilder:validation:Minimum=60
+	// +required
+	DurationSeconds int32 `json:"durationSeconds,omitempty"`
+
+	// nominalQuota replaces the nominalQuota of the [flavor, resource]
+	// combination while the window is active.
+	// If not set, the nominalQuota is not overridden.
+	// +optional
+	NominalQuota *resource.Quantity `json:"nominalQuota,omitempty"`
+
+	// borrowingLimit replaces the borrowingLimit of the [flavor, resource]
+	// combination while the window is active.
+	// If not set, the borrowingLimit is not overridden.
+	// borrowingLimit must be null if spec.cohortName is empty.
+	// +optional
+	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
+
+	// lendingLimit replaces the lendingLimit of the [flavor, resource]
+	// combination while the window is active.
+	// If not set, the lendingLimit is not overridden.
+	// lendingLimit must be null if spec.cohortName is empty.
+	// +optional
+	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
 }
 
 // ResourceFlavorReference is the name of the ResourceFlavor.
@@ -396,6 +497,32 @@ type ClusterQueueStatus struct {
 	// This is recorded only when Fair Sharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharingStatus `json:"fairSharing,omitempty"`
+
+	// preemptionBudget contains the consumption of the preemption budget
+	// within the current window. It is recorded only when the ClusterQueue
+	// has a preemption budget.
+	// +optional
+	PreemptionBudget *PreemptionBudgetStatus `json:"preemptionBudget,omitempty"`
+}
+
+// PreemptionBudgetStatus contains the consumption of the preemption budget of
+// a ClusterQueue within the current window.
+type PreemptionBudgetStatus struct {
+	// preemptedWorkloads is the number of Workloads preempted within the
+	// window.
+	// +required
+	PreemptedWorkloads int32 `json:"preemptedWorkloads"`
+
+	// preemptedResources is the quantity of the resources requested by the
+	// Workloads preempted within the window.
+	// +optional
+	PreemptedResources corev1.ResourceList `json:"preemptedResources,omitempty"`
+
+	// lastPreemptionTime is the time of the last preemption within the window.
+	// When Kueue restarts, it restores the consumption recorded in this status
+	// and keeps it until lastPreemptionTime falls out of the window.
+	// +optional
+	LastPreemptionTime *metav1.Time `json:"lastPreemptionTime,omitempty"`
 }
 
 type FlavorUsage struct {
@@ -558,6 +685,102 @@ type ClusterQueuePreemption struct {
 	// +kubebuilder:validation:Enum=Never;LowerPriority;LowerOrNewerEqualPriority
 	// +optional
 	WithinClusterQueue PreemptionPolicy `json:"withinClusterQueue,omitempty"`
+
+	// preemptionBudget limits the preemptions issued by the Workloads of this
+	// ClusterQueue within a sliding time window. Once the budget is exhausted,
+	// the pending Workloads which need preemption wait until the earlier
+	// preemptions fall out of the window.
+	// This field is in alpha stage. To use this field, the PreemptionBudgets
+	// feature gate must be enabled.
+	// +optional
+	PreemptionBudget *PreemptionBudget `json:"preemptionBudget,omitempty"`
+
+	// minimumRuntimeSeconds, if provided, protects the Workloads admitted to
+	// this ClusterQueue from preemption until they've held their quota
+	// reservation for this many seconds, so that a preemption doesn't waste
+	// the cost of pulling their images and starting them.
+	// This field is in alpha stage. To use this field, the
+	// PreemptionMinimumRuntime feature gate must be enabled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinimumRuntimeSeconds *int32 `json:"minimumRuntimeSeconds,omitempty"`
+
+	// minimumRuntimeExemption determines which preemptions can target the
+	// Workloads protected by minimumRuntimeSeconds. The possible values are:
+	//
+	// - `ReclaimNominalQuota` (default): Workloads from other ClusterQueues
+	//   in the cohort can preempt the protected Workloads when they reclaim
+	//   the nominal quota of their ClusterQueue.
+	// - `None`: no preemption can target the protected Workloads.
+	//
+	// This field is in alpha stage. To use this field, the
+	// PreemptionMinimumRuntime feature gate must be enabled.
+	// +kubebuilder:validation:Enum=None;ReclaimNominalQuota
+	// +optional
+	MinimumRuntimeExemption MinimumRuntimeExemption `json:"minimumRuntimeExemption,omitempty"`
+
+	// priorityDecay, if provided, lowers the effective priority of the
+	// Workloads admitted to this ClusterQueue as they run, so that pending
+	// Workloads of the same priority can preempt them, with any
+	// withinClusterQueue policy other than Never. The decay is reset when a
+	// Workload is evicted.
+	// This field is in alpha stage. To use this field, the PriorityDecay
+	// feature gate must be enabled.
+	// +optional
+	PriorityDecay *PriorityDecay `json:"priorityDecay,omitempty"`
+}
+
+// PriorityDecay defines how the effective priority of the admitted Workloads
+// decreases with their runtime.
+type PriorityDecay struct {
+	// windowSeconds is the runtime, since the quota reservation, after which
+	// the effective priority of a Workload drops by the step, and again after
+	// each further window.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	WindowSeconds int32 `json:"windowSeconds"`
+
+	// step is the amount by which the effective priority drops after each
+	// window.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Step int32 `json:"step"`
+
+	// maxDecay, if provided, caps the total decrease of the effective
+	// priority.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDecay *int32 `json:"maxDecay,omitempty"`
+}
+
+type MinimumRuntimeExemption string
+
+const (
+	MinimumRuntimeExemptionNone                MinimumRuntimeExemption = "None"
+	MinimumRuntimeExemptionReclaimNominalQuota MinimumRuntimeExemption = "ReclaimNominalQuota"
+)
+
+// PreemptionBudget defines the preemptions which the Workloads of a
+// ClusterQueue can issue within a sliding time window.
+// +kubebuilder:validation:XValidation:rule="has(self.maxWorkloads) || has(self.maxResources)", message="at least one of maxWorkloads or maxResources must be set"
+type PreemptionBudget struct {
+	// window is the duration of the sliding window over which the
+	// preemptions are accounted, for example 10m.
+	// +required
+	Window metav1.Duration `json:"window"`
+
+	// maxWorkloads is the maximum number of Workloads which can be preempted
+	// within the window.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxWorkloads *int32 `json:"maxWorkloads,omitempty"`
+
+	// maxResources is the maximum quantity of each resource, requested by the
+	// preempted Workloads, which can be preempted within the window. For
+	// example, nvidia.com/gpu: 16 allows preempting Workloads requesting up
+	// to 16 GPUs in total.
+	// +optional
+	MaxResources corev1.ResourceList `json:"maxResources,omitempty"`
 }
 
 type BorrowWithinCohortPolicy string
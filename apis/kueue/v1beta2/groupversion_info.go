@@ -44,6 +44,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LocalQueue{}, &LocalQueueList{},
 		&MultiKueueConfig{}, &MultiKueueConfigList{}, &MultiKueueCluster{}, &MultiKueueClusterList{},
 		&ProvisioningRequestConfig{}, &ProvisioningRequestConfigList{},
+		&QuotaReservation{}, &QuotaReservationList{},
 		&ResourceFlavor{}, &ResourceFlavorList{},
 		&Topology{}, &TopologyList{},
 		&Workload{}, &WorkloadList{},
@@ -55,8 +55,67 @@ type LocalQueueSpec struct {
 	// if AdmissionFairSharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharing `json:"fairSharing,omitempty"`
+
+	// budget limits the resource-time that the workloads of the LocalQueue
+	// can consume within a calendar period, for example GPU-hours per month.
+	// This field is in alpha stage. To use this field, the LocalQueueBudgets
+	// feature gate must be enabled.
+	// +optional
+	Budget *LocalQueueBudget `json:"budget,omitempty"`
+}
+
+// LocalQueueBudget defines the resource-time the workloads of a LocalQueue
+// can consume within a calendar period.
+type LocalQueueBudget struct {
+	// period is the calendar period over which the consumption is accounted.
+	// Periods start at midnight UTC; weeks start on Monday.
+	//
+	// - Daily - the budget is renewed every day.
+	// - Weekly - the budget is renewed every week.
+	// - Monthly - the budget is renewed every month.
+	//
+	// +kubebuilder:validation:Enum=Daily;Weekly;Monthly
+	// +kubebuilder:default=Monthly
+	// +optional
+	Period BudgetPeriod `json:"period,omitempty"`
+
+	// resourceHours is the resource-time, in resource-hours, that the
+	// workloads of the LocalQueue can consume within the period. For example,
+	// nvidia.com/gpu: 10000 allows 10000 GPU-hours.
+	// The consumption is accounted from the resources of the admitted workloads.
+	// +required
+	ResourceHours corev1.ResourceList `json:"resourceHours"`
+
+	// whenExhausted defines what happens to the pending workloads of the
+	// LocalQueue once the budget of any resource is exhausted.
+	//
+	// - Block - the workloads are not admitted until the next period.
+	// - Deprioritize - the workloads are ordered after the workloads of
+	//   the LocalQueues with remaining budget in the ClusterQueue.
+	//
+	// Admitted workloads are not evicted when the budget is exhausted.
+	//
+	// +kubebuilder:validation:Enum=Block;Deprioritize
+	// +kubebuilder:default=Block
+	// +optional
+	WhenExhausted BudgetExhaustedAction `json:"whenExhausted,omitempty"`
 }
 
+type BudgetPeriod string
+
+const (
+	BudgetPeriodDaily   BudgetPeriod = "Daily"
+	BudgetPeriodWeekly  BudgetPeriod = "Weekly"
+	BudgetPeriodMonthly BudgetPeriod = "Monthly"
+)
+
+type BudgetExhaustedAction string
+
+const (
+	BudgetExhaustedBlock        BudgetExhaustedAction = "Block"
+	BudgetExhaustedDeprioritize BudgetExhaustedAction = "Deprioritize"
+)
+
 type TopologyInfo struct {
 	// name is the name of the topology.
 	//
@@ -119,6 +178,59 @@ type LocalQueueStatus struct {
 	// fairSharing contains the information about the current status of fair sharing.
 	// +optional
 	FairSharing *LocalQueueFairSharingStatus `json:"fairSharing,omitempty"`
+
+	// budget contains the consumption of the budget in the current period.
+	// +optional
+	Budget *LocalQueueBudgetStatus `json:"budget,omitempty"`
+
+	// resourceRecommendations aggregates the resourceRecommendations of the
+	// admitted workloads in the LocalQueue.
+	// Requires enabling the WorkloadRightSizing feature gate.
+	// +optional
+	ResourceRecommendations *LocalQueueResourceRecommendations `json:"resourceRecommendations,omitempty"`
+}
+
+// LocalQueueResourceRecommendations aggregates the resource recommendations
+// of the admitted workloads in a LocalQueue.
+type LocalQueueResourceRecommendations struct {
+	// workloads is the number of admitted workloads with a recommendation.
+	// +optional
+	Workloads int32 `json:"workloads"`
+
+	// requested is the total of the requests of those workloads, for the
+	// recommended resources.
+	// +optional
+	Requested corev1.ResourceList `json:"requested,omitempty"`
+
+	// recommended is the total of the recommended requests of those workloads.
+	// +optional
+	Recommended corev1.ResourceList `json:"recommended,omitempty"`
+
+	// lastUpdate is the time when the recommendations were last aggregated.
+	// +required
+	LastUpdate metav1.Time `json:"lastUpdate"`
+}
+
+// LocalQueueBudgetStatus contains the consumption of the LocalQueue budget
+// in the current period.
+type LocalQueueBudgetStatus struct {
+	// periodStart is the time when the current period started.
+	// +required
+	PeriodStart metav1.Time `json:"periodStart"`
+
+	// consumedResourceHours is the resource-time, in resource-hours,
+	// consumed by the workloads of the LocalQueue in the current period.
+	// +optional
+	ConsumedResourceHours corev1.ResourceList `json:"consumedResourceHours,omitempty"`
+
+	// remainingResourceHours is the resource-time, in resource-hours, left
+	// in the budget for the current period.
+	// +optional
+	RemainingResourceHours corev1.ResourceList `json:"remainingResourceHours,omitempty"`
+
+	// lastUpdate is the time when the consumption was last updated.
+	// +required
+	LastUpdate metav1.Time `json:"lastUpdate"`
 }
 
 // LocalQueueFairSharingStatus contains the information about the current status of Fair Sharing.
@@ -154,6 +266,10 @@ const (
 	// LocalQueueActive indicates that the ClusterQueue that backs the LocalQueue is active and
 	// the LocalQueue can submit new workloads to its ClusterQueue.
 	LocalQueueActive string = "Active"
+
+	// LocalQueueBudgetExhausted indicates that the workloads of the LocalQueue
+	// consumed the budget of at least one resource for the current period.
+	LocalQueueBudgetExhausted string = "BudgetExhausted"
 )
 
 type LocalQueueFlavorUsage struct {
@@ -24,6 +24,22 @@ const (
 	MultiKueueConfigSecretKey = "kubeconfig"
 	MultiKueueClusterActive   = "Active"
 
+	// MultiKueueClusterCordoned is the condition type set when the manager
+	// doesn't dispatch new workloads to the cluster.
+	MultiKueueClusterCordoned = "Cordoned"
+
+	// MultiKueueClusterCordonedManually is the reason of the Cordoned
+	// condition when the cluster is cordoned by spec.cordoned.
+	MultiKueueClusterCordonedManually = "CordonedManually"
+
+	// MultiKueueClusterUnhealthy is the reason of the Cordoned condition
+	// when the health of the cluster crosses the configured thresholds.
+	MultiKueueClusterUnhealthy = "Unhealthy"
+
+	// MultiKueueClusterHealthy is the reason of the Cordoned condition
+	// when the cluster is not cordoned.
+	MultiKueueClusterHealthy = "Healthy"
+
 	// MultiKueueOriginLabel is a label used to track the creator
 	// of multikueue remote objects.
 	MultiKueueOriginLabel = "kueue.x-k8s.io/multikueue-origin"
@@ -77,6 +93,13 @@ type MultiKueueClusterSpec struct {
 	// clusterSource is the source to connect to the cluster.
 	// +required
 	ClusterSource ClusterSource `json:"clusterSource,omitempty"`
+
+	// cordoned, when true, stops the manager from dispatching new workloads
+	// to the cluster, for example during a maintenance window. The workloads
+	// already admitted by the cluster keep running and being synchronized.
+	// Requires enabling the MultiKueueClusterHealth feature gate.
+	// +optional
+	Cordoned *bool `json:"cordoned,omitempty"`
 }
 
 // +kubebuilder:validation:ExactlyOneOf=kubeConfig;clusterProfileRef
@@ -109,6 +132,56 @@ type MultiKueueClusterStatus struct {
 	// +patchMergeKey=type
 	// +kubebuilder:validation:MaxItems=16
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// health summarizes how the cluster handled the workloads dispatched to
+	// it during the observation window configured in the manager.
+	// Requires enabling the MultiKueueClusterHealth feature gate.
+	// +optional
+	Health *MultiKueueClusterHealth `json:"health,omitempty"`
+}
+
+// MultiKueueClusterHealth summarizes how a worker cluster handled the
+// workloads dispatched to it.
+type MultiKueueClusterHealth struct {
+	// dispatches is the number of workloads the manager tried to create in
+	// the cluster.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Dispatches int32 `json:"dispatches"`
+
+	// dispatchSuccessPercent is the percentage of the dispatches which
+	// succeeded. It's not set if there were no dispatches.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	DispatchSuccessPercent *int32 `json:"dispatchSuccessPercent,omitempty"`
+
+	// admissions is the number of dispatched workloads admitted by the cluster.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Admissions int32 `json:"admissions"`
+
+	// averageAdmissionLatency is the average time between the creation of a
+	// workload in the cluster and its admission. It's not set if there were
+	// no admissions.
+	// +optional
+	AverageAdmissionLatency *metav1.Duration `json:"averageAdmissionLatency,omitempty"`
+
+	// evictions is the number of admitted workloads evicted by the cluster.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Evictions int32 `json:"evictions"`
+
+	// evictionPercent is the percentage of the admissions which ended with
+	// an eviction. It's not set if there were no admissions.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	EvictionPercent *int32 `json:"evictionPercent,omitempty"`
+
+	// lastUpdateTime is the time when the health last changed.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
 // +genclient
@@ -119,6 +192,7 @@ type MultiKueueClusterStatus struct {
 // +kubebuilder:resource:scope=Cluster,shortName={mkc}
 
 // +kubebuilder:printcolumn:name="Connected",JSONPath=".status.conditions[?(@.type=='Active')].status",type="string",description="MultiKueueCluster is connected"
+// +kubebuilder:printcolumn:name="Cordoned",JSONPath=".status.conditions[?(@.type=='Cordoned')].status",type="string",description="MultiKueueCluster is cordoned",priority=1
 // +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type="date",description="Time this workload was created"
 // MultiKueueCluster is the Schema for the multikueue API
 type MultiKueueCluster struct {
@@ -0,0 +1,170 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// QuotaReservationActive indicates that the reservation window is
+	// ongoing. While it is, the reserved quota can only be used by the
+	// Workloads holding the QuotaReservation.
+	QuotaReservationActive = "Active"
+
+	// QuotaReservationPending is the reason of the Active condition set to
+	// false before Kueue starts withholding the reserved quota.
+	QuotaReservationPending = "Pending"
+
+	// QuotaReservationRampingUp is the reason of the Active condition set to
+	// false while Kueue progressively stops lending the reserved quota.
+	QuotaReservationRampingUp = "RampingUp"
+
+	// QuotaReservationStarted is the reason of the Active condition set to
+	// true during the reservation window.
+	QuotaReservationStarted = "Started"
+
+	// QuotaReservationExpired is the reason of the Active condition set to
+	// false after the reservation window ended.
+	QuotaReservationExpired = "Expired"
+)
+
+// QuotaReservationSpec defines the desired state of QuotaReservation
+// +kubebuilder:validation:XValidation:rule="has(self.clusterQueue) != has(self.cohort)", message="exactly one of clusterQueue or cohort must be set"
+type QuotaReservationSpec struct {
+	// clusterQueue is the name of the ClusterQueue whose nominal quota is
+	// reserved.
+	// +optional
+	ClusterQueue ClusterQueueReference `json:"clusterQueue,omitempty"`
+
+	// cohort is the name of the Cohort whose quota is reserved, including
+	// the quota lent to it by its children.
+	// +optional
+	Cohort CohortReference `json:"cohort,omitempty"`
+
+	// allowedNamespaces lists the namespaces whose Workloads can hold the
+	// reservation. The kueue.x-k8s.io/quota-reservation annotation is
+	// ignored on the Workloads of other namespaces.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=63
+	AllowedNamespaces []string `json:"allowedNamespaces"`
+
+	// flavor is the name of the ResourceFlavor of the reserved quota.
+	// +required
+	Flavor ResourceFlavorReference `json:"flavor"`
+
+	// resources lists the reserved quantities of the resources in the
+	// flavor.
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Resources []ReservedResource `json:"resources"`
+
+	// startTime is the time at which the reservation window starts.
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+
+	// durationSeconds is the length of the reservation window.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds int64 `json:"durationSeconds"`
+
+	// rampUpSeconds is how long before the startTime Kueue starts
+	// withholding the reserved quota from the Cohort. The withheld quota
+	// grows in steps during that time, so that Workloads borrowing it are
+	// not admitted anew while the ones already running can finish.
+	// Defaults to 1 hour.
+	// +optional
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=0
+	RampUpSeconds *int64 `json:"rampUpSeconds,omitempty"`
+}
+
+// ReservedResource is a reserved quantity of a resource.
+type ReservedResource struct {
+	// name of the resource. For example, nvidia.com/gpu.
+	// +required
+	Name corev1.ResourceName `json:"name"`
+
+	// quantity of the resource which is reserved.
+	// +required
+	Quantity resource.Quantity `json:"quantity"`
+}
+
+// QuotaReservationStatus defines the observed state of QuotaReservation
+type QuotaReservationStatus struct {
+	// conditions hold the latest available observations of the QuotaReservation
+	// current state.
+	//
+	// The type of the condition could be:
+	//
+	// - Active: the reservation window is ongoing.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,shortName={qres}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ClusterQueue",JSONPath=".spec.clusterQueue",type=string,description="ClusterQueue whose quota is reserved"
+// +kubebuilder:printcolumn:name="Cohort",JSONPath=".spec.cohort",type=string,description="Cohort whose quota is reserved"
+// +kubebuilder:printcolumn:name="Start",JSONPath=".spec.startTime",type=date,description="Start of the reservation window"
+// +kubebuilder:printcolumn:name="Active",JSONPath=".status.conditions[?(@.type=='Active')].status",type=string,description="Whether the reservation window is ongoing"
+
+// QuotaReservation is the Schema for the quotaReservations API.
+// It books quota of a ClusterQueue or Cohort for a time window, for the
+// Workloads of the allowed namespaces carrying the
+// kueue.x-k8s.io/quota-reservation annotation with its name.
+type QuotaReservation struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the metadata of the QuotaReservation.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the QuotaReservation.
+	// +optional
+	Spec QuotaReservationSpec `json:"spec"`
+
+	// status is the status of the QuotaReservation.
+	// +optional
+	Status QuotaReservationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuotaReservationList contains a list of QuotaReservation
+type QuotaReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuotaReservation `json:"items"`
+}
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -107,6 +108,37 @@ type ResourceFlavorSpec struct {
 	//
 	// +optional
 	TopologyName *TopologyReference `json:"topologyName,omitempty"`
+
+	// prices define the cost of using the resources of this flavor.
+	// When the WorkloadCostAccounting feature is enabled, Kueue uses them to
+	// accumulate the cost of the workloads admitted in this flavor over their
+	// execution.
+	//
+	// prices can be up to 64 elements.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Prices []ResourcePrice `json:"prices,omitempty"`
+}
+
+// ResourcePrice is the hourly cost of a resource.
+type ResourcePrice struct {
+	// name of the resource. For example, nvidia.com/gpu.
+	// +required
+	Name corev1.ResourceName `json:"name"`
+
+	// pricePerHour is the cost of using one unit of the resource for one
+	// hour, in a currency of the administrator's choice. For example, 2.5
+	// for the price of a GPU-hour.
+	// +required
+	PricePerHour resource.Quantity `json:"pricePerHour"`
+
+	// unit is the amount of the resource that pricePerHour applies to.
+	// For example, 1Gi for memory. Defaults to 1.
+	// +optional
+	Unit *resource.Quantity `json:"unit,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -115,10 +115,12 @@ type TopologySpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf || (self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname' && oldSelf[size(oldSelf) - 1].nodeLabel == 'kubernetes.io/hostname')",message="levels are mutable only when kubernetes.io/hostname is the lowest level both before and after the change"
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, size(self.filter(j, j == i)) > 1)) == 0",message="must be unique"
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, i.nodeLabel == 'kubernetes.io/hostname')) == 0 || self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname'",message="the kubernetes.io/hostname label can only be used at the lowest level of topology"
+	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, has(i.deviceAttribute))) == 0 || self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname'",message="levels with a deviceAttribute require kubernetes.io/hostname as the lowest level of topology"
 	Levels []TopologyLevel `json:"levels,omitempty"`
 }
 
 // TopologyLevel defines the desired state of TopologyLevel
+// +kubebuilder:validation:XValidation:rule="!has(self.deviceAttribute) || self.nodeLabel != 'kubernetes.io/hostname'",message="the kubernetes.io/hostname level cannot have a deviceAttribute"
 type TopologyLevel struct {
 	// nodeLabel indicates the name of the node label for a specific topology
 	// level.
@@ -132,6 +134,48 @@ type TopologyLevel struct {
 	// +kubebuilder:validation:MaxLength=316
 	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
 	NodeLabel string `json:"nodeLabel,omitempty"`
+
+	// deviceAttribute indicates that the values of this level are read from
+	// an attribute of the DRA devices published in ResourceSlices, rather
+	// than from the node label. This allows to use device interconnects, such
+	// as an NVLink domain, as topology levels. The nodeLabel remains the name
+	// of the level, used in PodSet topology requests and assignments.
+	//
+	// A node belongs to a domain of this level only when all the devices the
+	// driver publishes for the node report the same attribute value. Since
+	// Kueue places pods on nodes, the level must not be below the node: its
+	// domains are groups of nodes, and kubernetes.io/hostname is required as
+	// the lowest level of the topology.
+	//
+	// This field is alpha-level and is only honored when the
+	// TASDeviceTopologyLevels feature gate is enabled.
+	//
+	// +optional
+	DeviceAttribute *TopologyDeviceAttribute `json:"deviceAttribute,omitempty"`
+}
+
+// TopologyDeviceAttribute references an attribute of the DRA devices
+// published by a driver.
+type TopologyDeviceAttribute struct {
+	// driver is the name of the DRA driver publishing the devices, as
+	// specified in the ResourceSlice spec.driver field.
+	//
+	// Example: gpu.nvidia.com
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Driver string `json:"driver,omitempty"`
+
+	// name is the name of the device attribute. An attribute name without a
+	// domain is qualified by the driver name.
+	//
+	// Example: gpu.nvidia.com/cliqueID
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=96
+	Name string `json:"name,omitempty"`
 }
 
 // +genclient
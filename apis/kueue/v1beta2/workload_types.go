@@ -76,6 +76,20 @@ type WorkloadSpec struct {
 	// +kubebuilder:validation:Minimum=1
 	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
 
+	// preemptionGracePeriodSeconds if provided, determines the time, in seconds,
+	// the workload is given to checkpoint once it's notified of its preemption
+	// through the PreemptionPending condition, before it's evicted. The
+	// workload is evicted earlier if it acknowledges the checkpoint with the
+	// kueue.x-k8s.io/checkpoint-acknowledged annotation.
+	//
+	// If unspecified, the workload is evicted as soon as it's preempted.
+	// This field is in alpha stage. To use this field, the
+	// CheckpointAwarePreemption feature gate must be enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PreemptionGracePeriodSeconds *int32 `json:"preemptionGracePeriodSeconds,omitempty"`
+
 	// preemptionGates is a list of gates governing whether the workload
 	// can trigger preemptions.
 	// The gates are closed by default.
@@ -84,6 +98,32 @@ type WorkloadSpec struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +optional
 	PreemptionGates []PreemptionGate `json:"preemptionGates,omitempty"`
+
+	// dependsOn is a list of Workloads in the same namespace that must finish
+	// successfully before this workload can be queued for admission.
+	// While any dependency is not finished, the workload is kept out of the
+	// queues with the WaitingForDependencies condition. If any dependency
+	// finishes with a failure, the workload is deactivated.
+	// At most 8 dependencies can be listed.
+	// This field is in alpha stage. To use this field, the
+	// WorkloadDependencies feature gate must be enabled.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	DependsOn []WorkloadDependency `json:"dependsOn,omitempty"`
+}
+
+// WorkloadDependency references a Workload that must finish before the
+// dependent workload can be admitted.
+type WorkloadDependency struct {
+	// name is the name of the Workload, in the namespace of the dependent
+	// workload.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
 }
 
 // PriorityClassGroup indicates the API group of the PriorityClass object.
@@ -439,6 +479,16 @@ type PodSetAssignment struct {
 	//
 	// +optional
 	DelayedTopologyRequest *DelayedTopologyRequestState `json:"delayedTopologyRequest,omitempty"`
+
+	// clusterQueue is the name of the ClusterQueue whose quota is charged for
+	// this podSet. It is only set when the podSet is charged to a ClusterQueue
+	// other than .status.admission.clusterQueue, which happens when the podSet
+	// specifies its own queueName.
+	//
+	// This is an alpha field and requires enabling the MultiQueueWorkloads feature gate.
+	//
+	// +optional
+	ClusterQueue *ClusterQueueReference `json:"clusterQueue,omitempty"`
 }
 
 // DelayedTopologyRequestState indicates the state of the delayed TopologyRequest.
@@ -601,6 +651,19 @@ type PodSet struct {
 	//
 	// +optional
 	TopologyRequest *PodSetTopologyRequest `json:"topologyRequest,omitempty"`
+
+	// queueName is the name of the LocalQueue, in the Workload's namespace,
+	// whose ClusterQueue provides the quota for this PodSet. When omitted, the
+	// PodSet uses the Workload's .spec.queueName.
+	//
+	// The ClusterQueue of this LocalQueue must belong to the same cohort tree
+	// as the ClusterQueue of the Workload. The Workload is admitted only when
+	// all its PodSets fit in their ClusterQueues at the same time.
+	//
+	// This is an alpha field and requires enabling the MultiQueueWorkloads feature gate.
+	//
+	// +optional
+	QueueName *LocalQueueName `json:"queueName,omitempty"`
 }
 
 type PreemptionGate struct {
@@ -725,6 +788,83 @@ type WorkloadStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +optional
 	PreemptionGates []PreemptionGateState `json:"preemptionGates,omitempty"`
+
+	// resourceRecommendations holds, per podSet, the requests recommended
+	// for its pods based on the usage observed through the metrics API
+	// while the workload is admitted.
+	// Requires enabling the WorkloadRightSizing feature gate.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	ResourceRecommendations []PodSetResourceRecommendation `json:"resourceRecommendations,omitempty"`
+
+	// migrationHistory lists, from the oldest, the MultiKueue worker clusters
+	// that evicted the workload after admitting it, and why.
+	// The manager doesn't dispatch the workload again to a cluster for a
+	// cooldown period after it evicted the workload.
+	// Only the latest entries are kept.
+	// Requires enabling the MultiKueueWorkloadMigration feature gate.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10
+	MigrationHistory []WorkloadMigration `json:"migrationHistory,omitempty"`
+}
+
+// WorkloadMigration records the eviction of a workload by a MultiKueue
+// worker cluster, after which the workload was dispatched again.
+type WorkloadMigration struct {
+	// clusterName is the name of the worker cluster that evicted the workload.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=256
+	ClusterName string `json:"clusterName"`
+
+	// reason is the reason of the eviction in the worker cluster.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=316
+	Reason string `json:"reason"`
+
+	// message is the message of the eviction in the worker cluster.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=32768
+	Message string `json:"message,omitempty"`
+
+	// evictionTime is the time when the manager observed the eviction.
+	//
+	// +required
+	EvictionTime metav1.Time `json:"evictionTime"`
+}
+
+// PodSetResourceRecommendation contains the requests recommended for the pods
+// of a podSet, based on their observed usage.
+type PodSetResourceRecommendation struct {
+	// name is the name of the podSet.
+	//
+	// +required
+	Name PodSetReference `json:"name"`
+
+	// peakUsage is the highest usage observed for a single pod of the podSet
+	// since the workload was first admitted.
+	//
+	// +optional
+	PeakUsage corev1.ResourceList `json:"peakUsage,omitempty"`
+
+	// resources are the requests recommended for each pod of the podSet.
+	// Only the resources reported by the metrics API, like cpu and memory,
+	// are recommended.
+	//
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+
+	// lastUpdate is the time when the recommendation last changed.
+	//
+	// +required
+	LastUpdate metav1.Time `json:"lastUpdate"`
 }
 
 type SchedulingStats struct {
@@ -978,6 +1118,10 @@ const (
 	// for previously admitted workloads to reach PodsReady condition under waitForPodsReady configuration.
 	WorkloadQuotaReservedReasonWaitingForPodsReady = "WaitingForPodsReady"
 
+	// WorkloadQuotaReservedReasonBudgetExhausted indicates that the workload is waiting
+	// for the next period of its LocalQueue budget.
+	WorkloadQuotaReservedReasonBudgetExhausted = "BudgetExhausted"
+
 	// WorkloadAdmittedReasonNoReservation indicates that the workload has no reservation.
 	WorkloadAdmittedReasonNoReservation = "NoReservation"
 
@@ -1013,6 +1157,14 @@ const (
 	// by one of the "base" reasons.
 	WorkloadPreempted = "Preempted"
 
+	// WorkloadPreemptionPending means that the Workload was selected for
+	// preemption, and is given its preemptionGracePeriodSeconds to checkpoint
+	// before it's evicted. The reason is the one of the upcoming Preempted
+	// condition. The condition is set to False, with the
+	// "PreemptionNoticeExpired" reason, when the Workload wasn't evicted after
+	// the grace period.
+	WorkloadPreemptionPending = "PreemptionPending"
+
 	// WorkloadRequeued means that the Workload was requeued due to eviction.
 	WorkloadRequeued = "Requeued"
 
@@ -1023,6 +1175,53 @@ const (
 	// WorkloadWaitingForReplacementPods means that Kueue doesn't observe all
 	// the Pods declared for the group.
 	WorkloadWaitingForReplacementPods = "WaitingForReplacementPods"
+
+	// WorkloadDeadlineUnattainable means that the pending Workload can no longer
+	// finish before the deadline declared in its kueue.x-k8s.io/deadline annotation.
+	// The possible reasons for this condition are:
+	// - "DeadlinePassed": the deadline has passed
+	// - "InsufficientTimeBeforeDeadline": the deadline is closer than the
+	//   maximumExecutionTimeSeconds of the Workload
+	WorkloadDeadlineUnattainable = "DeadlineUnattainable"
+
+	// WorkloadWaitingForDependencies means that the Workload is kept out of
+	// the queues until the Workloads listed in its dependsOn finish.
+	// The possible reasons for this condition are:
+	// - "DependenciesPending": some dependency didn't finish yet
+	// - "DependenciesFinished": all the dependencies finished successfully
+	// - "DependencyFailed": some dependency finished with a failure
+	WorkloadWaitingForDependencies = "WaitingForDependencies"
+)
+
+// Reasons for the WorkloadWaitingForDependencies condition.
+const (
+	// WorkloadDependenciesPending indicates that some dependency of the
+	// Workload didn't finish yet.
+	WorkloadDependenciesPending = "DependenciesPending"
+
+	// WorkloadDependenciesFinished indicates that all the dependencies of the
+	// Workload finished successfully.
+	WorkloadDependenciesFinished = "DependenciesFinished"
+
+	// WorkloadDependencyFailed indicates that some dependency of the Workload
+	// finished with a failure. It's also the reason of the deactivation of
+	// the Workload.
+	WorkloadDependencyFailed = "DependencyFailed"
+)
+
+// Reasons for the WorkloadDeadlineUnattainable condition.
+const (
+	// WorkloadDeadlinePassed indicates that the deadline of the pending
+	// Workload has passed.
+	WorkloadDeadlinePassed = "DeadlinePassed"
+
+	// WorkloadInsufficientTimeBeforeDeadline indicates that the Workload would
+	// not finish before its deadline even if it was admitted now.
+	WorkloadInsufficientTimeBeforeDeadline = "InsufficientTimeBeforeDeadline"
+
+	// WorkloadDeadlineAttainable indicates that the deadline of the Workload
+	// was extended, so it can be met again.
+	WorkloadDeadlineAttainable = "DeadlineAttainable"
 )
 
 // Reasons for the WorkloadPreemptionBlocked condition.
@@ -1109,6 +1308,10 @@ const (
 	// due to non-recoverable node failures.
 	WorkloadEvictedDueToNodeFailures = "NodeFailures"
 
+	// WorkloadEvictedByTASDefragmentation indicates that the workload was evicted
+	// to free a topology domain for a pending workload.
+	WorkloadEvictedByTASDefragmentation = "TASDefragmentation"
+
 	// WorkloadEvictedOnManagerCluster indicates the workload was evicted on the
 	// manager cluster.
 	WorkloadEvictedOnManagerCluster = "EvictedOnManagerCluster"
@@ -1145,6 +1348,12 @@ const (
 	// maximum execution time.
 	WorkloadMaximumExecutionTimeExceeded = "MaximumExecutionTimeExceeded"
 
+	// WorkloadPreemptionNoticeExpired indicates the reason for the
+	// PreemptionPending=False condition when the workload wasn't evicted after
+	// the grace period of its preemption notice, as no pending workload
+	// targets it anymore.
+	WorkloadPreemptionNoticeExpired = "PreemptionNoticeExpired"
+
 	// WorkloadWaitForStart indicates the reason for PodsReady=False condition
 	// when the pods have not been ready since admission, or the workload is not admitted.
 	WorkloadWaitForStart = "WaitForStart"
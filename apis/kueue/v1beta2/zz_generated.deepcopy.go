@@ -356,6 +356,21 @@ func (in *ClusterQueuePreemption) DeepCopyInto(out *ClusterQueuePreemption) {
 		*out = new(BorrowWithinCohort)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreemptionBudget != nil {
+		in, out := &in.PreemptionBudget, &out.PreemptionBudget
+		*out = new(PreemptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumRuntimeSeconds != nil {
+		in, out := &in.MinimumRuntimeSeconds, &out.MinimumRuntimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PriorityDecay != nil {
+		in, out := &in.PriorityDecay, &out.PriorityDecay
+		*out = new(PriorityDecay)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePreemption.
@@ -418,6 +433,11 @@ func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
 		*out = new(ConcurrentAdmissionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LocalQueueTemplate != nil {
+		in, out := &in.LocalQueueTemplate, &out.LocalQueueTemplate
+		*out = new(LocalQueueTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueSpec.
@@ -459,6 +479,11 @@ func (in *ClusterQueueStatus) DeepCopyInto(out *ClusterQueueStatus) {
 		*out = new(FairSharingStatus)
 		**out = **in
 	}
+	if in.PreemptionBudget != nil {
+		in, out := &in.PreemptionBudget, &out.PreemptionBudget
+		*out = new(PreemptionBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueStatus.
@@ -822,6 +847,59 @@ func (in *LocalQueueAdmissionFairSharingStatus) DeepCopy() *LocalQueueAdmissionF
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueBudget) DeepCopyInto(out *LocalQueueBudget) {
+	*out = *in
+	if in.ResourceHours != nil {
+		in, out := &in.ResourceHours, &out.ResourceHours
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueBudget.
+func (in *LocalQueueBudget) DeepCopy() *LocalQueueBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueBudgetStatus) DeepCopyInto(out *LocalQueueBudgetStatus) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	if in.ConsumedResourceHours != nil {
+		in, out := &in.ConsumedResourceHours, &out.ConsumedResourceHours
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.RemainingResourceHours != nil {
+		in, out := &in.RemainingResourceHours, &out.RemainingResourceHours
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueBudgetStatus.
+func (in *LocalQueueBudgetStatus) DeepCopy() *LocalQueueBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalQueueFairSharingStatus) DeepCopyInto(out *LocalQueueFairSharingStatus) {
 	*out = *in
@@ -896,6 +974,36 @@ func (in *LocalQueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueResourceRecommendations) DeepCopyInto(out *LocalQueueResourceRecommendations) {
+	*out = *in
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Recommended != nil {
+		in, out := &in.Recommended, &out.Recommended
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueResourceRecommendations.
+func (in *LocalQueueResourceRecommendations) DeepCopy() *LocalQueueResourceRecommendations {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueResourceRecommendations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalQueueResourceUsage) DeepCopyInto(out *LocalQueueResourceUsage) {
 	*out = *in
@@ -925,6 +1033,11 @@ func (in *LocalQueueSpec) DeepCopyInto(out *LocalQueueSpec) {
 		*out = new(FairSharing)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(LocalQueueBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueSpec.
@@ -966,6 +1079,16 @@ func (in *LocalQueueStatus) DeepCopyInto(out *LocalQueueStatus) {
 		*out = new(LocalQueueFairSharingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(LocalQueueBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRecommendations != nil {
+		in, out := &in.ResourceRecommendations, &out.ResourceRecommendations
+		*out = new(LocalQueueResourceRecommendations)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueStatus.
@@ -978,6 +1101,31 @@ func (in *LocalQueueStatus) DeepCopy() *LocalQueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueTemplate) DeepCopyInto(out *LocalQueueTemplate) {
+	*out = *in
+	if in.StopPolicy != nil {
+		in, out := &in.StopPolicy, &out.StopPolicy
+		*out = new(StopPolicy)
+		**out = **in
+	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueTemplate.
+func (in *LocalQueueTemplate) DeepCopy() *LocalQueueTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueCluster) DeepCopyInto(out *MultiKueueCluster) {
 	*out = *in
@@ -1005,6 +1153,37 @@ func (in *MultiKueueCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiKueueClusterHealth) DeepCopyInto(out *MultiKueueClusterHealth) {
+	*out = *in
+	if in.DispatchSuccessPercent != nil {
+		in, out := &in.DispatchSuccessPercent, &out.DispatchSuccessPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AverageAdmissionLatency != nil {
+		in, out := &in.AverageAdmissionLatency, &out.AverageAdmissionLatency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EvictionPercent != nil {
+		in, out := &in.EvictionPercent, &out.EvictionPercent
+		*out = new(int32)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterHealth.
+func (in *MultiKueueClusterHealth) DeepCopy() *MultiKueueClusterHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiKueueClusterHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueClusterList) DeepCopyInto(out *MultiKueueClusterList) {
 	*out = *in
@@ -1041,6 +1220,11 @@ func (in *MultiKueueClusterList) DeepCopyObject() runtime.Object {
 func (in *MultiKueueClusterSpec) DeepCopyInto(out *MultiKueueClusterSpec) {
 	*out = *in
 	in.ClusterSource.DeepCopyInto(&out.ClusterSource)
+	if in.Cordoned != nil {
+		in, out := &in.Cordoned, &out.Cordoned
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterSpec.
@@ -1063,6 +1247,11 @@ func (in *MultiKueueClusterStatus) DeepCopyInto(out *MultiKueueClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(MultiKueueClusterHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueClusterStatus.
@@ -1172,6 +1361,11 @@ func (in *PodSet) DeepCopyInto(out *PodSet) {
 		*out = new(PodSetTopologyRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueueName != nil {
+		in, out := &in.QueueName, &out.QueueName
+		*out = new(LocalQueueName)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSet.
@@ -1216,6 +1410,11 @@ func (in *PodSetAssignment) DeepCopyInto(out *PodSetAssignment) {
 		*out = new(DelayedTopologyRequestState)
 		**out = **in
 	}
+	if in.ClusterQueue != nil {
+		in, out := &in.ClusterQueue, &out.ClusterQueue
+		*out = new(ClusterQueueReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetAssignment.
@@ -1250,6 +1449,36 @@ func (in *PodSetRequest) DeepCopy() *PodSetRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetResourceRecommendation) DeepCopyInto(out *PodSetResourceRecommendation) {
+	*out = *in
+	if in.PeakUsage != nil {
+		in, out := &in.PeakUsage, &out.PeakUsage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetResourceRecommendation.
+func (in *PodSetResourceRecommendation) DeepCopy() *PodSetResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSetTopologyRequest) DeepCopyInto(out *PodSetTopologyRequest) {
 	*out = *in
@@ -1373,6 +1602,60 @@ func (in *PodsetSliceRequiredTopologyConstraint) DeepCopy() *PodsetSliceRequired
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreemptionBudget) DeepCopyInto(out *PreemptionBudget) {
+	*out = *in
+	out.Window = in.Window
+	if in.MaxWorkloads != nil {
+		in, out := &in.MaxWorkloads, &out.MaxWorkloads
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxResources != nil {
+		in, out := &in.MaxResources, &out.MaxResources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreemptionBudget.
+func (in *PreemptionBudget) DeepCopy() *PreemptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreemptionBudgetStatus) DeepCopyInto(out *PreemptionBudgetStatus) {
+	*out = *in
+	if in.PreemptedResources != nil {
+		in, out := &in.PreemptedResources, &out.PreemptedResources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LastPreemptionTime != nil {
+		in, out := &in.LastPreemptionTime, &out.LastPreemptionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreemptionBudgetStatus.
+func (in *PreemptionBudgetStatus) DeepCopy() *PreemptionBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreemptionGate) DeepCopyInto(out *PreemptionGate) {
 	*out = *in
@@ -1419,6 +1702,26 @@ func (in *PriorityClassRef) DeepCopy() *PriorityClassRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityDecay) DeepCopyInto(out *PriorityDecay) {
+	*out = *in
+	if in.MaxDecay != nil {
+		in, out := &in.MaxDecay, &out.MaxDecay
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityDecay.
+func (in *PriorityDecay) DeepCopy() *PriorityDecay {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityDecay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisioningRequestConfig) DeepCopyInto(out *ProvisioningRequestConfig) {
 	*out = *in
@@ -1584,6 +1887,155 @@ func (in *ProvisioningRequestRetryStrategy) DeepCopy() *ProvisioningRequestRetry
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaReservation) DeepCopyInto(out *QuotaReservation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaReservation.
+func (in *QuotaReservation) DeepCopy() *QuotaReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaReservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaReservationList) DeepCopyInto(out *QuotaReservationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuotaReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaReservationList.
+func (in *QuotaReservationList) DeepCopy() *QuotaReservationList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaReservationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaReservationSpec) DeepCopyInto(out *QuotaReservationSpec) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ReservedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.RampUpSeconds != nil {
+		in, out := &in.RampUpSeconds, &out.RampUpSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaReservationSpec.
+func (in *QuotaReservationSpec) DeepCopy() *QuotaReservationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaReservationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaReservationStatus) DeepCopyInto(out *QuotaReservationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaReservationStatus.
+func (in *QuotaReservationStatus) DeepCopy() *QuotaReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSchedule) DeepCopyInto(out *QuotaSchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.NominalQuota != nil {
+		in, out := &in.NominalQuota, &out.NominalQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BorrowingLimit != nil {
+		in, out := &in.BorrowingLimit, &out.BorrowingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LendingLimit != nil {
+		in, out := &in.LendingLimit, &out.LendingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSchedule.
+func (in *QuotaSchedule) DeepCopy() *QuotaSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
 	*out = *in
@@ -1623,6 +2075,22 @@ func (in *RequeueState) DeepCopy() *RequeueState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedResource) DeepCopyInto(out *ReservedResource) {
+	*out = *in
+	out.Quantity = in.Quantity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedResource.
+func (in *ReservedResource) DeepCopy() *ReservedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceFlavor) DeepCopyInto(out *ResourceFlavor) {
 	*out = *in
@@ -1710,6 +2178,13 @@ func (in *ResourceFlavorSpec) DeepCopyInto(out *ResourceFlavorSpec) {
 		*out = new(TopologyReference)
 		**out = **in
 	}
+	if in.Prices != nil {
+		in, out := &in.Prices, &out.Prices
+		*out = make([]ResourcePrice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorSpec.
@@ -1749,6 +2224,27 @@ func (in *ResourceGroup) DeepCopy() *ResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePrice) DeepCopyInto(out *ResourcePrice) {
+	*out = *in
+	out.PricePerHour = in.PricePerHour.DeepCopy()
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePrice.
+func (in *ResourcePrice) DeepCopy() *ResourcePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
 	*out = *in
@@ -1763,6 +2259,13 @@ func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]QuotaSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuota.
@@ -1968,6 +2471,21 @@ func (in *TopologyAssignmentSlicePodCounts) DeepCopy() *TopologyAssignmentSliceP
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDeviceAttribute) DeepCopyInto(out *TopologyDeviceAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDeviceAttribute.
+func (in *TopologyDeviceAttribute) DeepCopy() *TopologyDeviceAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDeviceAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyInfo) DeepCopyInto(out *TopologyInfo) {
 	*out = *in
@@ -1991,6 +2509,11 @@ func (in *TopologyInfo) DeepCopy() *TopologyInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyLevel) DeepCopyInto(out *TopologyLevel) {
 	*out = *in
+	if in.DeviceAttribute != nil {
+		in, out := &in.DeviceAttribute, &out.DeviceAttribute
+		*out = new(TopologyDeviceAttribute)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLevel.
@@ -2041,7 +2564,9 @@ func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 	if in.Levels != nil {
 		in, out := &in.Levels, &out.Levels
 		*out = make([]TopologyLevel, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2097,6 +2622,21 @@ func (in *Workload) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadDependency) DeepCopyInto(out *WorkloadDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadDependency.
+func (in *WorkloadDependency) DeepCopy() *WorkloadDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadList) DeepCopyInto(out *WorkloadList) {
 	*out = *in
@@ -2129,6 +2669,22 @@ func (in *WorkloadList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadMigration) DeepCopyInto(out *WorkloadMigration) {
+	*out = *in
+	in.EvictionTime.DeepCopyInto(&out.EvictionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadMigration.
+func (in *WorkloadMigration) DeepCopy() *WorkloadMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadPriorityClass) DeepCopyInto(out *WorkloadPriorityClass) {
 	*out = *in
@@ -2231,11 +2787,21 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PreemptionGracePeriodSeconds != nil {
+		in, out := &in.PreemptionGracePeriodSeconds, &out.PreemptionGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.PreemptionGates != nil {
 		in, out := &in.PreemptionGates, &out.PreemptionGates
 		*out = make([]PreemptionGate, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]WorkloadDependency, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
@@ -2319,6 +2885,20 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceRecommendations != nil {
+		in, out := &in.ResourceRecommendations, &out.ResourceRecommendations
+		*out = make([]PodSetResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MigrationHistory != nil {
+		in, out := &in.MigrationHistory, &out.MigrationHistory
+		*out = make([]WorkloadMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
@@ -44,7 +44,7 @@ var (
 )
 
 func addKnownTypes(scheme *runtime.Scheme) error {
-	scheme.AddKnownTypes(SchemeGroupVersion, &PendingWorkloadsSummary{}, &PendingWorkloadOptions{})
+	scheme.AddKnownTypes(SchemeGroupVersion, &PendingWorkloadsSummary{}, &PendingWorkloadOptions{}, &PreemptionExplanation{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
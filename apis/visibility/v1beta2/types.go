@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -106,3 +107,92 @@ type PendingWorkloadOptions struct {
 	// Limit indicates max number of pending workloads that should be fetched. 1000 by default
 	Limit int64 `json:"limit,omitempty"`
 }
+
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:openapi-gen=true
+// +genclient:method=GetPreemptionExplanation,verb=get,subresource=preemptionexplanation,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.PreemptionExplanation
+type Workload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Explanation PreemptionExplanation `json:"preemptionExplanation"`
+}
+
+// +kubebuilder:object:root=true
+type WorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Workload `json:"items"`
+}
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// PreemptionExplanation is a dry run of the flavor assignment and the preemption
+// target selection for a pending workload against the current state of the cluster.
+type PreemptionExplanation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ClusterQueue indicates the name of the ClusterQueue the workload is queued in
+	ClusterQueue v1beta2.ClusterQueueReference `json:"clusterQueue"`
+
+	// Mode indicates the worst flavor assignment mode among the podSets,
+	// one of NoFit, Preempt or Fit
+	Mode string `json:"mode"`
+
+	// Message explains why the workload doesn't fit without preemption, if it doesn't
+	Message string `json:"message,omitempty"`
+
+	// PodSets indicates the flavors the assigner would choose for each podSet
+	PodSets []PodSetFlavors `json:"podSets,omitempty"`
+
+	// Victims indicates the workloads that would be preempted to make room for the workload
+	Victims []PreemptionCandidate `json:"victims,omitempty"`
+
+	// RejectedCandidates indicates the workloads using the resources that need
+	// preemption, which the preemption policies don't allow to preempt
+	RejectedCandidates []PreemptionCandidate `json:"rejectedCandidates,omitempty"`
+}
+
+// PodSetFlavors is the flavor assignment of a podSet.
+type PodSetFlavors struct {
+	// Name indicates the name of the podSet
+	Name v1beta2.PodSetReference `json:"name"`
+
+	// Flavors indicates the flavor assigned to each resource of the podSet
+	Flavors []ResourceFlavorAssignment `json:"flavors,omitempty"`
+}
+
+// ResourceFlavorAssignment is the flavor assigned to a resource.
+type ResourceFlavorAssignment struct {
+	// Resource indicates the name of the resource
+	Resource corev1.ResourceName `json:"resource"`
+
+	// Flavor indicates the name of the ResourceFlavor assigned to the resource
+	Flavor v1beta2.ResourceFlavorReference `json:"flavor"`
+
+	// Mode indicates whether the resource fits in the flavor, one of NoFit, Preempt or Fit
+	Mode string `json:"mode"`
+}
+
+// PreemptionCandidate is a user-facing representation of an admitted workload
+// considered for preemption.
+type PreemptionCandidate struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ClusterQueue indicates the name of the ClusterQueue the workload is admitted in
+	ClusterQueue v1beta2.ClusterQueueReference `json:"clusterQueue"`
+
+	// Priority indicates the workload's priority
+	Priority int32 `json:"priority"`
+
+	// Reason indicates why the workload would be preempted, or which policy
+	// blocks its preemption
+	Reason string `json:"reason"`
+
+	// Message is a human readable explanation of the reason
+	Message string `json:"message,omitempty"`
+}
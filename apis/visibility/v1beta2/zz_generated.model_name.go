@@ -55,3 +55,33 @@ func (in PendingWorkloadOptions) OpenAPIModelName() string {
 func (in PendingWorkloadsSummary) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.PendingWorkloadsSummary"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PodSetFlavors) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.PodSetFlavors"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PreemptionCandidate) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.PreemptionCandidate"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in PreemptionExplanation) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.PreemptionExplanation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ResourceFlavorAssignment) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.ResourceFlavorAssignment"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Workload) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.Workload"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkloadList) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.WorkloadList"
+}
@@ -77,6 +77,39 @@ type ClusterQueuePreemptionApplyConfiguration struct {
 	// either have a lower priority than the pending workload or equal priority
 	// and are newer than the pending workload.
 	WithinClusterQueue *kueuev1beta2.PreemptionPolicy `json:"withinClusterQueue,omitempty"`
+	// preemptionBudget limits the preemptions issued by the Workloads of this
+	// ClusterQueue within a sliding time window. Once the budget is exhausted,
+	// the pending Workloads which need preemption wait until the earlier
+	// preemptions fall out of the window.
+	// This field is in alpha stage. To use this field, the PreemptionBudgets
+	// feature gate must be enabled.
+	PreemptionBudget *PreemptionBudgetApplyConfiguration `json:"preemptionBudget,omitempty"`
+	// minimumRuntimeSeconds, if provided, protects the Workloads admitted to
+	// this ClusterQueue from preemption until they've held their quota
+	// reservation for this many seconds, so that a preemption doesn't waste
+	// the cost of pulling their images and starting them.
+	// This field is in alpha stage. To use this field, the
+	// PreemptionMinimumRuntime feature gate must be enabled.
+	MinimumRuntimeSeconds *int32 `json:"minimumRuntimeSeconds,omitempty"`
+	// minimumRuntimeExemption determines which preemptions can target the
+	// Workloads protected by minimumRuntimeSeconds. The possible values are:
+	//
+	// - `ReclaimNominalQuota` (default): Workloads from other ClusterQueues
+	// in the cohort can preempt the protected Workloads when they reclaim
+	// the nominal quota of their ClusterQueue.
+	// - `None`: no preemption can target the protected Workloads.
+	//
+	// This field is in alpha stage. To use this field, the
+	// PreemptionMinimumRuntime feature gate must be enabled.
+	MinimumRuntimeExemption *kueuev1beta2.MinimumRuntimeExemption `json:"minimumRuntimeExemption,omitempty"`
+	// priorityDecay, if provided, lowers the effective priority of the
+	// Workloads admitted to this ClusterQueue as they run, so that pending
+	// Workloads of the same priority can preempt them, with any
+	// withinClusterQueue policy other than Never. The decay is reset when a
+	// Workload is evicted.
+	// This field is in alpha stage. To use this field, the PriorityDecay
+	// feature gate must be enabled.
+	PriorityDecay *PriorityDecayApplyConfiguration `json:"priorityDecay,omitempty"`
 }
 
 // ClusterQueuePreemptionApplyConfiguration constructs a declarative configuration of the ClusterQueuePreemption type for use with
@@ -108,3 +141,35 @@ func (b *ClusterQueuePreemptionApplyConfiguration) WithWithinClusterQueue(value
 	b.WithinClusterQueue = &value
 	return b
 }
+
+// WithPreemptionBudget sets the PreemptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionBudget field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithPreemptionBudget(value *PreemptionBudgetApplyConfiguration) *ClusterQueuePreemptionApplyConfiguration {
+	b.PreemptionBudget = value
+	return b
+}
+
+// WithMinimumRuntimeSeconds sets the MinimumRuntimeSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinimumRuntimeSeconds field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithMinimumRuntimeSeconds(value int32) *ClusterQueuePreemptionApplyConfiguration {
+	b.MinimumRuntimeSeconds = &value
+	return b
+}
+
+// WithMinimumRuntimeExemption sets the MinimumRuntimeExemption field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinimumRuntimeExemption field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithMinimumRuntimeExemption(value kueuev1beta2.MinimumRuntimeExemption) *ClusterQueuePreemptionApplyConfiguration {
+	b.MinimumRuntimeExemption = &value
+	return b
+}
+
+// WithPriorityDecay sets the PriorityDecay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityDecay field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithPriorityDecay(value *PriorityDecayApplyConfiguration) *ClusterQueuePreemptionApplyConfiguration {
+	b.PriorityDecay = value
+	return b
+}
@@ -57,6 +57,13 @@ type ClusterQueueSpecApplyConfiguration struct {
 	// - BestEffortFIFO: workloads are ordered by creation time,
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
+	// - EarliestDeadlineFirst: workloads are ordered by the deadline declared
+	// in their kueue.x-k8s.io/deadline annotation, earliest first, with
+	// priority and creation time as tie-breakers. Workloads without a deadline
+	// are ordered after the ones with a deadline. As in BestEffortFIFO, workloads
+	// that can't be admitted will not block admitting the next ones.
+	// This strategy requires the DeadlineAwareQueueing feature gate, otherwise
+	// it behaves as BestEffortFIFO.
 	QueueingStrategy *kueuev1beta2.QueueingStrategy `json:"queueingStrategy,omitempty"`
 	// namespaceSelector defines which namespaces are allowed to submit workloads to
 	// this clusterQueue. Beyond this basic support for policy, a policy agent like
@@ -91,6 +98,14 @@ type ClusterQueueSpecApplyConfiguration struct {
 	// Additionally after the admission, Workloads can still try to pursue capacity on the more preferable flavors while running.
 	// It enables them to migrate to more preferable, whenever capacity appears.
 	ConcurrentAdmissionPolicy *ConcurrentAdmissionPolicyApplyConfiguration `json:"concurrentAdmissionPolicy,omitempty"`
+	// localQueueTemplate defines the LocalQueues that Kueue provisions for
+	// this ClusterQueue in every namespace matching its namespaceSelector.
+	// The provisioned LocalQueues are deleted once their namespace stops
+	// matching, the template is removed or the ClusterQueue is deleted, and
+	// they have no unfinished Workloads.
+	// This field is in alpha stage. To use this field, the
+	// LocalQueueProvisioning feature gate must be enabled.
+	LocalQueueTemplate *LocalQueueTemplateApplyConfiguration `json:"localQueueTemplate,omitempty"`
 }
 
 // ClusterQueueSpecApplyConfiguration constructs a declarative configuration of the ClusterQueueSpec type for use with
@@ -191,3 +206,11 @@ func (b *ClusterQueueSpecApplyConfiguration) WithConcurrentAdmissionPolicy(value
 	b.ConcurrentAdmissionPolicy = value
 	return b
 }
+
+// WithLocalQueueTemplate sets the LocalQueueTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalQueueTemplate field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithLocalQueueTemplate(value *LocalQueueTemplateApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.LocalQueueTemplate = value
+	return b
+}
@@ -51,6 +51,10 @@ type ClusterQueueStatusApplyConfiguration struct {
 	// when participating in Fair Sharing.
 	// This is recorded only when Fair Sharing is enabled in the Kueue configuration.
 	FairSharing *FairSharingStatusApplyConfiguration `json:"fairSharing,omitempty"`
+	// preemptionBudget contains the consumption of the preemption budget
+	// within the current window. It is recorded only when the ClusterQueue
+	// has a preemption budget.
+	PreemptionBudget *PreemptionBudgetStatusApplyConfiguration `json:"preemptionBudget,omitempty"`
 }
 
 // ClusterQueueStatusApplyConfiguration constructs a declarative configuration of the ClusterQueueStatus type for use with
@@ -129,3 +133,11 @@ func (b *ClusterQueueStatusApplyConfiguration) WithFairSharing(value *FairSharin
 	b.FairSharing = value
 	return b
 }
+
+// WithPreemptionBudget sets the PreemptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionBudget field is set to the value of the last call.
+func (b *ClusterQueueStatusApplyConfiguration) WithPreemptionBudget(value *PreemptionBudgetStatusApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	b.PreemptionBudget = value
+	return b
+}
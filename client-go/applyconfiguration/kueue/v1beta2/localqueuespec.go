@@ -42,6 +42,11 @@ type LocalQueueSpecApplyConfiguration struct {
 	// participating in AdmissionFairSharing.  The values are only relevant
 	// if AdmissionFairSharing is enabled in the Kueue configuration.
 	FairSharing *FairSharingApplyConfiguration `json:"fairSharing,omitempty"`
+	// budget limits the resource-time that the workloads of the LocalQueue
+	// can consume within a calendar period, for example GPU-hours per month.
+	// This field is in alpha stage. To use this field, the LocalQueueBudgets
+	// feature gate must be enabled.
+	Budget *LocalQueueBudgetApplyConfiguration `json:"budget,omitempty"`
 }
 
 // LocalQueueSpecApplyConfiguration constructs a declarative configuration of the LocalQueueSpec type for use with
@@ -73,3 +78,11 @@ func (b *LocalQueueSpecApplyConfiguration) WithFairSharing(value *FairSharingApp
 	b.FairSharing = value
 	return b
 }
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *LocalQueueSpecApplyConfiguration) WithBudget(value *LocalQueueBudgetApplyConfiguration) *LocalQueueSpecApplyConfiguration {
+	b.Budget = value
+	return b
+}
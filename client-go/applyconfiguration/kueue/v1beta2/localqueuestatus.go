@@ -47,6 +47,12 @@ type LocalQueueStatusApplyConfiguration struct {
 	FlavorsUsage []LocalQueueFlavorUsageApplyConfiguration `json:"flavorsUsage,omitempty"`
 	// fairSharing contains the information about the current status of fair sharing.
 	FairSharing *LocalQueueFairSharingStatusApplyConfiguration `json:"fairSharing,omitempty"`
+	// budget contains the consumption of the budget in the current period.
+	Budget *LocalQueueBudgetStatusApplyConfiguration `json:"budget,omitempty"`
+	// resourceRecommendations aggregates the resourceRecommendations of the
+	// admitted workloads in the LocalQueue.
+	// Requires enabling the WorkloadRightSizing feature gate.
+	ResourceRecommendations *LocalQueueResourceRecommendationsApplyConfiguration `json:"resourceRecommendations,omitempty"`
 }
 
 // LocalQueueStatusApplyConfiguration constructs a declarative configuration of the LocalQueueStatus type for use with
@@ -125,3 +131,19 @@ func (b *LocalQueueStatusApplyConfiguration) WithFairSharing(value *LocalQueueFa
 	b.FairSharing = value
 	return b
 }
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *LocalQueueStatusApplyConfiguration) WithBudget(value *LocalQueueBudgetStatusApplyConfiguration) *LocalQueueStatusApplyConfiguration {
+	b.Budget = value
+	return b
+}
+
+// WithResourceRecommendations sets the ResourceRecommendations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceRecommendations field is set to the value of the last call.
+func (b *LocalQueueStatusApplyConfiguration) WithResourceRecommendations(value *LocalQueueResourceRecommendationsApplyConfiguration) *LocalQueueStatusApplyConfiguration {
+	b.ResourceRecommendations = value
+	return b
+}
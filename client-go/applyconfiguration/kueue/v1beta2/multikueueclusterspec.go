@@ -23,6 +23,11 @@ package v1beta2
 type MultiKueueClusterSpecApplyConfiguration struct {
 	// clusterSource is the source to connect to the cluster.
 	ClusterSource *ClusterSourceApplyConfiguration `json:"clusterSource,omitempty"`
+	// cordoned, when true, stops the manager from dispatching new workloads
+	// to the cluster, for example during a maintenance window. The workloads
+	// already admitted by the cluster keep running and being synchronized.
+	// Requires enabling the MultiKueueClusterHealth feature gate.
+	Cordoned *bool `json:"cordoned,omitempty"`
 }
 
 // MultiKueueClusterSpecApplyConfiguration constructs a declarative configuration of the MultiKueueClusterSpec type for use with
@@ -38,3 +43,11 @@ func (b *MultiKueueClusterSpecApplyConfiguration) WithClusterSource(value *Clust
 	b.ClusterSource = value
 	return b
 }
+
+// WithCordoned sets the Cordoned field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cordoned field is set to the value of the last call.
+func (b *MultiKueueClusterSpecApplyConfiguration) WithCordoned(value bool) *MultiKueueClusterSpecApplyConfiguration {
+	b.Cordoned = &value
+	return b
+}
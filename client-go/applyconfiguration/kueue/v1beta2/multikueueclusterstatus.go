@@ -29,6 +29,10 @@ type MultiKueueClusterStatusApplyConfiguration struct {
 	// current state.
 	// conditions are limited to 16 elements.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// health summarizes how the cluster handled the workloads dispatched to
+	// it during the observation window configured in the manager.
+	// Requires enabling the MultiKueueClusterHealth feature gate.
+	Health *MultiKueueClusterHealthApplyConfiguration `json:"health,omitempty"`
 }
 
 // MultiKueueClusterStatusApplyConfiguration constructs a declarative configuration of the MultiKueueClusterStatus type for use with
@@ -49,3 +53,11 @@ func (b *MultiKueueClusterStatusApplyConfiguration) WithConditions(values ...*v1
 	}
 	return b
 }
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *MultiKueueClusterStatusApplyConfiguration) WithHealth(value *MultiKueueClusterHealthApplyConfiguration) *MultiKueueClusterStatusApplyConfiguration {
+	b.Health = value
+	return b
+}
@@ -56,6 +56,16 @@ type PodSetApplyConfiguration struct {
 	MinCount *int32 `json:"minCount,omitempty"`
 	// topologyRequest defines the topology request for the PodSet.
 	TopologyRequest *PodSetTopologyRequestApplyConfiguration `json:"topologyRequest,omitempty"`
+	// queueName is the name of the LocalQueue, in the Workload's namespace,
+	// whose ClusterQueue provides the quota for this PodSet. When omitted, the
+	// PodSet uses the Workload's .spec.queueName.
+	//
+	// The ClusterQueue of this LocalQueue must belong to the same cohort tree
+	// as the ClusterQueue of the Workload. The Workload is admitted only when
+	// all its PodSets fit in their ClusterQueues at the same time.
+	//
+	// This is an alpha field and requires enabling the MultiQueueWorkloads feature gate.
+	QueueName *kueuev1beta2.LocalQueueName `json:"queueName,omitempty"`
 }
 
 // PodSetApplyConfiguration constructs a declarative configuration of the PodSet type for use with
@@ -103,3 +113,11 @@ func (b *PodSetApplyConfiguration) WithTopologyRequest(value *PodSetTopologyRequ
 	b.TopologyRequest = value
 	return b
 }
+
+// WithQueueName sets the QueueName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueueName field is set to the value of the last call.
+func (b *PodSetApplyConfiguration) WithQueueName(value kueuev1beta2.LocalQueueName) *PodSetApplyConfiguration {
+	b.QueueName = &value
+	return b
+}
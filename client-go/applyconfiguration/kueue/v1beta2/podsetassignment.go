@@ -160,6 +160,13 @@ type PodSetAssignmentApplyConfiguration struct {
 	// least one PodSet which has delayedTopologyRequest=true and without
 	// topologyAssignment.
 	DelayedTopologyRequest *kueuev1beta2.DelayedTopologyRequestState `json:"delayedTopologyRequest,omitempty"`
+	// clusterQueue is the name of the ClusterQueue whose quota is charged for
+	// this podSet. It is only set when the podSet is charged to a ClusterQueue
+	// other than .status.admission.clusterQueue, which happens when the podSet
+	// specifies its own queueName.
+	//
+	// This is an alpha field and requires enabling the MultiQueueWorkloads feature gate.
+	ClusterQueue *kueuev1beta2.ClusterQueueReference `json:"clusterQueue,omitempty"`
 }
 
 // PodSetAssignmentApplyConfiguration constructs a declarative configuration of the PodSetAssignment type for use with
@@ -221,3 +228,11 @@ func (b *PodSetAssignmentApplyConfiguration) WithDelayedTopologyRequest(value ku
 	b.DelayedTopologyRequest = &value
 	return b
 }
+
+// WithClusterQueue sets the ClusterQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterQueue field is set to the value of the last call.
+func (b *PodSetAssignmentApplyConfiguration) WithClusterQueue(value kueuev1beta2.ClusterQueueReference) *PodSetAssignmentApplyConfiguration {
+	b.ClusterQueue = &value
+	return b
+}
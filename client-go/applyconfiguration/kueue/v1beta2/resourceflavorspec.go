@@ -65,6 +65,13 @@ type ResourceFlavorSpecApplyConfiguration struct {
 	// When specified, it enables scraping of the topology information from the
 	// nodes matching to the Resource Flavor node labels.
 	TopologyName *kueuev1beta2.TopologyReference `json:"topologyName,omitempty"`
+	// prices define the cost of using the resources of this flavor.
+	// When the WorkloadCostAccounting feature is enabled, Kueue uses them to
+	// accumulate the cost of the workloads admitted in this flavor over their
+	// execution.
+	//
+	// prices can be up to 64 elements.
+	Prices []ResourcePriceApplyConfiguration `json:"prices,omitempty"`
 }
 
 // ResourceFlavorSpecApplyConfiguration constructs a declarative configuration of the ResourceFlavorSpec type for use with
@@ -120,3 +127,16 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithTopologyName(value kueuev1bet
 	b.TopologyName = &value
 	return b
 }
+
+// WithPrices adds the given value to the Prices field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prices field.
+func (b *ResourceFlavorSpecApplyConfiguration) WithPrices(values ...*ResourcePriceApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPrices")
+		}
+		b.Prices = append(b.Prices, *values[i])
+	}
+	return b
+}
@@ -61,6 +61,15 @@ type ResourceQuotaApplyConfiguration struct {
 	// lendingLimit must be null if spec.cohortName is empty.
 	// This field is in beta stage and is enabled by default.
 	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+	// schedules is a list of recurring time windows during which the
+	// nominalQuota, borrowingLimit and lendingLimit of this [flavor, resource]
+	// combination are overridden. When several windows are active at the same
+	// time, the first one in the list takes precedence. Outside of any window,
+	// the values above apply.
+	// There could be up to 8 schedules.
+	//
+	// This field is in alpha stage and requires the QuotaSchedules feature gate.
+	Schedules []QuotaScheduleApplyConfiguration `json:"schedules,omitempty"`
 }
 
 // ResourceQuotaApplyConfiguration constructs a declarative configuration of the ResourceQuota type for use with
@@ -100,3 +109,16 @@ func (b *ResourceQuotaApplyConfiguration) WithLendingLimit(value resource.Quanti
 	b.LendingLimit = &value
 	return b
 }
+
+// WithSchedules adds the given value to the Schedules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Schedules field.
+func (b *ResourceQuotaApplyConfiguration) WithSchedules(values ...*QuotaScheduleApplyConfiguration) *ResourceQuotaApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSchedules")
+		}
+		b.Schedules = append(b.Schedules, *values[i])
+	}
+	return b
+}
@@ -30,6 +30,21 @@ type TopologyLevelApplyConfiguration struct {
 	// - cloud.provider.com/topology-block
 	// - cloud.provider.com/topology-rack
 	NodeLabel *string `json:"nodeLabel,omitempty"`
+	// deviceAttribute indicates that the values of this level are read from
+	// an attribute of the DRA devices published in ResourceSlices, rather
+	// than from the node label. This allows to use device interconnects, such
+	// as an NVLink domain, as topology levels. The nodeLabel remains the name
+	// of the level, used in PodSet topology requests and assignments.
+	//
+	// A node belongs to a domain of this level only when all the devices the
+	// driver publishes for the node report the same attribute value. Since
+	// Kueue places pods on nodes, the level must not be below the node: its
+	// domains are groups of nodes, and kubernetes.io/hostname is required as
+	// the lowest level of the topology.
+	//
+	// This field is alpha-level and is only honored when the
+	// TASDeviceTopologyLevels feature gate is enabled.
+	DeviceAttribute *TopologyDeviceAttributeApplyConfiguration `json:"deviceAttribute,omitempty"`
 }
 
 // TopologyLevelApplyConfiguration constructs a declarative configuration of the TopologyLevel type for use with
@@ -45,3 +60,11 @@ func (b *TopologyLevelApplyConfiguration) WithNodeLabel(value string) *TopologyL
 	b.NodeLabel = &value
 	return b
 }
+
+// WithDeviceAttribute sets the DeviceAttribute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeviceAttribute field is set to the value of the last call.
+func (b *TopologyLevelApplyConfiguration) WithDeviceAttribute(value *TopologyDeviceAttributeApplyConfiguration) *TopologyLevelApplyConfiguration {
+	b.DeviceAttribute = value
+	return b
+}
@@ -57,10 +57,29 @@ type WorkloadSpecApplyConfiguration struct {
 	//
 	// If unspecified, no execution time limit is enforced on the Workload.
 	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
+	// preemptionGracePeriodSeconds if provided, determines the time, in seconds,
+	// the workload is given to checkpoint once it's notified of its preemption
+	// through the PreemptionPending condition, before it's evicted. The
+	// workload is evicted earlier if it acknowledges the checkpoint with the
+	// kueue.x-k8s.io/checkpoint-acknowledged annotation.
+	//
+	// If unspecified, the workload is evicted as soon as it's preempted.
+	// This field is in alpha stage. To use this field, the
+	// CheckpointAwarePreemption feature gate must be enabled.
+	PreemptionGracePeriodSeconds *int32 `json:"preemptionGracePeriodSeconds,omitempty"`
 	// preemptionGates is a list of gates governing whether the workload
 	// can trigger preemptions.
 	// The gates are closed by default.
 	PreemptionGates []PreemptionGateApplyConfiguration `json:"preemptionGates,omitempty"`
+	// dependsOn is a list of Workloads in the same namespace that must finish
+	// successfully before this workload can be queued for admission.
+	// While any dependency is not finished, the workload is kept out of the
+	// queues with the WaitingForDependencies condition. If any dependency
+	// finishes with a failure, the workload is deactivated.
+	// At most 8 dependencies can be listed.
+	// This field is in alpha stage. To use this field, the
+	// WorkloadDependencies feature gate must be enabled.
+	DependsOn []WorkloadDependencyApplyConfiguration `json:"dependsOn,omitempty"`
 }
 
 // WorkloadSpecApplyConfiguration constructs a declarative configuration of the WorkloadSpec type for use with
@@ -122,6 +141,14 @@ func (b *WorkloadSpecApplyConfiguration) WithMaximumExecutionTimeSeconds(value i
 	return b
 }
 
+// WithPreemptionGracePeriodSeconds sets the PreemptionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionGracePeriodSeconds field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithPreemptionGracePeriodSeconds(value int32) *WorkloadSpecApplyConfiguration {
+	b.PreemptionGracePeriodSeconds = &value
+	return b
+}
+
 // WithPreemptionGates adds the given value to the PreemptionGates field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the PreemptionGates field.
@@ -134,3 +161,16 @@ func (b *WorkloadSpecApplyConfiguration) WithPreemptionGates(values ...*Preempti
 	}
 	return b
 }
+
+// WithDependsOn adds the given value to the DependsOn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependsOn field.
+func (b *WorkloadSpecApplyConfiguration) WithDependsOn(values ...*WorkloadDependencyApplyConfiguration) *WorkloadSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependsOn")
+		}
+		b.DependsOn = append(b.DependsOn, *values[i])
+	}
+	return b
+}
@@ -81,6 +81,18 @@ type WorkloadStatusApplyConfiguration struct {
 	// preemptionGates is a list of states of gates governing whether the workload
 	// can trigger preemptions.
 	PreemptionGates []PreemptionGateStateApplyConfiguration `json:"preemptionGates,omitempty"`
+	// resourceRecommendations holds, per podSet, the requests recommended
+	// for its pods based on the usage observed through the metrics API
+	// while the workload is admitted.
+	// Requires enabling the WorkloadRightSizing feature gate.
+	ResourceRecommendations []PodSetResourceRecommendationApplyConfiguration `json:"resourceRecommendations,omitempty"`
+	// migrationHistory lists, from the oldest, the MultiKueue worker clusters
+	// that evicted the workload after admitting it, and why.
+	// The manager doesn't dispatch the workload again to a cluster for a
+	// cooldown period after it evicted the workload.
+	// Only the latest entries are kept.
+	// Requires enabling the MultiKueueWorkloadMigration feature gate.
+	MigrationHistory []WorkloadMigrationApplyConfiguration `json:"migrationHistory,omitempty"`
 }
 
 // WorkloadStatusApplyConfiguration constructs a declarative configuration of the WorkloadStatus type for use with
@@ -216,3 +228,29 @@ func (b *WorkloadStatusApplyConfiguration) WithPreemptionGates(values ...*Preemp
 	}
 	return b
 }
+
+// WithResourceRecommendations adds the given value to the ResourceRecommendations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourceRecommendations field.
+func (b *WorkloadStatusApplyConfiguration) WithResourceRecommendations(values ...*PodSetResourceRecommendationApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResourceRecommendations")
+		}
+		b.ResourceRecommendations = append(b.ResourceRecommendations, *values[i])
+	}
+	return b
+}
+
+// WithMigrationHistory adds the given value to the MigrationHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MigrationHistory field.
+func (b *WorkloadStatusApplyConfiguration) WithMigrationHistory(values ...*WorkloadMigrationApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMigrationHistory")
+		}
+		b.MigrationHistory = append(b.MigrationHistory, *values[i])
+	}
+	return b
+}
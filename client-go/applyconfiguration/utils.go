@@ -236,18 +236,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.LocalQueueApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueAdmissionFairSharingStatus"):
 		return &kueuev1beta2.LocalQueueAdmissionFairSharingStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueBudget"):
+		return &kueuev1beta2.LocalQueueBudgetApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueBudgetStatus"):
+		return &kueuev1beta2.LocalQueueBudgetStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueFairSharingStatus"):
 		return &kueuev1beta2.LocalQueueFairSharingStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueFlavorUsage"):
 		return &kueuev1beta2.LocalQueueFlavorUsageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueResourceRecommendations"):
+		return &kueuev1beta2.LocalQueueResourceRecommendationsApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueResourceUsage"):
 		return &kueuev1beta2.LocalQueueResourceUsageApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueSpec"):
 		return &kueuev1beta2.LocalQueueSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueStatus"):
 		return &kueuev1beta2.LocalQueueStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueTemplate"):
+		return &kueuev1beta2.LocalQueueTemplateApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueCluster"):
 		return &kueuev1beta2.MultiKueueClusterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterHealth"):
+		return &kueuev1beta2.MultiKueueClusterHealthApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterSpec"):
 		return &kueuev1beta2.MultiKueueClusterSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterStatus"):
@@ -262,18 +272,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.PodSetAssignmentApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetRequest"):
 		return &kueuev1beta2.PodSetRequestApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PodSetResourceRecommendation"):
+		return &kueuev1beta2.PodSetResourceRecommendationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodsetSliceRequiredTopologyConstraint"):
 		return &kueuev1beta2.PodsetSliceRequiredTopologyConstraintApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetTopologyRequest"):
 		return &kueuev1beta2.PodSetTopologyRequestApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetUpdate"):
 		return &kueuev1beta2.PodSetUpdateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreemptionBudget"):
+		return &kueuev1beta2.PreemptionBudgetApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PreemptionBudgetStatus"):
+		return &kueuev1beta2.PreemptionBudgetStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PreemptionGate"):
 		return &kueuev1beta2.PreemptionGateApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PreemptionGateState"):
 		return &kueuev1beta2.PreemptionGateStateApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PriorityClassRef"):
 		return &kueuev1beta2.PriorityClassRefApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PriorityDecay"):
+		return &kueuev1beta2.PriorityDecayApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ProvisioningRequestConfig"):
 		return &kueuev1beta2.ProvisioningRequestConfigApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ProvisioningRequestConfigSpec"):
@@ -284,16 +302,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.ProvisioningRequestPodSetUpdatesNodeSelectorApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ProvisioningRequestRetryStrategy"):
 		return &kueuev1beta2.ProvisioningRequestRetryStrategyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaReservation"):
+		return &kueuev1beta2.QuotaReservationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaReservationSpec"):
+		return &kueuev1beta2.QuotaReservationSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaReservationStatus"):
+		return &kueuev1beta2.QuotaReservationStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaSchedule"):
+		return &kueuev1beta2.QuotaScheduleApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ReclaimablePod"):
 		return &kueuev1beta2.ReclaimablePodApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RequeueState"):
 		return &kueuev1beta2.RequeueStateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReservedResource"):
+		return &kueuev1beta2.ReservedResourceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceFlavor"):
 		return &kueuev1beta2.ResourceFlavorApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceFlavorSpec"):
 		return &kueuev1beta2.ResourceFlavorSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceGroup"):
 		return &kueuev1beta2.ResourceGroupApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ResourcePrice"):
+		return &kueuev1beta2.ResourcePriceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceQuota"):
 		return &kueuev1beta2.ResourceQuotaApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceUsage"):
@@ -312,6 +342,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.TopologyAssignmentSliceLevelValuesApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologyAssignmentSlicePodCounts"):
 		return &kueuev1beta2.TopologyAssignmentSlicePodCountsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyDeviceAttribute"):
+		return &kueuev1beta2.TopologyDeviceAttributeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologyLevel"):
 		return &kueuev1beta2.TopologyLevelApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologySpec"):
@@ -320,6 +352,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.UnhealthyNodeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta2.WorkloadApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("WorkloadDependency"):
+		return &kueuev1beta2.WorkloadDependencyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("WorkloadMigration"):
+		return &kueuev1beta2.WorkloadMigrationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
 		return &kueuev1beta2.WorkloadPriorityClassApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadSchedulingStatsEviction"):
@@ -348,6 +384,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationvisibilityv1beta2.PendingWorkloadApplyConfiguration{}
 	case visibilityv1beta2.SchemeGroupVersion.WithKind("PendingWorkloadsSummary"):
 		return &applyconfigurationvisibilityv1beta2.PendingWorkloadsSummaryApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("PodSetFlavors"):
+		return &applyconfigurationvisibilityv1beta2.PodSetFlavorsApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("PreemptionCandidate"):
+		return &applyconfigurationvisibilityv1beta2.PreemptionCandidateApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("PreemptionExplanation"):
+		return &applyconfigurationvisibilityv1beta2.PreemptionExplanationApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("ResourceFlavorAssignment"):
+		return &applyconfigurationvisibilityv1beta2.ResourceFlavorAssignmentApplyConfiguration{}
+	case visibilityv1beta2.SchemeGroupVersion.WithKind("Workload"):
+		return &applyconfigurationvisibilityv1beta2.WorkloadApplyConfiguration{}
 
 	}
 	return nil
@@ -56,6 +56,10 @@ func (c *FakeKueueV1beta2) ProvisioningRequestConfigs() v1beta2.ProvisioningRequ
 	return newFakeProvisioningRequestConfigs(c)
 }
 
+func (c *FakeKueueV1beta2) QuotaReservations() v1beta2.QuotaReservationInterface {
+	return newFakeQuotaReservations(c)
+}
+
 func (c *FakeKueueV1beta2) ResourceFlavors() v1beta2.ResourceFlavorInterface {
 	return newFakeResourceFlavors(c)
 }
@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	kueuev1beta2 "sigs.k8s.io/kueue/client-go/applyconfiguration/kueue/v1beta2"
+	typedkueuev1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta2"
+)
+
+// fakeQuotaReservations implements QuotaReservationInterface
+type fakeQuotaReservations struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.QuotaReservation, *v1beta2.QuotaReservationList, *kueuev1beta2.QuotaReservationApplyConfiguration]
+	Fake *FakeKueueV1beta2
+}
+
+func newFakeQuotaReservations(fake *FakeKueueV1beta2) typedkueuev1beta2.QuotaReservationInterface {
+	return &fakeQuotaReservations{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.QuotaReservation, *v1beta2.QuotaReservationList, *kueuev1beta2.QuotaReservationApplyConfiguration](
+			fake.Fake,
+			"",
+			v1beta2.SchemeGroupVersion.WithResource("quotareservations"),
+			v1beta2.SchemeGroupVersion.WithKind("QuotaReservation"),
+			func() *v1beta2.QuotaReservation { return &v1beta2.QuotaReservation{} },
+			func() *v1beta2.QuotaReservationList { return &v1beta2.QuotaReservationList{} },
+			func(dst, src *v1beta2.QuotaReservationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.QuotaReservationList) []*v1beta2.QuotaReservation {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.QuotaReservationList, items []*v1beta2.QuotaReservation) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
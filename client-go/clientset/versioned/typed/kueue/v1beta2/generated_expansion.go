@@ -32,6 +32,8 @@ type MultiKueueConfigExpansion interface{}
 
 type ProvisioningRequestConfigExpansion interface{}
 
+type QuotaReservationExpansion interface{}
+
 type ResourceFlavorExpansion interface{}
 
 type TopologyExpansion interface{}
@@ -35,6 +35,7 @@ type KueueV1beta2Interface interface {
 	MultiKueueClustersGetter
 	MultiKueueConfigsGetter
 	ProvisioningRequestConfigsGetter
+	QuotaReservationsGetter
 	ResourceFlavorsGetter
 	TopologiesGetter
 	WorkloadsGetter
@@ -74,6 +75,10 @@ func (c *KueueV1beta2Client) ProvisioningRequestConfigs() ProvisioningRequestCon
 	return newProvisioningRequestConfigs(c)
 }
 
+func (c *KueueV1beta2Client) QuotaReservations() QuotaReservationInterface {
+	return newQuotaReservations(c)
+}
+
 func (c *KueueV1beta2Client) ResourceFlavors() ResourceFlavorInterface {
 	return newResourceFlavors(c)
 }
@@ -36,6 +36,10 @@ func (c *FakeVisibilityV1beta2) LocalQueues(namespace string) v1beta2.LocalQueue
 	return newFakeLocalQueues(c, namespace)
 }
 
+func (c *FakeVisibilityV1beta2) Workloads(namespace string) v1beta2.WorkloadInterface {
+	return newFakeWorkloads(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeVisibilityV1beta2) RESTClient() rest.Interface {
@@ -0,0 +1,67 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+	testing "k8s.io/client-go/testing"
+	v1beta2 "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	visibilityv1beta2 "sigs.k8s.io/kueue/client-go/applyconfiguration/visibility/v1beta2"
+	typedvisibilityv1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/visibility/v1beta2"
+)
+
+// fakeWorkloads implements WorkloadInterface
+type fakeWorkloads struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.Workload, *v1beta2.WorkloadList, *visibilityv1beta2.WorkloadApplyConfiguration]
+	Fake *FakeVisibilityV1beta2
+}
+
+func newFakeWorkloads(fake *FakeVisibilityV1beta2, namespace string) typedvisibilityv1beta2.WorkloadInterface {
+	return &fakeWorkloads{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.Workload, *v1beta2.WorkloadList, *visibilityv1beta2.WorkloadApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("workloads"),
+			v1beta2.SchemeGroupVersion.WithKind("Workload"),
+			func() *v1beta2.Workload { return &v1beta2.Workload{} },
+			func() *v1beta2.WorkloadList { return &v1beta2.WorkloadList{} },
+			func(dst, src *v1beta2.WorkloadList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.WorkloadList) []*v1beta2.Workload { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta2.WorkloadList, items []*v1beta2.Workload) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
+
+// GetPreemptionExplanation takes name of the workload, and returns the corresponding preemptionExplanation object, and an error if there is any.
+func (c *fakeWorkloads) GetPreemptionExplanation(ctx context.Context, workloadName string, options v1.GetOptions) (result *v1beta2.PreemptionExplanation, err error) {
+	emptyResult := &v1beta2.PreemptionExplanation{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "preemptionexplanation", workloadName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.PreemptionExplanation), err
+}
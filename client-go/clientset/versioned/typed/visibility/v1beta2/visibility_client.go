@@ -30,6 +30,7 @@ type VisibilityV1beta2Interface interface {
 	RESTClient() rest.Interface
 	ClusterQueuesGetter
 	LocalQueuesGetter
+	WorkloadsGetter
 }
 
 // VisibilityV1beta2Client is used to interact with features provided by the visibility.kueue.x-k8s.io group.
@@ -45,6 +46,10 @@ func (c *VisibilityV1beta2Client) LocalQueues(namespace string) LocalQueueInterf
 	return newLocalQueues(c, namespace)
 }
 
+func (c *VisibilityV1beta2Client) Workloads(namespace string) WorkloadInterface {
+	return newWorkloads(c, namespace)
+}
+
 // NewForConfig creates a new VisibilityV1beta2Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
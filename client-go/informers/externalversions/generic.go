@@ -94,6 +94,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().MultiKueueConfigs().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("provisioningrequestconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().ProvisioningRequestConfigs().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("quotareservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().QuotaReservations().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("resourceflavors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().ResourceFlavors().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("topologies"):
@@ -114,6 +116,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().ClusterQueues().Informer()}, nil
 	case visibilityv1beta2.SchemeGroupVersion.WithResource("localqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().LocalQueues().Informer()}, nil
+	case visibilityv1beta2.SchemeGroupVersion.WithResource("workloads"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta2().Workloads().Informer()}, nil
 
 	}
 
@@ -38,6 +38,8 @@ type Interface interface {
 	MultiKueueConfigs() MultiKueueConfigInformer
 	// ProvisioningRequestConfigs returns a ProvisioningRequestConfigInformer.
 	ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
+	// QuotaReservations returns a QuotaReservationInformer.
+	QuotaReservations() QuotaReservationInformer
 	// ResourceFlavors returns a ResourceFlavorInformer.
 	ResourceFlavors() ResourceFlavorInformer
 	// Topologies returns a TopologyInformer.
@@ -94,6 +96,11 @@ func (v *version) ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
 	return &provisioningRequestConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// QuotaReservations returns a QuotaReservationInformer.
+func (v *version) QuotaReservations() QuotaReservationInformer {
+	return &quotaReservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ResourceFlavors returns a ResourceFlavorInformer.
 func (v *version) ResourceFlavors() ResourceFlavorInformer {
 	return &resourceFlavorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -50,6 +50,10 @@ type MultiKueueConfigListerExpansion interface{}
 // ProvisioningRequestConfigLister.
 type ProvisioningRequestConfigListerExpansion interface{}
 
+// QuotaReservationListerExpansion allows custom methods to be added to
+// QuotaReservationLister.
+type QuotaReservationListerExpansion interface{}
+
 // ResourceFlavorListerExpansion allows custom methods to be added to
 // ResourceFlavorLister.
 type ResourceFlavorListerExpansion interface{}
@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// QuotaReservationLister helps list QuotaReservations.
+// All objects returned here must be treated as read-only.
+type QuotaReservationLister interface {
+	// List lists all QuotaReservations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kueuev1beta2.QuotaReservation, err error)
+	// Get retrieves the QuotaReservation from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kueuev1beta2.QuotaReservation, error)
+	QuotaReservationListerExpansion
+}
+
+// quotaReservationLister implements the QuotaReservationLister interface.
+type quotaReservationLister struct {
+	listers.ResourceIndexer[*kueuev1beta2.QuotaReservation]
+}
+
+// NewQuotaReservationLister returns a new QuotaReservationLister.
+func NewQuotaReservationLister(indexer cache.Indexer) QuotaReservationLister {
+	return &quotaReservationLister{listers.New[*kueuev1beta2.QuotaReservation](indexer, kueuev1beta2.Resource("quotareservation"))}
+}
@@ -29,3 +29,11 @@ type LocalQueueListerExpansion interface{}
 // LocalQueueNamespaceListerExpansion allows custom methods to be added to
 // LocalQueueNamespaceLister.
 type LocalQueueNamespaceListerExpansion interface{}
+
+// WorkloadListerExpansion allows custom methods to be added to
+// WorkloadLister.
+type WorkloadListerExpansion interface{}
+
+// WorkloadNamespaceListerExpansion allows custom methods to be added to
+// WorkloadNamespaceLister.
+type WorkloadNamespaceListerExpansion interface{}
@@ -25,11 +25,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/util/resourcegroups"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -44,6 +47,7 @@ var (
 
 type ImportCache struct {
 	Namespaces          []string
+	NamespaceLabels     map[string]map[string]string
 	MappingRules        mapping.Rules
 	LocalQueues         map[string]map[string]*kueue.LocalQueue
 	ClusterQueues       map[string]*kueue.ClusterQueue
@@ -52,14 +56,22 @@ type ImportCache struct {
 	AddLabels           map[string]string
 	workloadInfoOptions []workload.InfoOption
 
+	// IntegrationManager has the integrations being imported enabled. It's
+	// used to skip the objects owned by other imported objects.
+	IntegrationManager *jobframework.IntegrationManager
+
 	// Derived from ClusterQueues and ResourceFlavors at Load time.
 	flavorValidation  map[kueue.ClusterQueueReference]error
 	flavorsByResource map[kueue.ClusterQueueReference]map[corev1.ResourceName]kueue.ResourceFlavorReference
 }
 
 func Load(ctx context.Context, c client.Client, namespaces []string, mappingRules mapping.Rules, addLabels map[string]string, workloadInfoOptions []workload.InfoOption) (*ImportCache, error) {
+	if err := mappingRules.Compile(); err != nil {
+		return nil, fmt.Errorf("compiling mapping rules: %w", err)
+	}
 	ret := ImportCache{
 		Namespaces:          slices.Clone(namespaces),
+		NamespaceLabels:     make(map[string]map[string]string, len(namespaces)),
 		MappingRules:        mappingRules,
 		LocalQueues:         make(map[string]map[string]*kueue.LocalQueue),
 		AddLabels:           addLabels,
@@ -73,6 +85,13 @@ func Load(ctx context.Context, c client.Client, namespaces []string, mappingRule
 	ret.ClusterQueues = utilslices.ToRefMap(cqList.Items, func(cq *kueue.ClusterQueue) string { return cq.Name })
 
 	for _, ns := range namespaces {
+		// A missing namespace has no objects to import.
+		nsObj := &corev1.Namespace{}
+		if err := c.Get(ctx, client.ObjectKey{Name: ns}, nsObj); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("loading namespace %s: %w", ns, err)
+		}
+		ret.NamespaceLabels[ns] = nsObj.Labels
+
 		lqList := &kueue.LocalQueueList{}
 		if err := c.List(ctx, lqList, client.InNamespace(ns)); err != nil {
 			return nil, fmt.Errorf("loading local queues in namespace %s: %w", ns, err)
@@ -162,7 +181,34 @@ func validateFlavors(cqName string, rgs []resourcegroups.ResourceGroup, resource
 }
 
 func (ic *ImportCache) LocalQueueForPod(p *corev1.Pod) (*kueue.LocalQueue, bool, error) {
-	queueName, skip, found := ic.MappingRules.QueueFor(p.Spec.PriorityClassName, p.Labels)
+	return ic.LocalQueueFor(ic.MappingObject(p, p.Spec.PriorityClassName))
+}
+
+// MappingObject returns the properties of obj the mapping rules are matched
+// against, for an object with the given priority class.
+func (ic *ImportCache) MappingObject(obj client.Object, priorityClassName string) *mapping.Object {
+	mo := &mapping.Object{
+		Namespace:         obj.GetNamespace(),
+		NamespaceLabels:   ic.NamespaceLabels[obj.GetNamespace()],
+		PriorityClassName: priorityClassName,
+		Labels:            obj.GetLabels(),
+		Content: func() (map[string]any, error) {
+			return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		},
+	}
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		mo.OwnerKind = owner.Kind
+	}
+	return mo
+}
+
+// LocalQueueFor returns the LocalQueue that the mapping rules select for the
+// object.
+func (ic *ImportCache) LocalQueueFor(obj *mapping.Object) (*kueue.LocalQueue, bool, error) {
+	queueName, skip, found, err := ic.MappingRules.QueueFor(obj)
+	if err != nil {
+		return nil, false, err
+	}
 	if !found {
 		return nil, false, mapping.ErrNoMapping
 	}
@@ -171,7 +217,7 @@ func (ic *ImportCache) LocalQueueForPod(p *corev1.Pod) (*kueue.LocalQueue, bool,
 		return nil, true, nil
 	}
 
-	nqQueues, found := ic.LocalQueues[p.Namespace]
+	nqQueues, found := ic.LocalQueues[obj.Namespace]
 	if !found {
 		return nil, false, fmt.Errorf("%s: %w", queueName, ErrLQNotFound)
 	}
@@ -183,6 +229,13 @@ func (ic *ImportCache) LocalQueueForPod(p *corev1.Pod) (*kueue.LocalQueue, bool,
 	return lq, false, nil
 }
 
+// IsOwnedByImportedObject returns whether the controller of the object is of
+// a kind imported along with it, in which case it's accounted for through
+// its owner.
+func (ic *ImportCache) IsOwnedByImportedObject(obj client.Object) bool {
+	return ic.IntegrationManager != nil && ic.IntegrationManager.IsOwnerManagedByKueueForObject(obj)
+}
+
 func (ic *ImportCache) FlavorValidationForClusterQueue(cqName kueue.ClusterQueueReference) error {
 	return ic.flavorValidation[cqName]
 }
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package pod
+package common
 
 import (
 	"fmt"
@@ -22,34 +22,38 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-type queueLabelConflictError struct {
+// QueueLabelConflictError is returned when an object already targets another
+// LocalQueue than the one it's mapped to.
+type QueueLabelConflictError struct {
 	CurrentQueue  string
 	ExpectedQueue string
 }
 
-func (e *queueLabelConflictError) Error() string {
+func (e *QueueLabelConflictError) Error() string {
 	return fmt.Sprintf("another local queue name is set %q expecting %q", e.CurrentQueue, e.ExpectedQueue)
 }
 
-func (e *queueLabelConflictError) Is(target error) bool {
-	t, ok := target.(*queueLabelConflictError)
+func (e *QueueLabelConflictError) Is(target error) bool {
+	t, ok := target.(*QueueLabelConflictError)
 	if !ok {
 		return false
 	}
 	return e.CurrentQueue == t.CurrentQueue && e.ExpectedQueue == t.ExpectedQueue
 }
 
-type resourceNotCoveredError struct {
+// ResourceNotCoveredError is returned when a resource requested by an object
+// is not covered by the target ClusterQueue.
+type ResourceNotCoveredError struct {
 	Resource     corev1.ResourceName
 	ClusterQueue string
 }
 
-func (e *resourceNotCoveredError) Error() string {
+func (e *ResourceNotCoveredError) Error() string {
 	return fmt.Sprintf("resource %q is not covered by ClusterQueue %q", e.Resource, e.ClusterQueue)
 }
 
-func (e *resourceNotCoveredError) Is(target error) bool {
-	t, ok := target.(*resourceNotCoveredError)
+func (e *ResourceNotCoveredError) Is(target error) bool {
+	t, ok := target.(*ResourceNotCoveredError)
 	if !ok {
 		return false
 	}
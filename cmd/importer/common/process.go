@@ -0,0 +1,92 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ListLength = 100
+)
+
+// ListOptions returns the options to list a page of the objects in the
+// namespace.
+func ListOptions(namespace, continueToken string) []client.ListOption {
+	opts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.Limit(ListLength),
+		client.Continue(continueToken),
+	}
+	return opts
+}
+
+type ProcessResult struct {
+	Object string
+	Err    error
+	Skip   bool
+}
+
+type ProcessSummary struct {
+	Total           int
+	Skipped         int
+	Failed          int
+	ErrorsForObject map[string][]string
+	Errors          []error
+}
+
+// ProcessConcurrently calls f for every item received on ch, using the given
+// number of workers, and summarizes the results. The key identifies the item
+// in the summary.
+func ProcessConcurrently[T any](ch <-chan T, jobs uint, key func(*T) string, f func(*T) (bool, error)) ProcessSummary {
+	wg := sync.WaitGroup{}
+	resultCh := make(chan ProcessResult)
+
+	for range jobs {
+		wg.Go(func() {
+			for item := range ch {
+				skip, err := f(&item)
+				resultCh <- ProcessResult{Object: key(&item), Err: err, Skip: skip}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	ps := ProcessSummary{
+		ErrorsForObject: make(map[string][]string),
+	}
+	for result := range resultCh {
+		ps.Total++
+		if result.Skip {
+			ps.Skipped++
+		}
+		if result.Err != nil {
+			ps.Failed++
+			estr := result.Err.Error()
+			if _, found := ps.ErrorsForObject[estr]; !found {
+				ps.Errors = append(ps.Errors, result.Err)
+			}
+			ps.ErrorsForObject[estr] = append(ps.ErrorsForObject[estr], result.Object)
+		}
+	}
+	return ps
+}
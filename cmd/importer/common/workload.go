@@ -0,0 +1,221 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadpatching "sigs.k8s.io/kueue/pkg/workload/patching"
+)
+
+var realClock = clock.RealClock{}
+
+func CheckError(err error) (retry, reload bool, timeout time.Duration) {
+	retrySeconds, retry := apierrors.SuggestsClientDelay(err)
+	if retry {
+		return true, false, time.Duration(retrySeconds) * time.Second
+	}
+
+	if apierrors.IsConflict(err) {
+		return true, true, 0
+	}
+	return false, false, 0
+}
+
+// WaitForRetry blocks for timeout, or returns early with an error if ctx is
+// done first. A non-positive timeout returns immediately.
+func WaitForRetry(ctx context.Context, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return errors.New("context canceled")
+	case <-t.C:
+		return nil
+	}
+}
+
+// UpdateObject applies mutate to obj and updates it, reloading obj and
+// applying mutate again on conflicts.
+func UpdateObject(ctx context.Context, c client.Client, obj client.Object, mutate func()) error {
+	mutate()
+	err := c.Update(ctx, obj)
+	retry, reload, timeout := CheckError(err)
+
+	for retry {
+		if err := WaitForRetry(ctx, timeout); err != nil {
+			return err
+		}
+		if reload {
+			err = c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+			if err != nil {
+				retry, reload, timeout = CheckError(err)
+				continue
+			}
+			mutate()
+		}
+		err = c.Update(ctx, obj)
+		retry, reload, timeout = CheckError(err)
+	}
+	return err
+}
+
+func CreateWorkload(ctx context.Context, c client.Client, wl *kueue.Workload) error {
+	err := c.Create(ctx, wl)
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	retry, _, timeout := CheckError(err)
+	for retry {
+		if err := WaitForRetry(ctx, timeout); err != nil {
+			return err
+		}
+		err = c.Create(ctx, wl)
+		retry, _, timeout = CheckError(err)
+	}
+	return err
+}
+
+// AdmitWorkload sets the admission of the workload in the ClusterQueue,
+// with the given flavors for each of its PodSets.
+func AdmitWorkload(
+	ctx context.Context,
+	c client.Client,
+	wl *kueue.Workload,
+	cq *kueue.ClusterQueue,
+	flavors []map[corev1.ResourceName]kueue.ResourceFlavorReference,
+	workloadInfoOptions []workload.InfoOption,
+) error {
+	resourceFormatter := resources.NewResourceFormatter()
+	update := func(wl *kueue.Workload) (bool, error) {
+		info := workload.NewInfo(wl, workloadInfoOptions...)
+		admission := kueue.Admission{
+			ClusterQueue:      kueue.ClusterQueueReference(cq.Name),
+			PodSetAssignments: make([]kueue.PodSetAssignment, len(info.TotalRequests)),
+		}
+		for i, psr := range info.TotalRequests {
+			admission.PodSetAssignments[i] = kueue.PodSetAssignment{
+				Name:          psr.Name,
+				Flavors:       flavors[i],
+				ResourceUsage: psr.Requests.ToResourceList(resourceFormatter),
+				Count:         new(psr.Count),
+			}
+		}
+		msg := fmt.Sprintf("Imported into ClusterQueue %s", cq.Name)
+		wl.Status.Admission = &admission
+		apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+			Type:    kueue.WorkloadQuotaReserved,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Imported",
+			Message: msg,
+		})
+		apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+			Type:    kueue.WorkloadAdmitted,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Imported",
+			Message: msg,
+		})
+		return true, nil
+	}
+
+	const maxAttempts = 5
+	for range maxAttempts {
+		err := workloadpatching.PatchAdmissionStatus(ctx, c, wl, realClock, update, workloadpatching.WithForceApply())
+		if err == nil {
+			return nil
+		}
+		retry, reload, timeout := CheckError(err)
+		if !retry {
+			return err
+		}
+		if waitErr := WaitForRetry(ctx, timeout); waitErr != nil {
+			return waitErr
+		}
+		if reload {
+			if getErr := c.Get(ctx, client.ObjectKeyFromObject(wl), wl); getErr != nil {
+				return getErr
+			}
+		}
+	}
+	return fmt.Errorf("admitting workload %s: too many conflicts", klog.KObj(wl))
+}
+
+// FlavorAssignmentsForRequests assigns a flavor to each non-zero requested
+// resource, using the ClusterQueue's precomputed resource-to-flavor map.
+func FlavorAssignmentsForRequests(
+	flavorsByResource map[corev1.ResourceName]kueue.ResourceFlavorReference,
+	cqName string,
+	requests resources.Requests,
+) (map[corev1.ResourceName]kueue.ResourceFlavorReference, error) {
+	type rq struct {
+		name corev1.ResourceName
+		qty  int64
+	}
+	pairs := make([]rq, 0, requests.Len())
+	requests.ForEach(func(name corev1.ResourceName, quantity int64) {
+		pairs = append(pairs, rq{name, quantity})
+	})
+	slices.SortFunc(pairs, func(a, b rq) int { return strings.Compare(string(a.name), string(b.name)) })
+
+	flavors := make(map[corev1.ResourceName]kueue.ResourceFlavorReference)
+	for _, p := range pairs {
+		if p.qty == 0 {
+			continue
+		}
+		flv, ok := flavorsByResource[p.name]
+		if !ok {
+			return nil, &ResourceNotCoveredError{Resource: p.name, ClusterQueue: cqName}
+		}
+		flavors[p.name] = flv
+	}
+
+	return flavors, nil
+}
+
+// FlavorResourceUsage returns the usage of the PodSets, with the flavors
+// assigned to the requested resources of each of them.
+func FlavorResourceUsage(totalRequests []workload.PodSetResources, flavors []map[corev1.ResourceName]kueue.ResourceFlavorReference) resources.FlavorResourceQuantities {
+	usage := make(resources.FlavorResourceQuantities)
+	for i, psr := range totalRequests {
+		psr.Requests.ForEach(func(name corev1.ResourceName, quantity int64) {
+			if flavor, found := flavors[i][name]; found {
+				fr := resources.FlavorResource{Flavor: flavor, Resource: name}
+				usage[fr] = usage[fr].AddInt64(quantity)
+			}
+		})
+	}
+	return usage
+}
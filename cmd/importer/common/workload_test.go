@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+func TestFlavorAssignmentsForRequests(t *testing.T) {
+	const cqName = "cq"
+	flavorsByResource := map[corev1.ResourceName]kueue.ResourceFlavorReference{
+		corev1.ResourceCPU: "cpu-flavor",
+	}
+
+	cases := map[string]struct {
+		requests  resources.Requests
+		want      map[corev1.ResourceName]kueue.ResourceFlavorReference
+		wantError error
+	}{
+		"assigns covered non-zero resources": {
+			requests: resources.MapRequests{
+				corev1.ResourceCPU: 1000,
+			},
+			want: map[corev1.ResourceName]kueue.ResourceFlavorReference{
+				corev1.ResourceCPU: "cpu-flavor",
+			},
+		},
+		"ignores uncovered zero-quantity resources": {
+			requests: resources.MapRequests{
+				corev1.ResourceCPU:                    1000,
+				corev1.ResourceName("nvidia.com/gpu"): 0,
+			},
+			want: map[corev1.ResourceName]kueue.ResourceFlavorReference{
+				corev1.ResourceCPU: "cpu-flavor",
+			},
+		},
+		"fails for uncovered non-zero resources": {
+			requests: resources.MapRequests{
+				corev1.ResourceName("nvidia.com/gpu"): 1,
+			},
+			wantError: &ResourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq"},
+		},
+		"fails with the lexicographically first uncovered non-zero resource": {
+			requests: resources.MapRequests{
+				corev1.ResourceName("z.example.com/resource"): 1,
+				corev1.ResourceName("a.example.com/resource"): 1,
+			},
+			wantError: &ResourceNotCoveredError{Resource: corev1.ResourceName("a.example.com/resource"), ClusterQueue: "cq"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := FlavorAssignmentsForRequests(flavorsByResource, cqName, tc.requests)
+
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("Unexpected flavors (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
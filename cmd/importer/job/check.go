@@ -0,0 +1,162 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
+	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	"sigs.k8s.io/kueue/cmd/importer/report"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// checkedWorkload is the outcome of validating an object against its target
+// ClusterQueue: the Workload it would produce and the flavors assigned to
+// the requested resources of each of its PodSets.
+type checkedWorkload struct {
+	workload *kueue.Workload
+	cq       *kueue.ClusterQueue
+	lqName   string
+	flavors  []map[corev1.ResourceName]kueue.ResourceFlavorReference
+	usage    resources.FlavorResourceQuantities
+}
+
+// Check validates that the running objects of the integration can be
+// imported. The unmapped objects and the usage of the others are recorded in
+// rep, if not nil.
+func Check(ctx context.Context, c client.Client, importCache *cache.ImportCache, newJob func() jobframework.GenericJob, rep *report.Report, jobs uint) error {
+	ch := make(chan jobframework.GenericJob)
+	go func() {
+		err := ListJobs(ctx, c, importCache, newJob, ch)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Listing objects")
+		}
+	}()
+	summary := common.ProcessConcurrently(ch, jobs, jobKey, func(job *jobframework.GenericJob) (bool, error) {
+		log := ctrl.LoggerFrom(ctx).WithValues("object", klog.KObj((*job).Object()))
+		log.V(3).Info("Checking")
+
+		checked, skip, err := checkJobWorkload(ctx, c, importCache, *job)
+		if errors.Is(err, mapping.ErrNoMapping) {
+			rep.AddUnmapped((*job).GVK().Kind, jobKey(job))
+		}
+		if skip || err != nil {
+			return skip, err
+		}
+
+		rep.AddUsage((*job).GVK().Kind, jobKey(job), checked.cq.Name, checked.usage)
+
+		log.V(2).Info("Successfully checked", "clusterQueue", klog.KObj(checked.cq), "priority", priority.Priority(checked.workload), "flavors", checked.flavors)
+		return false, nil
+	})
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Check done", "checked", summary.Total, "skipped", summary.Skipped, "failed", summary.Failed)
+	for e, objects := range summary.ErrorsForObject {
+		log.Info("Validation failed for objects", "err", e, "occurrences", len(objects), "observedFirstIn", objects[0])
+	}
+	return errors.Join(summary.Errors...)
+}
+
+// checkJobWorkload builds the Workload of the object with the integration's
+// GenericJob, and validates it against the ClusterQueue of the LocalQueue
+// selected by the mapping rules. It returns skip=true when the mapping says
+// the object should be skipped.
+func checkJobWorkload(ctx context.Context, c client.Client, importCache *cache.ImportCache, job jobframework.GenericJob) (*checkedWorkload, bool, error) {
+	obj := job.Object()
+	wl, err := jobframework.ConstructWorkload(ctx, c, job, nil, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("construct workload: %w", err)
+	}
+
+	lq, skip, err := importCache.LocalQueueFor(importCache.MappingObject(obj, priorityClassName(job, wl)))
+	if skip || err != nil {
+		return nil, skip, err
+	}
+	cq, ok := importCache.ClusterQueues[string(lq.Spec.ClusterQueue)]
+	if !ok {
+		return nil, false, fmt.Errorf("cluster queue not found in cache: %s: %w", lq.Spec.ClusterQueue, cache.ErrCQNotFound)
+	}
+
+	if oldLq, found := obj.GetLabels()[controllerconstants.QueueLabel]; found && oldLq != lq.Name {
+		return nil, false, &common.QueueLabelConflictError{CurrentQueue: oldLq, ExpectedQueue: lq.Name}
+	}
+	if len(cq.Spec.ResourceGroups) == 0 {
+		return nil, false, fmt.Errorf("%q has no resource groups: %w", cq.Name, cache.ErrCQInvalid)
+	}
+	if err := importCache.FlavorValidationForClusterQueue(kueue.ClusterQueueReference(cq.Name)); err != nil {
+		return nil, false, err
+	}
+
+	// The object isn't labeled yet, so set the queue and the importer-added
+	// labels on the Workload explicitly.
+	wl.Spec.QueueName = kueue.LocalQueueName(lq.Name)
+	maps.Copy(wl.Labels, importCache.AddLabels)
+
+	var customPriorityClassFunc func() string
+	if jobWithPriorityClass, implements := job.(jobframework.JobWithPriorityClass); implements {
+		customPriorityClassFunc = jobWithPriorityClass.PriorityClass
+	}
+	if err := jobframework.PrepareWorkloadPriority(ctx, c, obj, wl, customPriorityClassFunc); err != nil {
+		return nil, false, fmt.Errorf("priority: %w", err)
+	}
+
+	info := workload.NewInfo(wl, importCache.WorkloadInfoOptions()...)
+	flavorsByResource := importCache.FlavorsByResourceForClusterQueue(kueue.ClusterQueueReference(cq.Name))
+	flavors := make([]map[corev1.ResourceName]kueue.ResourceFlavorReference, len(info.TotalRequests))
+	for i, psr := range info.TotalRequests {
+		flavors[i], err = common.FlavorAssignmentsForRequests(flavorsByResource, cq.Name, psr.Requests)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	usage := common.FlavorResourceUsage(info.TotalRequests, flavors)
+	return &checkedWorkload{workload: wl, cq: cq, lqName: lq.Name, flavors: flavors, usage: usage}, false, nil
+}
+
+// priorityClassName returns the name of the priority class used to match the
+// object against the mapping rules: the one of the job if the integration
+// has a custom one, or otherwise the first one set in its PodSets.
+func priorityClassName(job jobframework.GenericJob, wl *kueue.Workload) string {
+	if jobWithPriorityClass, implements := job.(jobframework.JobWithPriorityClass); implements {
+		if name := jobWithPriorityClass.PriorityClass(); name != "" {
+			return name
+		}
+	}
+	for _, ps := range wl.Spec.PodSets {
+		if name := ps.Template.Spec.PriorityClassName; name != "" {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	controllerjob "sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+func TestCheckNamespace(t *testing.T) {
+	baseJobWrapper := testingjob.MakeJob("job", testingNamespace).
+		Suspend(false).
+		Label(testingQueueLabel, "q1").
+		PriorityClass("p-class").
+		Request(corev1.ResourceCPU, "1")
+
+	baseLocalQueue := utiltestingapi.MakeLocalQueue("lq1", testingNamespace).ClusterQueue("cq1")
+	baseClusterQueue := utiltestingapi.MakeClusterQueue("cq1").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("f1").Resource(corev1.ResourceCPU, "10", "0").Obj())
+
+	cases := map[string]struct {
+		mapping      mapping.Rules
+		localQueue   *kueue.LocalQueue
+		clusterQueue *kueue.ClusterQueue
+
+		wantError error
+	}{
+		"no mapping": {
+			mapping:      mapping.Rules{},
+			localQueue:   baseLocalQueue.Obj(),
+			clusterQueue: baseClusterQueue.Obj(),
+			wantError:    mapping.ErrNoMapping,
+		},
+		"mapping by priority class of the pod template": {
+			mapping: mapping.Rules{
+				{Match: mapping.Match{PriorityClassName: "p-class"}, ToLocalQueue: "lq1"},
+			},
+			localQueue:   baseLocalQueue.Obj(),
+			clusterQueue: baseClusterQueue.Obj(),
+		},
+		"skipped by the mapping": {
+			mapping: mapping.Rules{
+				{Match: mapping.Match{Labels: map[string]string{testingQueueLabel: "q1"}}, Skip: true},
+			},
+		},
+		"missing local queue": {
+			mapping: mapping.Rules{
+				{Match: mapping.Match{Labels: map[string]string{testingQueueLabel: "q1"}}, ToLocalQueue: "lq1"},
+			},
+			wantError: cache.ErrLQNotFound,
+		},
+		"missing cluster queue": {
+			mapping: mapping.Rules{
+				{Match: mapping.Match{Labels: map[string]string{testingQueueLabel: "q1"}}, ToLocalQueue: "lq1"},
+			},
+			localQueue: baseLocalQueue.Obj(),
+			wantError:  cache.ErrCQNotFound,
+		},
+		"cluster queue with missing flavors": {
+			mapping: mapping.Rules{
+				{Match: mapping.Match{Labels: map[string]string{testingQueueLabel: "q1"}}, ToLocalQueue: "lq1"},
+			},
+			localQueue: baseLocalQueue.Obj(),
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq1").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("f2").Resource(corev1.ResourceCPU, "10", "0").Obj()).
+				Obj(),
+			wantError: cache.ErrCQInvalid,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			jobsList := batchv1.JobList{Items: []batchv1.Job{*baseJobWrapper.Obj()}}
+			var cqList kueue.ClusterQueueList
+			if tc.clusterQueue != nil {
+				cqList.Items = append(cqList.Items, *tc.clusterQueue)
+			}
+			var lqList kueue.LocalQueueList
+			if tc.localQueue != nil {
+				lqList.Items = append(lqList.Items, *tc.localQueue)
+			}
+			rfList := kueue.ResourceFlavorList{Items: []kueue.ResourceFlavor{*utiltestingapi.MakeResourceFlavor("f1").Obj()}}
+			pc := utiltesting.MakePriorityClass("p-class").PriorityValue(100).Obj()
+
+			client := utiltesting.NewClientBuilder().
+				WithLists(&jobsList, &cqList, &lqList, &rfList).
+				WithObjects(pc).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			importCache, err := cache.Load(ctx, client, []string{testingNamespace}, tc.mapping, nil, nil)
+			if err != nil {
+				t.Fatalf("Unexpected cache load error: %s", err)
+			}
+
+			gotErr := Check(ctx, client, importCache, controllerjob.NewJob, nil, 8)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
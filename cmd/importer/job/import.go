@@ -0,0 +1,111 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// Import labels the running objects of the integration with their
+// LocalQueue, and creates admitted Workloads for them.
+func Import(ctx context.Context, c client.Client, importCache *cache.ImportCache, newJob func() jobframework.GenericJob, jobs uint) error {
+	ch := make(chan jobframework.GenericJob)
+	go func() {
+		err := ListJobs(ctx, c, importCache, newJob, ch)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Listing objects")
+		}
+	}()
+	summary := common.ProcessConcurrently(ch, jobs, jobKey, func(job *jobframework.GenericJob) (bool, error) {
+		obj := (*job).Object()
+		log := ctrl.LoggerFrom(ctx).WithValues("object", klog.KObj(obj))
+		log.V(3).Info("Importing")
+
+		// Import shares its validation and Workload construction with Check,
+		// so the two commands cannot disagree on what is importable.
+		checked, skip, err := checkJobWorkload(ctx, c, importCache, *job)
+		if skip || err != nil {
+			return skip, err
+		}
+		wl := checked.workload
+
+		if objectNeedsLabels(obj, checked.lqName, importCache.AddLabels) {
+			if err := addLabels(ctx, c, obj, checked.lqName, importCache.AddLabels); err != nil {
+				return false, fmt.Errorf("cannot add queue label: %w", err)
+			}
+		}
+
+		if err := common.CreateWorkload(ctx, c, wl); err != nil {
+			return false, fmt.Errorf("creating workload: %w", err)
+		}
+
+		if err := common.AdmitWorkload(ctx, c, wl, checked.cq, checked.flavors, importCache.WorkloadInfoOptions()); err != nil {
+			return false, err
+		}
+		log.V(2).Info("Successfully imported", "workload", klog.KObj(wl))
+		return false, nil
+	})
+
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Import done", "checked", summary.Total, "skipped", summary.Skipped, "failed", summary.Failed)
+	for e, objects := range summary.ErrorsForObject {
+		log.Info("Import failed for objects", "err", e, "occurrences", len(objects), "observedFirstIn", objects[0])
+	}
+	return errors.Join(summary.Errors...)
+}
+
+// importLabels merges queue and the configured extra labels into the full
+// label set an object must carry after import.
+func importLabels(queue string, addLabels map[string]string) map[string]string {
+	labels := make(map[string]string, len(addLabels)+1)
+	maps.Copy(labels, addLabels)
+	labels[controllerconstants.QueueLabel] = queue
+	return labels
+}
+
+// objectNeedsLabels reports whether obj is missing, or has a stale value for, any label from importLabels.
+func objectNeedsLabels(obj client.Object, queue string, addLabels map[string]string) bool {
+	for k, v := range importLabels(queue, addLabels) {
+		if obj.GetLabels()[k] != v {
+			return true
+		}
+	}
+	return false
+}
+
+func addLabels(ctx context.Context, c client.Client, obj client.Object, queue string, addLabels map[string]string) error {
+	return common.UpdateObject(ctx, c, obj, func() {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, importLabels(queue, addLabels))
+		obj.SetLabels(labels)
+	})
+}
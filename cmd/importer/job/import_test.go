@@ -0,0 +1,218 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	jobsetapi "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
+	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs"
+	controllerjob "sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/jobset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+const (
+	testingNamespace  = "ns"
+	testingQueueLabel = "testing.lbl"
+)
+
+func TestImportNamespace(t *testing.T) {
+	baseJobWrapper := testingjob.MakeJob("job", testingNamespace).
+		UID("job").
+		Suspend(false).
+		Parallelism(2).
+		Label(testingQueueLabel, "q1").
+		Request(corev1.ResourceCPU, "1")
+
+	baseMapping := mapping.Rules{
+		mapping.Rule{
+			Match: mapping.Match{
+				Labels: map[string]string{
+					testingQueueLabel: "q1",
+				},
+			},
+			ToLocalQueue: "lq1",
+		},
+	}
+
+	baseLocalQueue := utiltestingapi.MakeLocalQueue("lq1", testingNamespace).ClusterQueue("cq1")
+	baseClusterQueue := utiltestingapi.MakeClusterQueue("cq1").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("f1").Resource(corev1.ResourceCPU, "10", "0").Obj())
+
+	wantAdmission := utiltestingapi.MakeAdmission("cq1").
+		PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "f1", "2").
+			Count(2).
+			Obj()).
+		Obj()
+
+	cases := map[string]struct {
+		jobs         []batchv1.Job
+		integrations []string
+		addLabels    map[string]string
+
+		wantError      error
+		wantJobs       []batchv1.Job
+		wantAdmissions []kueue.Admission
+	}{
+		"imports a running job": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Obj(),
+			},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().
+					Label(controllerconstants.QueueLabel, "lq1").
+					Obj(),
+			},
+			wantAdmissions: []kueue.Admission{*wantAdmission},
+		},
+		"adds the additional labels to the job": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Obj(),
+			},
+			addLabels: map[string]string{"new.lbl": "val"},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().
+					Label(controllerconstants.QueueLabel, "lq1").
+					Label("new.lbl", "val").
+					Obj(),
+			},
+			wantAdmissions: []kueue.Admission{*wantAdmission},
+		},
+		"skips a suspended job": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Suspend(true).Obj(),
+			},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Suspend(true).Obj(),
+			},
+		},
+		"skips a finished job": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().
+					Condition(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}).
+					Obj(),
+			},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().
+					Condition(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}).
+					Obj(),
+			},
+		},
+		"skips a job owned by an imported object": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().OwnerReference("jobset", jobsetapi.SchemeGroupVersion.WithKind("JobSet")).Obj(),
+			},
+			integrations: []string{jobset.FrameworkName},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().OwnerReference("jobset", jobsetapi.SchemeGroupVersion.WithKind("JobSet")).Obj(),
+			},
+		},
+		"returns an error without mutating the job when a resource is not covered": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Request("example.com/gpu", "1").Obj(),
+			},
+			wantError: &common.ResourceNotCoveredError{Resource: "example.com/gpu", ClusterQueue: "cq1"},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Request("example.com/gpu", "1").Obj(),
+			},
+		},
+		"returns an error without mutating the job when another queue is set": {
+			jobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Queue("lq2").Obj(),
+			},
+			wantError: &common.QueueLabelConflictError{CurrentQueue: "lq2", ExpectedQueue: "lq1"},
+			wantJobs: []batchv1.Job{
+				*baseJobWrapper.Clone().Queue("lq2").Obj(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			jobsList := batchv1.JobList{Items: tc.jobs}
+			cqList := kueue.ClusterQueueList{Items: []kueue.ClusterQueue{*baseClusterQueue.Obj()}}
+			lqList := kueue.LocalQueueList{Items: []kueue.LocalQueue{*baseLocalQueue.Obj()}}
+			rfList := kueue.ResourceFlavorList{Items: []kueue.ResourceFlavor{*utiltestingapi.MakeResourceFlavor("f1").Obj()}}
+
+			client := utiltesting.NewClientBuilder(jobsetapi.AddToScheme).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).WithStatusSubresource(&kueue.Workload{}).
+				WithLists(&jobsList, &cqList, &lqList, &rfList).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			importCache, err := cache.Load(ctx, client, []string{testingNamespace}, baseMapping, tc.addLabels, nil)
+			if err != nil {
+				t.Fatalf("Unexpected cache load error: %s", err)
+			}
+			importCache.IntegrationManager = jobs.NewIntegrationManager()
+			for _, name := range append(tc.integrations, controllerjob.FrameworkName) {
+				importCache.IntegrationManager.EnableIntegration(name)
+			}
+
+			gotErr := Import(ctx, client, importCache, controllerjob.NewJob, 8)
+			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if err := client.List(ctx, &jobsList); err != nil {
+				t.Errorf("Unexpected list jobs error: %s", err)
+			}
+			if diff := cmp.Diff(tc.wantJobs, jobsList.Items, cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(batchv1.Job{}, "TypeMeta", "ObjectMeta.ResourceVersion")); diff != "" {
+				t.Errorf("Unexpected jobs (-want/+got)\n%s", diff)
+			}
+
+			wlList := kueue.WorkloadList{}
+			if err := client.List(ctx, &wlList); err != nil {
+				t.Errorf("Unexpected list workloads error: %s", err)
+			}
+			var gotAdmissions []kueue.Admission
+			for _, wl := range wlList.Items {
+				if wl.Spec.QueueName != "lq1" {
+					t.Errorf("Unexpected queue name of workload %s: %q", wl.Name, wl.Spec.QueueName)
+				}
+				for k, v := range tc.addLabels {
+					if wl.Labels[k] != v {
+						t.Errorf("Unexpected label %q of workload %s: %q, want %q", k, wl.Name, wl.Labels[k], v)
+					}
+				}
+				if wl.Status.Admission != nil {
+					gotAdmissions = append(gotAdmissions, *wl.Status.Admission)
+				}
+			}
+			if diff := cmp.Diff(tc.wantAdmissions, gotAdmissions, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected admissions (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// ListJobs sends the running objects of the integration, in the namespaces of
+// the import, to ch. The objects owned by other imported objects are left
+// out, as they are accounted for through their owner.
+func ListJobs(ctx context.Context, c client.Client, importCache *cache.ImportCache, newJob func() jobframework.GenericJob, ch chan<- jobframework.GenericJob) error {
+	defer close(ch)
+	gvk := newJob().GVK()
+	for _, ns := range importCache.Namespaces {
+		lst := &metav1.PartialObjectMetadataList{}
+		lst.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		log := ctrl.LoggerFrom(ctx).WithValues("namespace", ns, "kind", gvk.Kind)
+		log.V(3).Info("Begin objects list")
+		defer log.V(3).Info("End objects list")
+		page := 0
+		for {
+			err := c.List(ctx, lst, common.ListOptions(ns, lst.Continue)...)
+			if err != nil {
+				log.Error(err, "list")
+				return fmt.Errorf("listing %s in %s, page %d: %w", gvk.Kind, ns, page, err)
+			}
+
+			for i := range lst.Items {
+				job := newJob()
+				if err := c.Get(ctx, client.ObjectKeyFromObject(&lst.Items[i]), job.Object()); err != nil {
+					if apierrors.IsNotFound(err) {
+						continue
+					}
+					return fmt.Errorf("getting %s %s: %w", gvk.Kind, klog.KObj(&lst.Items[i]), err)
+				}
+				if reason := skipReason(ctx, importCache, job); reason != "" {
+					log.V(2).Info("Skip object", "object", klog.KObj(job.Object()), "reason", reason)
+				} else {
+					ch <- job
+				}
+			}
+			page++
+			if lst.Continue == "" {
+				log.V(2).Info("No more objects", "pages", page)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// skipReason returns why the object is not imported, or an empty string if
+// it should be.
+func skipReason(ctx context.Context, importCache *cache.ImportCache, job jobframework.GenericJob) string {
+	if importCache.IsOwnedByImportedObject(job.Object()) {
+		return "OwnedByImportedObject"
+	}
+	if job.IsSuspended() {
+		return "Suspended"
+	}
+	if _, _, finished := job.Finished(ctx); finished {
+		return "Finished"
+	}
+	if jws, implements := job.(jobframework.JobWithSkip); implements && jws.Skip(ctx) {
+		return "Skipped"
+	}
+	return ""
+}
+
+func jobKey(job *jobframework.GenericJob) string {
+	return client.ObjectKeyFromObject((*job).Object()).String()
+}
@@ -32,8 +32,13 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/job"
 	"sigs.k8s.io/kueue/cmd/importer/mapping"
 	"sigs.k8s.io/kueue/cmd/importer/pod"
+	"sigs.k8s.io/kueue/cmd/importer/report"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobs"
+	podcontroller "sigs.k8s.io/kueue/pkg/controller/jobs/pod"
 	"sigs.k8s.io/kueue/pkg/util/useragent"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
@@ -53,6 +58,9 @@ const (
 	DryRunFlag                  = "dry-run"
 	AddLabelsFlag               = "add-labels"
 	ExcludeResourcePrefixesFlag = "exclude-resource-prefixes"
+	IntegrationsFlag            = "integrations"
+	ReportFileFlag              = "report-file"
+	ReportFormatFlag            = "report-format"
 )
 
 var (
@@ -76,13 +84,16 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSliceP(NamespaceFlag, NamespaceFlagShort, nil, "target namespaces (at least one should be provided)")
 	cmd.Flags().String(QueueLabelFlag, "", "label used to identify the target local queue")
 	cmd.Flags().StringToString(QueueMappingFlag, nil, "mapping from \""+QueueLabelFlag+"\" label values to local queue names")
-	cmd.Flags().StringToString(AddLabelsFlag, nil, "additional label=value pairs to be added to the imported pods and created workloads")
+	cmd.Flags().StringToString(AddLabelsFlag, nil, "additional label=value pairs to be added to the imported objects and created workloads")
 	cmd.Flags().StringSlice(ExcludeResourcePrefixesFlag, nil, "resource name prefixes ignored by Kueue during workload quota accounting")
 	cmd.Flags().String(QueueMappingFileFlag, "", "yaml file containing extra mappings from \""+QueueLabelFlag+"\" label values to local queue names")
 	cmd.Flags().Float32(QPSFlag, 50, "client QPS, as described in https://kubernetes.io/docs/reference/config-api/apiserver-eventratelimit.v1alpha1/#eventratelimit-admission-k8s-io-v1alpha1-Limit")
 	cmd.Flags().Int(BurstFlag, 50, "client Burst, as described in https://kubernetes.io/docs/reference/config-api/apiserver-eventratelimit.v1alpha1/#eventratelimit-admission-k8s-io-v1alpha1-Limit")
 	cmd.Flags().UintP(ConcurrencyFlag, ConcurrencyFlagShort, 8, "number of concurrent import workers")
 	cmd.Flags().Bool(DryRunFlag, true, "don't import, check the config only")
+	cmd.Flags().StringSlice(IntegrationsFlag, []string{podcontroller.FrameworkName}, "names of the integrations whose running objects are imported, for example \"pod,batch/job\"")
+	cmd.Flags().String(ReportFileFlag, "", "file the check writes the unmapped objects and the objects overflowing the quota of their ClusterQueue to")
+	cmd.Flags().String(ReportFormatFlag, string(report.FormatCSV), "format of the report, \"csv\" or \"json\"")
 
 	_ = cmd.MarkFlagRequired(NamespaceFlag)
 	cmd.MarkFlagsRequiredTogether(QueueLabelFlag, QueueMappingFlag)
@@ -92,15 +103,15 @@ func setFlags(cmd *cobra.Command) {
 
 func init() {
 	rootCmd.AddGroup(&cobra.Group{
-		ID:    "pod",
-		Title: "Pods import",
+		ID:    "import",
+		Title: "Objects import",
 	})
 	rootCmd.PersistentFlags().CountP(VerbosityFlag, VerboseFlagShort, "verbosity (specify multiple times to increase the log level)")
 
 	importCmd := &cobra.Command{
 		Use:     "import",
-		GroupID: "pod",
-		Short:   "Checks the prerequisites and import pods.",
+		GroupID: "import",
+		Short:   "Checks the prerequisites and import the running objects.",
 		RunE:    importCmd,
 	}
 	setFlags(importCmd)
@@ -171,7 +182,7 @@ func loadMappingCache(ctx context.Context, c client.Client, cmd *cobra.Command)
 	return cache.Load(ctx, c, namespaces, rules, addLabels, workloadInfoOptions)
 }
 
-func getKubeClient(cmd *cobra.Command) (client.Client, error) {
+func getKubeClient(cmd *cobra.Command, integrations *jobframework.IntegrationManager) (client.Client, error) {
 	kubeConfig, err := ctrl.GetConfig()
 	if err != nil {
 		return nil, err
@@ -193,6 +204,14 @@ func getKubeClient(cmd *cobra.Command) (client.Client, error) {
 	if err := kueue.AddToScheme(scheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := integrations.ForEachIntegration(func(_ string, cb jobframework.IntegrationCallbacks) error {
+		if cb.AddToScheme != nil {
+			return cb.AddToScheme(scheme.Scheme)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
 	c, err := client.New(kubeConfig, client.Options{Scheme: scheme.Scheme})
 	if err != nil {
@@ -205,7 +224,16 @@ func importCmd(cmd *cobra.Command, _ []string) error {
 	log := ctrl.Log.WithName("import")
 	ctx := ctrl.LoggerInto(context.Background(), log)
 	cWorkers, _ := cmd.Flags().GetUint(ConcurrencyFlag)
-	c, err := getKubeClient(cmd)
+	reportFile, _ := cmd.Flags().GetString(ReportFileFlag)
+	reportFormat, _ := cmd.Flags().GetString(ReportFormatFlag)
+	if reportFormat != string(report.FormatCSV) && reportFormat != string(report.FormatJSON) {
+		return fmt.Errorf("%s: unknown format %q", ReportFormatFlag, reportFormat)
+	}
+	integrations, err := loadIntegrations(cmd)
+	if err != nil {
+		return err
+	}
+	c, err := getKubeClient(cmd, integrations)
 	if err != nil {
 		return err
 	}
@@ -214,8 +242,28 @@ func importCmd(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	cache.IntegrationManager = integrations
 
-	if err = pod.Check(ctx, c, cache, cWorkers); err != nil {
+	var rep *report.Report
+	if reportFile != "" {
+		rep = report.New()
+	}
+
+	names, _ := cmd.Flags().GetStringSlice(IntegrationsFlag)
+	for _, name := range names {
+		if err = checkIntegration(ctx, c, cache, integrations, name, rep, cWorkers); err != nil {
+			break
+		}
+	}
+	// The report is written even if the check fails, as it's where the
+	// failing objects are listed.
+	if rep != nil {
+		if reportErr := report.WriteFile(reportFile, report.Format(reportFormat), rep.Entries(cache.ClusterQueues)); reportErr != nil {
+			return errors.Join(err, fmt.Errorf("writing the report: %w", reportErr))
+		}
+		log.Info("Report written", "file", reportFile)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -223,5 +271,49 @@ func importCmd(cmd *cobra.Command, _ []string) error {
 		fmt.Printf("%q is enabled by default, use \"--%s=false\" to continue with the import\n", DryRunFlag, DryRunFlag)
 		return nil
 	}
-	return pod.Import(ctx, c, cache, cWorkers)
+	for _, name := range names {
+		if err = importIntegration(ctx, c, cache, integrations, name, cWorkers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadIntegrations returns an integration manager with the integrations
+// being imported enabled.
+func loadIntegrations(cmd *cobra.Command) (*jobframework.IntegrationManager, error) {
+	names, err := cmd.Flags().GetStringSlice(IntegrationsFlag)
+	if err != nil {
+		return nil, err
+	}
+	manager := jobs.NewIntegrationManager()
+	for _, name := range names {
+		cb, found := manager.GetIntegration(name)
+		if !found {
+			return nil, fmt.Errorf("%s: unknown integration %q, known integrations are %v", IntegrationsFlag, name, manager.GetIntegrationsList())
+		}
+		if cb.NewJob == nil {
+			return nil, fmt.Errorf("%s: integration %q can't be imported, import its pods with the %q integration instead", IntegrationsFlag, name, podcontroller.FrameworkName)
+		}
+		manager.EnableIntegration(name)
+	}
+	return manager, nil
+}
+
+func checkIntegration(ctx context.Context, c client.Client, cache *cache.ImportCache, integrations *jobframework.IntegrationManager, name string, rep *report.Report, cWorkers uint) error {
+	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("integration", name))
+	if name == podcontroller.FrameworkName {
+		return pod.Check(ctx, c, cache, rep, cWorkers)
+	}
+	cb, _ := integrations.GetIntegration(name)
+	return job.Check(ctx, c, cache, cb.NewJob, rep, cWorkers)
+}
+
+func importIntegration(ctx context.Context, c client.Client, cache *cache.ImportCache, integrations *jobframework.IntegrationManager, name string, cWorkers uint) error {
+	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("integration", name))
+	if name == podcontroller.FrameworkName {
+		return pod.Import(ctx, c, cache, cWorkers)
+	}
+	cb, _ := integrations.GetIntegration(name)
+	return job.Import(ctx, c, cache, cb.NewJob, cWorkers)
 }
@@ -20,29 +20,146 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/yaml"
 )
 
 var (
-	ErrNoMapping = errors.New("no mapping found")
+	ErrNoMapping   = errors.New("no mapping found")
+	ErrNotCompiled = errors.New("mapping rules not compiled")
 )
 
+// Object holds the properties of an object the rules are matched against.
+type Object struct {
+	Namespace         string
+	NamespaceLabels   map[string]string
+	PriorityClassName string
+	Labels            map[string]string
+	// OwnerKind is the kind of the controller of the object, if any.
+	OwnerKind string
+	// Content returns the unstructured content of the object, it's only
+	// called to evaluate CEL expressions.
+	Content func() (map[string]any, error)
+}
+
 type Match struct {
 	PriorityClassName string            `json:"priorityClassName"`
 	Labels            map[string]string `json:"labels"`
+	// LabelSelector selects the objects by their labels, with both equality
+	// and set-based (In, NotIn, Exists, DoesNotExist) requirements.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// NamespaceSelector selects the objects by the labels of their namespace.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// OwnerKind is the kind of the controller the objects need to have.
+	OwnerKind string `json:"ownerKind,omitempty"`
+	// CEL is an expression evaluating to a bool, in which `object` is the
+	// object and `namespaceLabels` the labels of its namespace.
+	CEL string `json:"cel,omitempty"`
+
+	labelSelector     labels.Selector
+	namespaceSelector labels.Selector
+	program           cel.Program
 }
 
-func (mm *Match) Match(priorityClassName string, labels map[string]string) bool {
-	if mm.PriorityClassName != "" && priorityClassName != mm.PriorityClassName {
-		return false
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("namespaceLabels", cel.MapType(cel.StringType, cel.StringType)),
+	)
+})
+
+func (mm *Match) compile() error {
+	var err error
+	if mm.LabelSelector != nil {
+		if mm.labelSelector, err = metav1.LabelSelectorAsSelector(mm.LabelSelector); err != nil {
+			return fmt.Errorf("labelSelector: %w", err)
+		}
+	}
+	if mm.NamespaceSelector != nil {
+		if mm.namespaceSelector, err = metav1.LabelSelectorAsSelector(mm.NamespaceSelector); err != nil {
+			return fmt.Errorf("namespaceSelector: %w", err)
+		}
+	}
+	if mm.CEL != "" {
+		env, err := celEnv()
+		if err != nil {
+			return err
+		}
+		ast, issues := env.Compile(mm.CEL)
+		if issues.Err() != nil {
+			return fmt.Errorf("cel: %w", issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType {
+			return fmt.Errorf("cel: expression of type %s, expected bool", ast.OutputType())
+		}
+		if mm.program, err = env.Program(ast); err != nil {
+			return fmt.Errorf("cel: %w", err)
+		}
+	}
+	return nil
+}
+
+func (mm *Match) compiled() bool {
+	return (mm.LabelSelector == nil || mm.labelSelector != nil) &&
+		(mm.NamespaceSelector == nil || mm.namespaceSelector != nil) &&
+		(mm.CEL == "" || mm.program != nil)
+}
+
+func (mm *Match) Match(obj *Object) (bool, error) {
+	if !mm.compiled() {
+		return false, ErrNotCompiled
+	}
+	if mm.PriorityClassName != "" && obj.PriorityClassName != mm.PriorityClassName {
+		return false, nil
 	}
 	for l, lv := range mm.Labels {
-		if labels[l] != lv {
-			return false
+		if obj.Labels[l] != lv {
+			return false, nil
 		}
 	}
-	return true
+	if mm.labelSelector != nil && !mm.labelSelector.Matches(labels.Set(obj.Labels)) {
+		return false, nil
+	}
+	if mm.namespaceSelector != nil && !mm.namespaceSelector.Matches(labels.Set(obj.NamespaceLabels)) {
+		return false, nil
+	}
+	if mm.OwnerKind != "" && obj.OwnerKind != mm.OwnerKind {
+		return false, nil
+	}
+	if mm.program != nil {
+		return mm.evaluate(obj)
+	}
+	return true, nil
+}
+
+func (mm *Match) evaluate(obj *Object) (bool, error) {
+	var content map[string]any
+	if obj.Content != nil {
+		var err error
+		if content, err = obj.Content(); err != nil {
+			return false, err
+		}
+	}
+	namespaceLabels := obj.NamespaceLabels
+	if namespaceLabels == nil {
+		namespaceLabels = map[string]string{}
+	}
+	out, _, err := mm.program.Eval(map[string]any{
+		"object":          content,
+		"namespaceLabels": namespaceLabels,
+	})
+	if err != nil {
+		return false, fmt.Errorf("evaluating %q: %w", mm.CEL, err)
+	}
+	matches, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("evaluating %q: got %v, expected a bool", mm.CEL, out.Value())
+	}
+	return matches, nil
 }
 
 type Rule struct {
@@ -53,13 +170,29 @@ type Rule struct {
 
 type Rules []Rule
 
-func (mr Rules) QueueFor(priorityClassName string, labels map[string]string) (string, bool, bool) {
+// Compile validates the selectors and CEL expressions of the rules and
+// prepares them for matching. It needs to be called before QueueFor.
+func (mr Rules) Compile() error {
+	var errs []error
 	for i := range mr {
-		if mr[i].Match.Match(priorityClassName, labels) {
-			return mr[i].ToLocalQueue, mr[i].Skip, true
+		if err := mr[i].Match.compile(); err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", i, err))
 		}
 	}
-	return "", false, false
+	return errors.Join(errs...)
+}
+
+func (mr Rules) QueueFor(obj *Object) (string, bool, bool, error) {
+	for i := range mr {
+		matches, err := mr[i].Match.Match(obj)
+		if err != nil {
+			return "", false, false, fmt.Errorf("rule %d: %w", i, err)
+		}
+		if matches {
+			return mr[i].ToLocalQueue, mr[i].Skip, true, nil
+		}
+	}
+	return "", false, false, nil
 }
 
 func RulesFromFile(mappingFile string) (Rules, error) {
@@ -72,6 +205,9 @@ func RulesFromFile(mappingFile string) (Rules, error) {
 	if err != nil {
 		return nil, fmt.Errorf("decoding %q: %w", mappingFile, err)
 	}
+	if err := ret.Compile(); err != nil {
+		return nil, fmt.Errorf("compiling %q: %w", mappingFile, err)
+	}
 	return ret, nil
 }
 
@@ -17,11 +17,15 @@ limitations under the License.
 package mapping
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -41,6 +45,22 @@ const (
       project_id: alpha
       resource_type: cpu
   skip: true
+- match:
+    namespaceSelector:
+      matchLabels:
+        tenant: beta
+    labelSelector:
+      matchExpressions:
+      - key: resource_type
+        operator: In
+        values: [cpu, gpu]
+      - key: legacy
+        operator: DoesNotExist
+    ownerKind: Job
+  toLocalQueue: beta
+- match:
+    cel: object.spec.containers.size() > 1
+  toLocalQueue: multi-container
 `
 )
 
@@ -72,6 +92,27 @@ var testMappingRules = Rules{
 		},
 		Skip: true,
 	},
+	{
+		Match: Match{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "beta"},
+			},
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "resource_type", Operator: metav1.LabelSelectorOpIn, Values: []string{"cpu", "gpu"}},
+					{Key: "legacy", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			OwnerKind: "Job",
+		},
+		ToLocalQueue: "beta",
+	},
+	{
+		Match: Match{
+			CEL: "object.spec.containers.size() > 1",
+		},
+		ToLocalQueue: "multi-container",
+	},
 }
 
 func TestRulesFromFile(t *testing.T) {
@@ -87,59 +128,154 @@ func TestRulesFromFile(t *testing.T) {
 		t.Fatalf("unexpected load error: %s", err)
 	}
 
-	if diff := cmp.Diff(testMappingRules, rules); diff != "" {
+	if diff := cmp.Diff(testMappingRules, rules, cmpopts.IgnoreUnexported(Match{})); diff != "" {
 		t.Errorf("unexpected mapping(want-/ got+):\n%s", diff)
 	}
 }
 
+func TestRulesFromFileInvalid(t *testing.T) {
+	cases := map[string]string{
+		"invalid label selector": `
+- match:
+    labelSelector:
+      matchExpressions:
+      - key: resource_type
+        operator: In
+  toLocalQueue: lq
+`,
+		"invalid cel": `
+- match:
+    cel: object.spec.
+  toLocalQueue: lq
+`,
+		"cel not evaluating to a bool": `
+- match:
+    cel: namespaceLabels["tenant"]
+  toLocalQueue: lq
+`,
+	}
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			fPath := filepath.Join(t.TempDir(), "mapping.yaml")
+			if err := os.WriteFile(fPath, []byte(content), os.FileMode(0600)); err != nil {
+				t.Fatalf("unable to create the test file: %s", err)
+			}
+			if _, err := RulesFromFile(fPath); err == nil {
+				t.Error("expecting an error")
+			}
+		})
+	}
+}
+
 func TestRulesQueueFor(t *testing.T) {
+	podWithContainers := func(n int) func() (map[string]any, error) {
+		return func() (map[string]any, error) {
+			containers := make([]any, n)
+			for i := range containers {
+				containers[i] = map[string]any{"name": "c"}
+			}
+			return map[string]any{"spec": map[string]any{"containers": containers}}, nil
+		}
+	}
 	cases := map[string]struct {
-		className string
-		labels    map[string]string
-		rules     Rules
+		object Object
 
 		wantMatch bool
 		wantSkip  bool
 		wantQueue string
 	}{
 		"missing one label": {
-			labels: map[string]string{"project_id": "alpha"},
-			rules:  testMappingRules,
+			object: Object{Labels: map[string]string{"project_id": "alpha"}, Content: podWithContainers(1)},
 		},
 		"priority class not checked if not part of the rule": {
-			className: "preemptible",
-			labels:    map[string]string{"project_id": "alpha", "resource_type": "gpu"},
-			rules:     testMappingRules,
+			object: Object{
+				PriorityClassName: "preemptible",
+				Labels:            map[string]string{"project_id": "alpha", "resource_type": "gpu"},
+			},
 
 			wantMatch: true,
 			wantQueue: "alpha-gpu",
 		},
 		"skip": {
-			className: "preemptible",
-			labels:    map[string]string{"project_id": "alpha", "resource_type": "cpu"},
-			rules:     testMappingRules,
+			object: Object{
+				PriorityClassName: "preemptible",
+				Labels:            map[string]string{"project_id": "alpha", "resource_type": "cpu"},
+			},
 
 			wantMatch: true,
 			wantSkip:  true,
 		},
 		"priority class not matching": {
-			className: "preemptible-1",
-			labels:    map[string]string{"resource_type": "cpu-only"},
-			rules:     testMappingRules,
+			object: Object{
+				PriorityClassName: "preemptible-1",
+				Labels:            map[string]string{"resource_type": "cpu-only"},
+				Content:           podWithContainers(1),
+			},
 		},
 		"priority class matching": {
-			className: "preemptible",
-			labels:    map[string]string{"resource_type": "cpu-only"},
-			rules:     testMappingRules,
+			object: Object{
+				PriorityClassName: "preemptible",
+				Labels:            map[string]string{"resource_type": "cpu-only"},
+			},
 
 			wantMatch: true,
 			wantQueue: "preemptible-cpu",
 		},
+		"namespace, label selector and owner kind matching": {
+			object: Object{
+				NamespaceLabels: map[string]string{"tenant": "beta"},
+				Labels:          map[string]string{"resource_type": "gpu"},
+				OwnerKind:       "Job",
+			},
+
+			wantMatch: true,
+			wantQueue: "beta",
+		},
+		"namespace selector not matching": {
+			object: Object{
+				NamespaceLabels: map[string]string{"tenant": "gamma"},
+				Labels:          map[string]string{"resource_type": "gpu"},
+				OwnerKind:       "Job",
+				Content:         podWithContainers(1),
+			},
+		},
+		"label selector expression not matching": {
+			object: Object{
+				NamespaceLabels: map[string]string{"tenant": "beta"},
+				Labels:          map[string]string{"resource_type": "gpu", "legacy": "true"},
+				OwnerKind:       "Job",
+				Content:         podWithContainers(1),
+			},
+		},
+		"owner kind not matching": {
+			object: Object{
+				NamespaceLabels: map[string]string{"tenant": "beta"},
+				Labels:          map[string]string{"resource_type": "gpu"},
+				OwnerKind:       "ReplicaSet",
+				Content:         podWithContainers(1),
+			},
+		},
+		"cel matching": {
+			object: Object{Content: podWithContainers(2)},
+
+			wantMatch: true,
+			wantQueue: "multi-container",
+		},
+		"cel not matching": {
+			object: Object{Content: podWithContainers(1)},
+		},
 	}
 
+	rules := slices.Clone(testMappingRules)
+	if err := rules.Compile(); err != nil {
+		t.Fatalf("unexpected compile error: %s", err)
+	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			gotQueue, gotSkip, gotMatch := tc.rules.QueueFor(tc.className, tc.labels)
+			gotQueue, gotSkip, gotMatch, err := rules.QueueFor(&tc.object)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
 
 			if tc.wantMatch != gotMatch {
 				t.Errorf("unexpected match %v", gotMatch)
@@ -155,3 +291,10 @@ func TestRulesQueueFor(t *testing.T) {
 		})
 	}
 }
+
+func TestRulesQueueForNotCompiled(t *testing.T) {
+	rules := Rules{{Match: Match{CEL: "true"}, ToLocalQueue: "lq"}}
+	if _, _, _, err := rules.QueueFor(&Object{}); !errors.Is(err, ErrNotCompiled) {
+		t.Errorf("unexpected error %v, want %v", err, ErrNotCompiled)
+	}
+}
@@ -21,8 +21,6 @@ import (
 	"errors"
 	"fmt"
 	"maps"
-	"slices"
-	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -31,6 +29,9 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
+	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	"sigs.k8s.io/kueue/cmd/importer/report"
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/pod"
@@ -38,16 +39,21 @@ import (
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
+const podKind = "Pod"
+
 // checkedWorkload is the outcome of validating a Pod against its target
 // ClusterQueue: the Workload it would produce, the flavor assigned to each
 // requested resource, and the resolved priority.
 type checkedWorkload struct {
 	workload *kueue.Workload
 	flavors  map[corev1.ResourceName]kueue.ResourceFlavorReference
+	usage    resources.FlavorResourceQuantities
 	priority int32
 }
 
-func Check(ctx context.Context, c client.Client, importCache *cache.ImportCache, jobs uint) error {
+// Check validates that the running Pods can be imported. The unmapped Pods and
+// the usage of the others are recorded in rep, if not nil.
+func Check(ctx context.Context, c client.Client, importCache *cache.ImportCache, rep *report.Report, jobs uint) error {
 	ch := make(chan corev1.Pod)
 	go func() {
 		err := ListPods(ctx, c, importCache.Namespaces, ch)
@@ -55,11 +61,14 @@ func Check(ctx context.Context, c client.Client, importCache *cache.ImportCache,
 			ctrl.LoggerFrom(ctx).Error(err, "Listing pods")
 		}
 	}()
-	summary := ProcessConcurrently(ch, jobs, func(p *corev1.Pod) (bool, error) {
+	summary := common.ProcessConcurrently(ch, jobs, podKey, func(p *corev1.Pod) (bool, error) {
 		log := ctrl.LoggerFrom(ctx).WithValues("pod", klog.KObj(p))
 		log.V(3).Info("Checking")
 
 		lq, cq, skip, err := resolveQueues(importCache, p)
+		if errors.Is(err, mapping.ErrNoMapping) {
+			rep.AddUnmapped(podKind, podKey(p))
+		}
 		if skip || err != nil {
 			return skip, err
 		}
@@ -69,22 +78,27 @@ func Check(ctx context.Context, c client.Client, importCache *cache.ImportCache,
 			return false, err
 		}
 
+		rep.AddUsage(podKind, podKey(p), cq.Name, checked.usage)
 		// flavors reflects the per-resource assignments validated against the workload's requests.
 		log.V(2).Info("Successfully checked", "clusterQueue", klog.KObj(cq), "priority", checked.priority, "flavors", checked.flavors)
 		return false, nil
 	})
 
 	log := ctrl.LoggerFrom(ctx)
-	log.Info("Check done", "checked", summary.TotalPods, "skipped", summary.SkippedPods, "failed", summary.FailedPods)
-	for e, pods := range summary.ErrorsForPods {
+	log.Info("Check done", "checked", summary.Total, "skipped", summary.Skipped, "failed", summary.Failed)
+	for e, pods := range summary.ErrorsForObject {
 		log.Info("Validation failed for Pods", "err", e, "occurrences", len(pods), "observedFirstIn", pods[0])
 	}
 	return errors.Join(summary.Errors...)
 }
 
 // resolveQueues resolves the Pod to its LocalQueue and ClusterQueue.
-// It returns skip=true when mapping says this Pod should be skipped.
+// It returns skip=true when mapping says this Pod should be skipped, or when
+// the Pod is owned by an object imported along with it.
 func resolveQueues(importCache *cache.ImportCache, p *corev1.Pod) (*kueue.LocalQueue, *kueue.ClusterQueue, bool, error) {
+	if importCache.IsOwnedByImportedObject(p) {
+		return nil, nil, true, nil
+	}
 	lq, skip, err := importCache.LocalQueueForPod(p)
 	if skip || err != nil {
 		return nil, nil, skip, err
@@ -101,7 +115,7 @@ func resolveQueues(importCache *cache.ImportCache, p *corev1.Pod) (*kueue.LocalQ
 // resolved priority.
 func checkPodWorkload(ctx context.Context, c client.Client, importCache *cache.ImportCache, p *corev1.Pod, lqName string, cq *kueue.ClusterQueue) (*checkedWorkload, error) {
 	if oldLq, found := p.Labels[controllerconstants.QueueLabel]; found && oldLq != lqName {
-		return nil, &queueLabelConflictError{CurrentQueue: oldLq, ExpectedQueue: lqName}
+		return nil, &common.QueueLabelConflictError{CurrentQueue: oldLq, ExpectedQueue: lqName}
 	}
 	if len(cq.Spec.ResourceGroups) == 0 {
 		return nil, fmt.Errorf("%q has no resource groups: %w", cq.Name, cache.ErrCQInvalid)
@@ -135,7 +149,7 @@ func checkPodWorkload(ctx context.Context, c client.Client, importCache *cache.I
 	maps.Copy(wl.Labels, importCache.AddLabels)
 
 	info := workload.NewInfo(wl, importCache.WorkloadInfoOptions()...)
-	flavors, err := flavorAssignmentsForRequests(importCache.FlavorsByResourceForClusterQueue(kueue.ClusterQueueReference(cq.Name)), cq.Name, info.TotalRequests[0].Requests)
+	flavors, err := common.FlavorAssignmentsForRequests(importCache.FlavorsByResourceForClusterQueue(kueue.ClusterQueueReference(cq.Name)), cq.Name, info.TotalRequests[0].Requests)
 	if err != nil {
 		return nil, err
 	}
@@ -149,39 +163,8 @@ func checkPodWorkload(ctx context.Context, c client.Client, importCache *cache.I
 		return nil, fmt.Errorf("%q: %w", p.Spec.PriorityClassName, cache.ErrPCNotFound)
 	}
 
-	return &checkedWorkload{workload: wl, flavors: flavors, priority: pv}, nil
-}
-
-// flavorAssignmentsForRequests assigns a flavor to each non-zero requested
-// resource, using the ClusterQueue's precomputed resource-to-flavor map.
-func flavorAssignmentsForRequests(
-	flavorsByResource map[corev1.ResourceName]kueue.ResourceFlavorReference,
-	cqName string,
-	requests resources.Requests,
-) (map[corev1.ResourceName]kueue.ResourceFlavorReference, error) {
-	type rq struct {
-		name corev1.ResourceName
-		qty  int64
-	}
-	pairs := make([]rq, 0, requests.Len())
-	requests.ForEach(func(name corev1.ResourceName, quantity int64) {
-		pairs = append(pairs, rq{name, quantity})
-	})
-	slices.SortFunc(pairs, func(a, b rq) int { return strings.Compare(string(a.name), string(b.name)) })
-
-	flavors := make(map[corev1.ResourceName]kueue.ResourceFlavorReference)
-	for _, p := range pairs {
-		if p.qty == 0 {
-			continue
-		}
-		flv, ok := flavorsByResource[p.name]
-		if !ok {
-			return nil, &resourceNotCoveredError{Resource: p.name, ClusterQueue: cqName}
-		}
-		flavors[p.name] = flv
-	}
-
-	return flavors, nil
+	usage := common.FlavorResourceUsage(info.TotalRequests, []map[corev1.ResourceName]kueue.ResourceFlavorReference{flavors})
+	return &checkedWorkload{workload: wl, flavors: flavors, usage: usage, priority: pv}, nil
 }
 
 // preparePodForWorkload returns a labeled copy of p for Workload construction.
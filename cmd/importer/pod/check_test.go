@@ -27,9 +27,10 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
 	"sigs.k8s.io/kueue/cmd/importer/mapping"
+	"sigs.k8s.io/kueue/cmd/importer/report"
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
-	"sigs.k8s.io/kueue/pkg/resources"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
@@ -68,8 +69,10 @@ func TestCheckNamespace(t *testing.T) {
 		flavors                  []kueue.ResourceFlavor
 		priorityClasses          []schedulingv1.PriorityClass
 		excludedResourcePrefixes []string
+		namespaces               []corev1.Namespace
 
-		wantError error
+		wantError  error
+		wantReport []report.Entry
 	}{
 		"empty cluster": {},
 		"no mapping": {
@@ -77,6 +80,63 @@ func TestCheckNamespace(t *testing.T) {
 				*basePodWrapper.DeepCopy(),
 			},
 			wantError: mapping.ErrNoMapping,
+			wantReport: []report.Entry{
+				{Kind: "Pod", Object: "ns/pod", Reason: report.ReasonUnmapped},
+			},
+		},
+		"namespace selector not matching": {
+			pods: []corev1.Pod{
+				*basePodWrapper.DeepCopy(),
+			},
+			mapping: mapping.Rules{{
+				Match: mapping.Match{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				},
+				ToLocalQueue: "lq1",
+			}},
+			namespaces: []corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: testingNamespace, Labels: map[string]string{"tenant": "b"}}},
+			},
+			wantError: mapping.ErrNoMapping,
+			wantReport: []report.Entry{
+				{Kind: "Pod", Object: "ns/pod", Reason: report.ReasonUnmapped},
+			},
+		},
+		"quota overflow": {
+			pods: []corev1.Pod{
+				*basePodWrapper.Clone().Name("pod-a").Request(corev1.ResourceCPU, "1").Obj(),
+				*basePodWrapper.Clone().Name("pod-b").Request(corev1.ResourceCPU, "1").Obj(),
+			},
+			mapping: mapping.Rules{{
+				Match: mapping.Match{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				},
+				ToLocalQueue: "lq1",
+			}},
+			namespaces: []corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: testingNamespace, Labels: map[string]string{"tenant": "a"}}},
+			},
+			localQueues: []kueue.LocalQueue{
+				*baseLocalQueue.Obj(),
+			},
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("cq1").ResourceGroup(*utiltestingapi.MakeFlavorQuotas("rf1").Resource(corev1.ResourceCPU, "1500m").Obj()).Obj(),
+			},
+			flavors: []kueue.ResourceFlavor{
+				*utiltestingapi.MakeResourceFlavor("rf1").Obj(),
+			},
+			wantReport: []report.Entry{
+				{
+					Kind:         "Pod",
+					Object:       "ns/pod-b",
+					Reason:       report.ReasonQuotaOverflow,
+					ClusterQueue: "cq1",
+					Flavor:       "rf1",
+					Resource:     "cpu",
+					Usage:        "2",
+					NominalQuota: "1500m",
+				},
+			},
 		},
 		"no local queue": {
 			pods: []corev1.Pod{
@@ -121,7 +181,7 @@ func TestCheckNamespace(t *testing.T) {
 			clusterQueues: []kueue.ClusterQueue{
 				*baseClusterQueue.Obj(),
 			},
-			wantError: &queueLabelConflictError{CurrentQueue: "other-lq", ExpectedQueue: "lq1"},
+			wantError: &common.QueueLabelConflictError{CurrentQueue: "other-lq", ExpectedQueue: "lq1"},
 		},
 		"known ResourceFlavor assignment with uncovered request fails assignment": {
 			pods: []corev1.Pod{
@@ -139,7 +199,7 @@ func TestCheckNamespace(t *testing.T) {
 			flavors: []kueue.ResourceFlavor{
 				*utiltestingapi.MakeResourceFlavor("rf1").Obj(),
 			},
-			wantError: &resourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq1"},
+			wantError: &common.ResourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq1"},
 		},
 		"excluded resource request is ignored": {
 			pods:        []corev1.Pod{*basePodWrapper.Clone().Request(corev1.ResourceName("vendor.com/special"), "1").Obj()},
@@ -188,7 +248,7 @@ func TestCheckNamespace(t *testing.T) {
 			flavors: []kueue.ResourceFlavor{
 				*utiltestingapi.MakeResourceFlavor("rf1").Obj(),
 			},
-			wantError: &resourceNotCoveredError{Resource: corev1.ResourceEphemeralStorage, ClusterQueue: "cq1"},
+			wantError: &common.ResourceNotCoveredError{Resource: corev1.ResourceEphemeralStorage, ClusterQueue: "cq1"},
 		},
 		"all found": {
 			pods: []corev1.Pod{
@@ -248,9 +308,10 @@ func TestCheckNamespace(t *testing.T) {
 			lqList := kueue.LocalQueueList{Items: tc.localQueues}
 			rfList := kueue.ResourceFlavorList{Items: tc.flavors}
 			pcList := schedulingv1.PriorityClassList{Items: tc.priorityClasses}
+			nsList := corev1.NamespaceList{Items: tc.namespaces}
 
 			builder := utiltesting.NewClientBuilder()
-			builder = builder.WithLists(&podsList, &cqList, &lqList, &rfList, &pcList)
+			builder = builder.WithLists(&podsList, &cqList, &lqList, &rfList, &pcList, &nsList)
 
 			client := builder.Build()
 			ctx, _ := utiltesting.ContextWithLog(t)
@@ -260,67 +321,13 @@ func TestCheckNamespace(t *testing.T) {
 				t.Fatalf("Unexpected cache load error: %s", err)
 			}
 
-			gotErr := Check(ctx, client, mpc, 8)
+			rep := report.New()
+			gotErr := Check(ctx, client, mpc, rep, 8)
 			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
 				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
 			}
-		})
-	}
-}
-
-func TestFlavorAssignmentsForRequests(t *testing.T) {
-	const cqName = "cq"
-	flavorsByResource := map[corev1.ResourceName]kueue.ResourceFlavorReference{
-		corev1.ResourceCPU: "cpu-flavor",
-	}
-
-	cases := map[string]struct {
-		requests  resources.Requests
-		want      map[corev1.ResourceName]kueue.ResourceFlavorReference
-		wantError error
-	}{
-		"assigns covered non-zero resources": {
-			requests: resources.MapRequests{
-				corev1.ResourceCPU: 1000,
-			},
-			want: map[corev1.ResourceName]kueue.ResourceFlavorReference{
-				corev1.ResourceCPU: "cpu-flavor",
-			},
-		},
-		"ignores uncovered zero-quantity resources": {
-			requests: resources.MapRequests{
-				corev1.ResourceCPU:                    1000,
-				corev1.ResourceName("nvidia.com/gpu"): 0,
-			},
-			want: map[corev1.ResourceName]kueue.ResourceFlavorReference{
-				corev1.ResourceCPU: "cpu-flavor",
-			},
-		},
-		"fails for uncovered non-zero resources": {
-			requests: resources.MapRequests{
-				corev1.ResourceName("nvidia.com/gpu"): 1,
-			},
-			wantError: &resourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq"},
-		},
-		"fails with the lexicographically first uncovered non-zero resource": {
-			requests: resources.MapRequests{
-				corev1.ResourceName("z.example.com/resource"): 1,
-				corev1.ResourceName("a.example.com/resource"): 1,
-			},
-			wantError: &resourceNotCoveredError{Resource: corev1.ResourceName("a.example.com/resource"), ClusterQueue: "cq"},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got, gotErr := flavorAssignmentsForRequests(flavorsByResource, cqName, tc.requests)
-
-			if diff := cmp.Diff(tc.wantError, gotErr, cmpopts.EquateErrors()); diff != "" {
-				t.Fatalf("Unexpected error (-want/+got)\n%s", diff)
-			}
-
-			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
-				t.Fatalf("Unexpected flavors (-want/+got)\n%s", diff)
+			if diff := cmp.Diff(tc.wantReport, rep.Entries(mpc.ClusterQueues), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected report (-want/+got)\n%s", diff)
 			}
 		})
 	}
@@ -31,6 +31,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/cmd/importer/cache"
+	"sigs.k8s.io/kueue/cmd/importer/common"
 	"sigs.k8s.io/kueue/cmd/importer/mapping"
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	controllerpod "sigs.k8s.io/kueue/pkg/controller/jobs/pod"
@@ -279,7 +280,7 @@ func TestImportNamespace(t *testing.T) {
 					Label(controllerconstants.QueueLabel, "other-lq").
 					Obj(),
 			},
-			wantError:     &queueLabelConflictError{CurrentQueue: "other-lq", ExpectedQueue: "lq1"},
+			wantError:     &common.QueueLabelConflictError{CurrentQueue: "other-lq", ExpectedQueue: "lq1"},
 			wantWorkloads: []kueue.Workload{},
 		},
 		"missing cluster queue": {
@@ -319,7 +320,7 @@ func TestImportNamespace(t *testing.T) {
 			flavors: []kueue.ResourceFlavor{
 				*utiltestingapi.MakeResourceFlavor("cpu-flavor").Obj(),
 			},
-			wantError: &resourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq1"},
+			wantError: &common.ResourceNotCoveredError{Resource: corev1.ResourceName("nvidia.com/gpu"), ClusterQueue: "cq1"},
 			wantPods: []corev1.Pod{
 				*baseGpuPodWrapper.DeepCopy(),
 			},
@@ -19,43 +19,16 @@ package pod
 import (
 	"context"
 	"fmt"
-	"sync"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"sigs.k8s.io/kueue/cmd/importer/common"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 )
 
-const (
-	ListLength = 100
-)
-
-func listOptions(namespace, continueToken string) []client.ListOption {
-	opts := []client.ListOption{
-		client.InNamespace(namespace),
-		client.Limit(ListLength),
-		client.Continue(continueToken),
-	}
-	return opts
-}
-
-type ProcessResult struct {
-	Pod  string
-	Err  error
-	Skip bool
-}
-
-type ProcessSummary struct {
-	TotalPods     int
-	SkippedPods   int
-	FailedPods    int
-	ErrorsForPods map[string][]string
-	Errors        []error
-}
-
 func ListPods(ctx context.Context, c client.Client, namespaces []string, ch chan<- corev1.Pod) error {
 	defer close(ch)
 	for _, ns := range namespaces {
@@ -65,7 +38,7 @@ func ListPods(ctx context.Context, c client.Client, namespaces []string, ch chan
 		defer log.V(3).Info("End pods list")
 		page := 0
 		for {
-			err := c.List(ctx, lst, listOptions(ns, lst.Continue)...)
+			err := c.List(ctx, lst, common.ListOptions(ns, lst.Continue)...)
 			if err != nil {
 				log.Error(err, "list")
 				return fmt.Errorf("listing pods in %s, page %d: %w", ns, page, err)
@@ -88,39 +61,6 @@ func ListPods(ctx context.Context, c client.Client, namespaces []string, ch chan
 	return nil
 }
 
-func ProcessConcurrently(ch <-chan corev1.Pod, jobs uint, f func(p *corev1.Pod) (bool, error)) ProcessSummary {
-	wg := sync.WaitGroup{}
-	resultCh := make(chan ProcessResult)
-
-	for range jobs {
-		wg.Go(func() {
-			for pod := range ch {
-				skip, err := f(&pod)
-				resultCh <- ProcessResult{Pod: client.ObjectKeyFromObject(&pod).String(), Err: err, Skip: skip}
-			}
-		})
-	}
-	go func() {
-		wg.Wait()
-		close(resultCh)
-	}()
-
-	ps := ProcessSummary{
-		ErrorsForPods: make(map[string][]string),
-	}
-	for result := range resultCh {
-		ps.TotalPods++
-		if result.Skip {
-			ps.SkippedPods++
-		}
-		if result.Err != nil {
-			ps.FailedPods++
-			estr := result.Err.Error()
-			if _, found := ps.ErrorsForPods[estr]; !found {
-				ps.Errors = append(ps.Errors, result.Err)
-			}
-			ps.ErrorsForPods[estr] = append(ps.ErrorsForPods[estr], result.Pod)
-		}
-	}
-	return ps
+func podKey(p *corev1.Pod) string {
+	return client.ObjectKeyFromObject(p).String()
 }
@@ -0,0 +1,225 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+type Reason string
+
+const (
+	// ReasonUnmapped is reported for the objects no mapping rule matches.
+	ReasonUnmapped Reason = "Unmapped"
+	// ReasonQuotaOverflow is reported for the objects whose usage, added to
+	// the usage of the ClusterQueue and of the objects checked before them,
+	// exceeds the nominal quota of the ClusterQueue.
+	ReasonQuotaOverflow Reason = "QuotaOverflow"
+)
+
+// Entry is a line of the report.
+type Entry struct {
+	Kind         string `json:"kind"`
+	Object       string `json:"object"`
+	Reason       Reason `json:"reason"`
+	ClusterQueue string `json:"clusterQueue,omitempty"`
+	Flavor       string `json:"flavor,omitempty"`
+	Resource     string `json:"resource,omitempty"`
+	// Usage is the usage of the ClusterQueue once the object is accounted.
+	Usage        string `json:"usage,omitempty"`
+	NominalQuota string `json:"nominalQuota,omitempty"`
+}
+
+type objectUsage struct {
+	kind         string
+	object       string
+	clusterQueue string
+	usage        resources.FlavorResourceQuantities
+}
+
+// Report collects the outcome of the check phase. It's safe for concurrent
+// use.
+type Report struct {
+	mu       sync.Mutex
+	unmapped []Entry
+	usage    []objectUsage
+}
+
+func New() *Report {
+	return &Report{}
+}
+
+// AddUnmapped records an object no mapping rule matches. It's a no-op on a
+// nil Report.
+func (r *Report) AddUnmapped(kind, object string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unmapped = append(r.unmapped, Entry{Kind: kind, Object: object, Reason: ReasonUnmapped})
+}
+
+// AddUsage records the usage an object would have in the ClusterQueue once
+// imported. It's a no-op on a nil Report.
+func (r *Report) AddUsage(kind, object, clusterQueue string, usage resources.FlavorResourceQuantities) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.usage = append(r.usage, objectUsage{kind: kind, object: object, clusterQueue: clusterQueue, usage: usage})
+}
+
+// Entries returns the unmapped objects, followed by the objects overflowing
+// the nominal quota of their ClusterQueue. The objects are accounted in the
+// order of their kind and name, on top of the current reservations of the
+// ClusterQueues.
+func (r *Report) Entries(clusterQueues map[string]*kueue.ClusterQueue) []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := slices.Clone(r.unmapped)
+	slices.SortFunc(entries, compareEntries)
+
+	objects := slices.Clone(r.usage)
+	slices.SortFunc(objects, func(a, b objectUsage) int {
+		return cmp.Or(cmp.Compare(a.kind, b.kind), cmp.Compare(a.object, b.object))
+	})
+
+	formatter := resources.NewResourceFormatter()
+	usage := make(map[string]resources.FlavorResourceQuantities)
+	for _, o := range objects {
+		cq, found := clusterQueues[o.clusterQueue]
+		if !found {
+			continue
+		}
+		cqUsage, found := usage[o.clusterQueue]
+		if !found {
+			cqUsage = reservation(cq)
+			usage[o.clusterQueue] = cqUsage
+		}
+		quota := nominalQuota(cq)
+		frs := make([]resources.FlavorResource, 0, len(o.usage))
+		for fr, amount := range o.usage {
+			cqUsage[fr] = cqUsage[fr].Add(amount)
+			frs = append(frs, fr)
+		}
+		slices.SortFunc(frs, func(a, b resources.FlavorResource) int {
+			return cmp.Or(cmp.Compare(a.Flavor, b.Flavor), cmp.Compare(a.Resource, b.Resource))
+		})
+		for _, fr := range frs {
+			if cqUsage[fr].Cmp(quota[fr]) <= 0 {
+				continue
+			}
+			entries = append(entries, Entry{
+				Kind:         o.kind,
+				Object:       o.object,
+				Reason:       ReasonQuotaOverflow,
+				ClusterQueue: o.clusterQueue,
+				Flavor:       string(fr.Flavor),
+				Resource:     string(fr.Resource),
+				Usage:        formatter.ResourceQuantityString(fr.Resource, cqUsage[fr].Int64()),
+				NominalQuota: formatter.ResourceQuantityString(fr.Resource, quota[fr].Int64()),
+			})
+		}
+	}
+	return entries
+}
+
+func compareEntries(a, b Entry) int {
+	return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Object, b.Object))
+}
+
+func nominalQuota(cq *kueue.ClusterQueue) resources.FlavorResourceQuantities {
+	quota := make(resources.FlavorResourceQuantities)
+	for _, rg := range cq.Spec.ResourceGroups {
+		for _, f := range rg.Flavors {
+			for _, r := range f.Resources {
+				quota[resources.FlavorResource{Flavor: f.Name, Resource: r.Name}] = resources.AmountFromQuantity(r.Name, r.NominalQuota)
+			}
+		}
+	}
+	return quota
+}
+
+func reservation(cq *kueue.ClusterQueue) resources.FlavorResourceQuantities {
+	usage := make(resources.FlavorResourceQuantities)
+	for _, f := range cq.Status.FlavorsReservation {
+		for _, r := range f.Resources {
+			usage[resources.FlavorResource{Flavor: f.Name, Resource: r.Name}] = resources.NewAmount(resources.ResourceValue(r.Name, r.Total))
+		}
+	}
+	return usage
+}
+
+// Write writes the entries to w in the format.
+func Write(w io.Writer, format Format, entries []Entry) error {
+	switch format {
+	case FormatJSON:
+		if entries == nil {
+			entries = []Entry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"kind", "object", "reason", "clusterQueue", "flavor", "resource", "usage", "nominalQuota"}); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := cw.Write([]string{e.Kind, e.Object, string(e.Reason), e.ClusterQueue, e.Flavor, e.Resource, e.Usage, e.NominalQuota}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// WriteFile writes the entries to the file at path in the format.
+func WriteFile(path string, format Format, entries []Entry) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, format, entries); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
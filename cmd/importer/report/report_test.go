@@ -0,0 +1,94 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestReport(t *testing.T) {
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("rf").Resource(corev1.ResourceCPU, "4").Obj()).
+		Obj()
+	cq.Status.FlavorsReservation = []kueue.FlavorUsage{{
+		Name:      "rf",
+		Resources: []kueue.ResourceUsage{{Name: corev1.ResourceCPU, Total: resource.MustParse("2")}},
+	}}
+	clusterQueues := map[string]*kueue.ClusterQueue{"cq": cq}
+	fr := resources.FlavorResource{Flavor: "rf", Resource: corev1.ResourceCPU}
+
+	rep := New()
+	// Added out of order, accounted by name.
+	rep.AddUsage("Job", "ns/c", "cq", resources.FlavorResourceQuantities{fr: resources.NewAmount(1_000)})
+	rep.AddUsage("Job", "ns/a", "cq", resources.FlavorResourceQuantities{fr: resources.NewAmount(1_000)})
+	rep.AddUsage("Job", "ns/b", "cq", resources.FlavorResourceQuantities{fr: resources.NewAmount(500)})
+	rep.AddUnmapped("Pod", "ns/p")
+
+	entries := rep.Entries(clusterQueues)
+	wantEntries := []Entry{
+		{Kind: "Pod", Object: "ns/p", Reason: ReasonUnmapped},
+		{Kind: "Job", Object: "ns/c", Reason: ReasonQuotaOverflow, ClusterQueue: "cq", Flavor: "rf", Resource: "cpu", Usage: "4500m", NominalQuota: "4"},
+	}
+	if diff := cmp.Diff(wantEntries, entries); diff != "" {
+		t.Errorf("Unexpected entries (-want/+got):\n%s", diff)
+	}
+
+	cases := map[Format]string{
+		FormatCSV: `kind,object,reason,clusterQueue,flavor,resource,usage,nominalQuota
+Pod,ns/p,Unmapped,,,,,
+Job,ns/c,QuotaOverflow,cq,rf,cpu,4500m,4
+`,
+		FormatJSON: `[
+  {
+    "kind": "Pod",
+    "object": "ns/p",
+    "reason": "Unmapped"
+  },
+  {
+    "kind": "Job",
+    "object": "ns/c",
+    "reason": "QuotaOverflow",
+    "clusterQueue": "cq",
+    "flavor": "rf",
+    "resource": "cpu",
+    "usage": "4500m",
+    "nominalQuota": "4"
+  }
+]
+`,
+	}
+	for format, want := range cases {
+		t.Run(string(format), func(t *testing.T) {
+			var out bytes.Buffer
+			if err := Write(&out, format, entries); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(want, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
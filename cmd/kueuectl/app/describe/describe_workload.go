@@ -0,0 +1,192 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/clock"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	clientset "sigs.k8s.io/kueue/client-go/clientset/versioned"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	wlLong = templates.LongDesc(`
+		Show the details of a Workload in a single view: the scheduling timeline
+		built from its conditions, the admission checks, the flavors assigned to
+		each PodSet, the evictions, the requeue backoff, the position in the
+		LocalQueue and ClusterQueue, and the related events, followed by a
+		diagnosis of why the Workload is in its current state.
+	`)
+	wlExample = templates.Examples(`
+		# Describe the Workload
+		kueuectl describe workload my-workload
+	`)
+)
+
+type WorkloadOptions struct {
+	Clock clock.Clock
+
+	Name      string
+	Namespace string
+
+	ClientSet    clientset.Interface
+	K8sClientSet k8s.Interface
+
+	genericiooptions.IOStreams
+}
+
+func NewWorkloadOptions(streams genericiooptions.IOStreams, clock clock.Clock) *WorkloadOptions {
+	return &WorkloadOptions{
+		IOStreams: streams,
+		Clock:     clock,
+	}
+}
+
+func NewWorkloadCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams, clock clock.Clock) *cobra.Command {
+	o := NewWorkloadOptions(streams, clock)
+
+	cmd := &cobra.Command{
+		Use:                   "workload NAME",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"kwl", "kueueworkload", "kueueworkloads"},
+		Short:                 "Show details of a Workload",
+		Long:                  wlLong,
+		Example:               wlExample,
+		Args:                  cobra.ExactArgs(1),
+		ValidArgsFunction:     completion.WorkloadNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+
+			return o.Run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *WorkloadOptions) Complete(clientGetter clientgetter.ClientGetter, args []string) error {
+	o.Name = args[0]
+
+	var err error
+
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	o.ClientSet, err = clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.K8sClientSet, err = clientGetter.K8sClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run describes the Workload
+func (o *WorkloadOptions) Run(ctx context.Context) error {
+	wl, err := o.ClientSet.KueueV1beta2().Workloads(o.Namespace).Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	details := &workloadDetails{workload: wl}
+
+	if workload.Status(wl) == workload.StatusPending && len(wl.Spec.QueueName) > 0 {
+		details.localQueue, err = o.ClientSet.KueueV1beta2().LocalQueues(wl.Namespace).Get(ctx, string(wl.Spec.QueueName), metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		details.pendingWorkload, details.positionErr = o.pendingWorkload(ctx, wl)
+	}
+
+	details.events, err = o.events(ctx, wl)
+	if err != nil {
+		return err
+	}
+
+	printer := &workloadPrinter{clock: o.Clock}
+	return printer.print(o.Out, details)
+}
+
+// pendingWorkload returns the position of the Workload in its queues from
+// the visibility API. The visibility API might not be available, so its
+// errors don't fail the command.
+func (o *WorkloadOptions) pendingWorkload(ctx context.Context, wl *kueue.Workload) (*visibility.PendingWorkload, error) {
+	summary, err := o.ClientSet.VisibilityV1beta2().LocalQueues(wl.Namespace).
+		GetPendingWorkloadsSummary(ctx, string(wl.Spec.QueueName), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pendingWorkload := range summary.Items {
+		if pendingWorkload.Name == wl.Name && pendingWorkload.Namespace == wl.Namespace {
+			return &pendingWorkload, nil
+		}
+	}
+	return nil, errNotInQueue
+}
+
+var errNotInQueue = errors.New("not found in the queue")
+
+func (o *WorkloadOptions) events(ctx context.Context, wl *kueue.Workload) ([]corev1.Event, error) {
+	list, err := o.K8sClientSet.CoreV1().Events(wl.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": "Workload",
+			"involvedObject.name": wl.Name,
+		}.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]corev1.Event, 0, len(list.Items))
+	for _, event := range list.Items {
+		if event.InvolvedObject.Kind == "Workload" && event.InvolvedObject.Name == wl.Name &&
+			(len(event.InvolvedObject.UID) == 0 || event.InvolvedObject.UID == wl.UID) {
+			events = append(events, event)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b corev1.Event) int {
+		return eventTime(&a).Compare(eventTime(&b))
+	})
+	return events, nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const none = "<none>"
+
+type workloadDetails struct {
+	workload        *kueue.Workload
+	localQueue      *kueue.LocalQueue
+	pendingWorkload *visibility.PendingWorkload
+	positionErr     error
+	events          []corev1.Event
+}
+
+type workloadPrinter struct {
+	clock clock.Clock
+}
+
+func (p *workloadPrinter) print(out io.Writer, details *workloadDetails) error {
+	w := printers.GetNewTabWriter(out)
+	wl := details.workload
+
+	writef(w, 0, "Name:\t%s\n", wl.Name)
+	writef(w, 0, "Namespace:\t%s\n", wl.Namespace)
+	writef(w, 0, "LocalQueue:\t%s\n", valueOrNone(string(wl.Spec.QueueName)))
+	writef(w, 0, "ClusterQueue:\t%s\n", valueOrNone(string(clusterQueueName(details))))
+	writef(w, 0, "Priority:\t%d\n", priority.Priority(wl))
+	if ref := wl.Spec.PriorityClassRef; ref != nil {
+		writef(w, 0, "Priority Class:\t%s (%s/%s)\n", ref.Name, ref.Group, ref.Kind)
+	}
+	writef(w, 0, "Active:\t%t\n", workload.IsActive(wl))
+	writef(w, 0, "Status:\t%s\n", strings.ToUpper(workload.Status(wl)))
+	writef(w, 0, "Created:\t%s (%s ago)\n", wl.CreationTimestamp.UTC().Format(time.RFC3339),
+		duration.HumanDuration(p.clock.Since(wl.CreationTimestamp.Time)))
+	writef(w, 0, "Diagnosis:\t%s\n", p.diagnose(details))
+
+	p.printTimeline(w, wl)
+	p.printAdmissionChecks(w, wl)
+	p.printFlavorAssignment(w, wl)
+	p.printEvictions(w, wl)
+	p.printRequeueState(w, wl)
+	p.printQueuePosition(w, details)
+	p.printEvents(w, details.events)
+
+	return w.Flush()
+}
+
+func (p *workloadPrinter) printTimeline(w io.Writer, wl *kueue.Workload) {
+	if len(wl.Status.Conditions) == 0 {
+		writef(w, 0, "Timeline:\t%s\n", none)
+		return
+	}
+	conditions := slices.Clone(wl.Status.Conditions)
+	slices.SortStableFunc(conditions, func(a, b metav1.Condition) int {
+		return cmp.Or(a.LastTransitionTime.Compare(b.LastTransitionTime.Time), cmp.Compare(a.Type, b.Type))
+	})
+	writef(w, 0, "Timeline:\n")
+	writef(w, 1, "Time\tCondition\tStatus\tReason\tMessage\n")
+	writef(w, 1, "----\t---------\t------\t------\t-------\n")
+	for _, c := range conditions {
+		writef(w, 1, "%s\t%s\t%s\t%s\t%s\n", c.LastTransitionTime.UTC().Format(time.RFC3339), c.Type, c.Status, c.Reason, c.Message)
+	}
+}
+
+func (p *workloadPrinter) printAdmissionChecks(w io.Writer, wl *kueue.Workload) {
+	if len(wl.Status.AdmissionChecks) == 0 {
+		writef(w, 0, "Admission Checks:\t%s\n", none)
+		return
+	}
+	writef(w, 0, "Admission Checks:\n")
+	writef(w, 1, "Name\tState\tLast Transition\tMessage\n")
+	writef(w, 1, "----\t-----\t---------------\t-------\n")
+	for _, ac := range wl.Status.AdmissionChecks {
+		writef(w, 1, "%s\t%s\t%s\t%s\n", ac.Name, ac.State, ac.LastTransitionTime.UTC().Format(time.RFC3339), ac.Message)
+	}
+}
+
+func (p *workloadPrinter) printFlavorAssignment(w io.Writer, wl *kueue.Workload) {
+	if wl.Status.Admission == nil || len(wl.Status.Admission.PodSetAssignments) == 0 {
+		writef(w, 0, "Flavor Assignment:\t%s\n", none)
+		return
+	}
+	writef(w, 0, "Flavor Assignment:\n")
+	writef(w, 1, "PodSet\tCount\tFlavors\tResource Usage\n")
+	writef(w, 1, "------\t-----\t-------\t--------------\n")
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		flavors := make([]string, 0, len(psa.Flavors))
+		for res, flv := range psa.Flavors {
+			flavors = append(flavors, fmt.Sprintf("%s=%s", res, flv))
+		}
+		slices.Sort(flavors)
+		usage := make([]string, 0, len(psa.ResourceUsage))
+		for res, q := range psa.ResourceUsage {
+			usage = append(usage, fmt.Sprintf("%s=%s", res, q.String()))
+		}
+		slices.Sort(usage)
+		count := "-"
+		if psa.Count != nil {
+			count = fmt.Sprint(*psa.Count)
+		}
+		writef(w, 1, "%s\t%s\t%s\t%s\n", psa.Name, count, strings.Join(flavors, ","), strings.Join(usage, ","))
+	}
+}
+
+func (p *workloadPrinter) printEvictions(w io.Writer, wl *kueue.Workload) {
+	if wl.Status.SchedulingStats == nil || len(wl.Status.SchedulingStats.Evictions) == 0 {
+		writef(w, 0, "Evictions:\t%s\n", none)
+		return
+	}
+	writef(w, 0, "Evictions:\n")
+	writef(w, 1, "Reason\tUnderlying Cause\tCount\n")
+	writef(w, 1, "------\t----------------\t-----\n")
+	for _, eviction := range wl.Status.SchedulingStats.Evictions {
+		writef(w, 1, "%s\t%s\t%d\n", eviction.Reason, valueOrNone(string(eviction.UnderlyingCause)), eviction.Count)
+	}
+}
+
+func (p *workloadPrinter) printRequeueState(w io.Writer, wl *kueue.Workload) {
+	rs := wl.Status.RequeueState
+	if rs == nil {
+		writef(w, 0, "Requeue State:\t%s\n", none)
+		return
+	}
+	writef(w, 0, "Requeue State:\n")
+	writef(w, 1, "Count:\t%d\n", ptr.Deref(rs.Count, 0))
+	if rs.RequeueAt != nil {
+		writef(w, 1, "Requeue At:\t%s (%s)\n", rs.RequeueAt.UTC().Format(time.RFC3339), p.relativeTime(rs.RequeueAt.Time))
+	}
+}
+
+func (p *workloadPrinter) printQueuePosition(w io.Writer, details *workloadDetails) {
+	switch {
+	case details.pendingWorkload != nil:
+		writef(w, 0, "Queue Position:\n")
+		writef(w, 1, "LocalQueue:\t%d\n", details.pendingWorkload.PositionInLocalQueue)
+		writef(w, 1, "ClusterQueue:\t%d\n", details.pendingWorkload.PositionInClusterQueue)
+	case details.positionErr != nil:
+		writef(w, 0, "Queue Position:\t<unknown: %v>\n", details.positionErr)
+	default:
+		writef(w, 0, "Queue Position:\t%s\n", none)
+	}
+}
+
+func (p *workloadPrinter) printEvents(w io.Writer, events []corev1.Event) {
+	if len(events) == 0 {
+		writef(w, 0, "Events:\t%s\n", none)
+		return
+	}
+	writef(w, 0, "Events:\n")
+	writef(w, 1, "Type\tReason\tAge\tFrom\tMessage\n")
+	writef(w, 1, "----\t------\t---\t----\t-------\n")
+	for _, e := range events {
+		from := e.Source.Component
+		if len(from) == 0 {
+			from = e.ReportingController
+		}
+		writef(w, 1, "%s\t%s\t%s\t%s\t%s\n", e.Type, e.Reason,
+			duration.HumanDuration(p.clock.Since(eventTime(&e))), valueOrNone(from), strings.TrimSpace(e.Message))
+	}
+}
+
+// diagnose explains in a sentence why the Workload is in its current state.
+func (p *workloadPrinter) diagnose(details *workloadDetails) string {
+	wl := details.workload
+	switch {
+	case workload.Status(wl) == workload.StatusFinished:
+		return conditionDiagnosis("Finished", apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadFinished))
+	case !workload.IsActive(wl):
+		return conditionDiagnosis("Deactivated", apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted))
+	case workload.IsAdmitted(wl):
+		return fmt.Sprintf("Admitted by ClusterQueue %s", wl.Status.Admission.ClusterQueue)
+	case workload.HasQuotaReservation(wl):
+		var pending []string
+		for _, ac := range wl.Status.AdmissionChecks {
+			if ac.State != kueue.CheckStateReady {
+				pending = append(pending, fmt.Sprintf("%s (%s)", ac.Name, ac.State))
+			}
+		}
+		if len(pending) == 0 {
+			return fmt.Sprintf("Quota reserved in ClusterQueue %s, waiting for admission", wl.Status.Admission.ClusterQueue)
+		}
+		return fmt.Sprintf("Quota reserved in ClusterQueue %s, waiting for admission checks: %s",
+			wl.Status.Admission.ClusterQueue, strings.Join(pending, ", "))
+	case wl.Status.RequeueState != nil && wl.Status.RequeueState.RequeueAt != nil && p.clock.Now().Before(wl.Status.RequeueState.RequeueAt.Time):
+		return fmt.Sprintf("Backing off after %d requeue(s), it will be requeued %s",
+			ptr.Deref(wl.Status.RequeueState.Count, 0), p.relativeTime(wl.Status.RequeueState.RequeueAt.Time))
+	case len(wl.Spec.QueueName) == 0:
+		return "Pending: the Workload doesn't specify a LocalQueue"
+	case details.localQueue == nil:
+		return fmt.Sprintf("Pending: the LocalQueue %s doesn't exist", wl.Spec.QueueName)
+	case !apimeta.IsStatusConditionTrue(details.localQueue.Status.Conditions, kueue.LocalQueueActive):
+		return conditionDiagnosis(fmt.Sprintf("Pending: the LocalQueue %s is inactive", wl.Spec.QueueName),
+			apimeta.FindStatusCondition(details.localQueue.Status.Conditions, kueue.LocalQueueActive))
+	}
+	if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved); c != nil && len(c.Message) > 0 {
+		return fmt.Sprintf("Pending: %s", c.Message)
+	}
+	return "Pending: waiting to be considered by the scheduler"
+}
+
+func conditionDiagnosis(summary string, c *metav1.Condition) string {
+	if c == nil || c.Status != metav1.ConditionTrue || len(c.Message) == 0 {
+		return summary
+	}
+	return fmt.Sprintf("%s: %s", summary, c.Message)
+}
+
+func (p *workloadPrinter) relativeTime(t time.Time) string {
+	if d := t.Sub(p.clock.Now()); d > 0 {
+		return fmt.Sprintf("in %s", duration.HumanDuration(d))
+	}
+	return fmt.Sprintf("%s ago", duration.HumanDuration(p.clock.Since(t)))
+}
+
+func clusterQueueName(details *workloadDetails) kueue.ClusterQueueReference {
+	if admission := details.workload.Status.Admission; admission != nil {
+		return admission.ClusterQueue
+	}
+	if details.localQueue != nil {
+		return details.localQueue.Spec.ClusterQueue
+	}
+	return ""
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func valueOrNone(s string) string {
+	if len(s) == 0 {
+		return none
+	}
+	return s
+}
+
+func writef(w io.Writer, level int, format string, args ...any) {
+	fmt.Fprintf(w, strings.Repeat("  ", level)+format, args...)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestWorkloadCmd(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		args             []string
+		objs             []runtime.Object
+		events           []runtime.Object
+		pendingWorkloads []visibility.PendingWorkload
+		wantOut          string
+		wantOutErr       string
+		wantErr          string
+	}{
+		"pending workload": {
+			args: []string{"wl"},
+			objs: []runtime.Object{
+				utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+					UID("wl-uid").
+					Queue("lq").
+					Priority(100).
+					Creation(now.Add(-time.Hour)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             "Pending",
+						Message:            "couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default",
+						LastTransitionTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionFalse,
+						Reason:             "Preempted",
+						Message:            "Preempted to accommodate a higher priority Workload",
+						LastTransitionTime: metav1.NewTime(now.Add(-40 * time.Minute)),
+					}).
+					SchedulingStatsEviction(kueue.WorkloadSchedulingStatsEviction{
+						Reason:          kueue.WorkloadEvictedByPreemption,
+						UnderlyingCause: kueue.EvictionUnderlyingCause(kueue.InClusterQueueReason),
+						Count:           1,
+					}).
+					RequeueState(new(int32(1)), nil).
+					Obj(),
+				utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).
+					ClusterQueue("cq").
+					Active(metav1.ConditionTrue).
+					Obj(),
+			},
+			events: []runtime.Object{
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{Name: "wl.2", Namespace: metav1.NamespaceDefault},
+					InvolvedObject: corev1.ObjectReference{
+						Kind: "Workload",
+						Name: "wl",
+						UID:  "wl-uid",
+					},
+					Type:          corev1.EventTypeNormal,
+					Reason:        "Pending",
+					Message:       "couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default",
+					Source:        corev1.EventSource{Component: "kueue-admission"},
+					LastTimestamp: metav1.NewTime(now.Add(-30 * time.Minute)),
+				},
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{Name: "wl.1", Namespace: metav1.NamespaceDefault},
+					InvolvedObject: corev1.ObjectReference{
+						Kind: "Workload",
+						Name: "wl",
+						UID:  "wl-uid",
+					},
+					Type:          corev1.EventTypeNormal,
+					Reason:        "Preempted",
+					Message:       "Preempted to accommodate a higher priority Workload",
+					Source:        corev1.EventSource{Component: "kueue-admission"},
+					LastTimestamp: metav1.NewTime(now.Add(-40 * time.Minute)),
+				},
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{Name: "other.1", Namespace: metav1.NamespaceDefault},
+					InvolvedObject: corev1.ObjectReference{
+						Kind: "Workload",
+						Name: "other",
+					},
+					Type:          corev1.EventTypeNormal,
+					Reason:        "Pending",
+					LastTimestamp: metav1.NewTime(now.Add(-40 * time.Minute)),
+				},
+			},
+			pendingWorkloads: []visibility.PendingWorkload{
+				{
+					ObjectMeta:             metav1.ObjectMeta{Name: "other", Namespace: metav1.NamespaceDefault},
+					PositionInLocalQueue:   0,
+					PositionInClusterQueue: 0,
+				},
+				{
+					ObjectMeta:             metav1.ObjectMeta{Name: "wl", Namespace: metav1.NamespaceDefault},
+					PositionInLocalQueue:   1,
+					PositionInClusterQueue: 3,
+				},
+			},
+			wantOut: `Name:           wl
+Namespace:      default
+LocalQueue:     lq
+ClusterQueue:   cq
+Priority:       100
+Active:         true
+Status:         PENDING
+Created:        2026-03-10T11:00:00Z (60m ago)
+Diagnosis:      Pending: couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default
+Timeline:
+  Time                   Condition       Status   Reason      Message
+  ----                   ---------       ------   ------      -------
+  2026-03-10T11:20:00Z   Evicted         False    Preempted   Preempted to accommodate a higher priority Workload
+  2026-03-10T11:30:00Z   QuotaReserved   False    Pending     couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default
+Admission Checks:        <none>
+Flavor Assignment:       <none>
+Evictions:
+  Reason                 Underlying Cause   Count
+  ------                 ----------------   -----
+  Preempted              InClusterQueue     1
+Requeue State:
+  Count:                 1
+Queue Position:
+  LocalQueue:            1
+  ClusterQueue:          3
+Events:
+  Type                   Reason             Age      From              Message
+  ----                   ------             ---      ----              -------
+  Normal                 Preempted          40m      kueue-admission   Preempted to accommodate a higher priority Workload
+  Normal                 Pending            30m      kueue-admission   couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default
+`,
+		},
+		"workload waiting for admission checks": {
+			args: []string{"wl"},
+			objs: []runtime.Object{
+				utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+					Queue("lq").
+					Creation(now.Add(-time.Hour)).
+					ReserveQuotaAt(
+						utiltestingapi.MakeAdmission("cq").
+							PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "default", "2").
+								Count(2).
+								Obj()).
+							Obj(),
+						now.Add(-10*time.Minute),
+					).
+					AdmissionCheck(kueue.AdmissionCheckState{
+						Name:               "prov",
+						State:              kueue.CheckStatePending,
+						LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+						Message:            "Waiting for capacity",
+					}).
+					Obj(),
+			},
+			wantOut: `Name:           wl
+Namespace:      default
+LocalQueue:     lq
+ClusterQueue:   cq
+Priority:       0
+Active:         true
+Status:         QUOTARESERVED
+Created:        2026-03-10T11:00:00Z (60m ago)
+Diagnosis:      Quota reserved in ClusterQueue cq, waiting for admission checks: prov (Pending)
+Timeline:
+  Time                   Condition       Status   Reason           Message
+  ----                   ---------       ------   ------           -------
+  2026-03-10T11:50:00Z   QuotaReserved   True     AdmittedByTest   Admitted by ClusterQueue cq
+Admission Checks:
+  Name                   State           Last Transition        Message
+  ----                   -----           ---------------        -------
+  prov                   Pending         2026-03-10T11:50:00Z   Waiting for capacity
+Flavor Assignment:
+  PodSet                 Count           Flavors                Resource Usage
+  ------                 -----           -------                --------------
+  main                   2               cpu=default            cpu=2
+Evictions:               <none>
+Requeue State:           <none>
+Queue Position:          <none>
+Events:                  <none>
+`,
+		},
+		"workload not found": {
+			args:    []string{"wl"},
+			wantErr: `workloads.kueue.x-k8s.io "wl" not found`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset(tc.objs...)
+			// `SimpleClientset` doesn't allow adding `PendingWorkloadsSummary` objects,
+			// so the visibility API is served by a reactor.
+			clientset.PrependReactor("get", "localqueues", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "pendingworkloads" {
+					return false, nil, nil
+				}
+				return true, &visibility.PendingWorkloadsSummary{Items: tc.pendingWorkloads}, nil
+			})
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithKueueClientset(clientset).
+				WithK8sClientset(k8sfake.NewClientset(tc.events...))
+
+			cmd := NewWorkloadCmd(tcg, streams, testingclock.NewFakeClock(now))
+			cmd.SetArgs(tc.args)
+
+			var gotErr string
+			if err := cmd.Execute(); err != nil {
+				gotErr = err.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected error output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
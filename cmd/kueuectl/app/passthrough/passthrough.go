@@ -24,9 +24,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/clock"
 
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/delete"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/describe"
 )
 
 type passThroughCommand struct {
@@ -56,10 +58,10 @@ var (
 	}
 )
 
-func NewCommands(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) []*cobra.Command {
+func NewCommands(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams, clock clock.Clock) []*cobra.Command {
 	commands := make([]*cobra.Command, len(passThroughCommands))
 	for i, ptCmd := range passThroughCommands {
-		commands[i] = newCommand(clientGetter, streams, ptCmd, passThroughTypes)
+		commands[i] = newCommand(clientGetter, streams, clock, ptCmd, passThroughTypes)
 	}
 	return commands
 }
@@ -67,6 +69,7 @@ func NewCommands(clientGetter clientgetter.ClientGetter, streams genericiooption
 func newCommand(
 	clientGetter clientgetter.ClientGetter,
 	streams genericiooptions.IOStreams,
+	clock clock.Clock,
 	command passThroughCommand,
 	ptTypes []passThroughType,
 ) *cobra.Command {
@@ -75,9 +78,12 @@ func newCommand(
 		Short: command.short,
 	}
 	for _, ptType := range ptTypes {
-		if command.name == "delete" && ptType.name == "workload" {
+		switch {
+		case command.name == "delete" && ptType.name == "workload":
 			cmd.AddCommand(delete.NewWorkloadCmd(clientGetter, streams))
-		} else {
+		case command.name == "describe" && ptType.name == "workload":
+			cmd.AddCommand(describe.NewWorkloadCmd(clientGetter, streams, clock))
+		default:
 			cmd.AddCommand(newSubcommand(command, ptType))
 		}
 	}
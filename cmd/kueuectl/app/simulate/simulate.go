@@ -0,0 +1,174 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/yaml"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/scheduler/simulation"
+)
+
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+var (
+	simulateLong = templates.LongDesc(`
+		Replay a trace of Workloads against a queue configuration, without a cluster.
+
+		The files hold ResourceFlavors, Cohorts, ClusterQueues, LocalQueues,
+		Namespaces and Workloads, as YAML or JSON documents or lists, such as
+		the output of "kubectl get -o yaml". The Kueue scheduler runs offline
+		with a simulated clock: each Workload arrives at its creationTimestamp
+		and, once admitted, runs for its maximumExecutionTimeSeconds, or until
+		the end of the simulation if unset. A preempted Workload runs again from
+		the beginning once readmitted. AdmissionChecks are not simulated.
+
+		The output lists the admission and wait times of each Workload, the
+		preemptions, and the utilization of each ClusterQueue.
+	`)
+	simulateExample = templates.Examples(`
+		# Simulate a trace against a new queue configuration
+		kueuectl simulate -f queues.yaml -f trace.yaml
+
+		# Simulate a trace of the Workloads in the cluster, with Fair Sharing
+		kubectl get workloads -A -o yaml > trace.yaml
+		kueuectl simulate -f queues.yaml -f trace.yaml --fair-sharing
+	`)
+
+	errNoFiles             = errors.New("must specify at least one file with -f")
+	errInvalidOutputFormat = errors.New("invalid output format, must be one of json|yaml")
+)
+
+type SimulateOptions struct {
+	Filenames    []string
+	FairSharing  bool
+	OutputFormat string
+
+	genericiooptions.IOStreams
+}
+
+func NewSimulateOptions(streams genericiooptions.IOStreams) *SimulateOptions {
+	return &SimulateOptions{
+		IOStreams: streams,
+	}
+}
+
+func NewSimulateCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewSimulateOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "simulate -f FILENAME [-f FILENAME...] [--fair-sharing] [-o json|yaml]",
+		DisableFlagsInUseLine: true,
+		Short:                 "Replay a trace of Workloads against a queue configuration",
+		Long:                  simulateLong,
+		Example:               simulateExample,
+		Args:                  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			if err := o.Validate(); err != nil {
+				return err
+			}
+
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&o.Filenames, "filename", "f", nil,
+		"Files with the queue configuration and the Workloads to replay.")
+	cmd.Flags().BoolVar(&o.FairSharing, "fair-sharing", false,
+		"Enable Fair Sharing in the simulated scheduler.")
+	cmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "",
+		"Output format. One of: json|yaml.")
+
+	return cmd
+}
+
+// Validate validates the options
+func (o *SimulateOptions) Validate() error {
+	if len(o.Filenames) == 0 {
+		return errNoFiles
+	}
+	if o.OutputFormat != "" && o.OutputFormat != outputJSON && o.OutputFormat != outputYAML {
+		return errInvalidOutputFormat
+	}
+	return nil
+}
+
+// Run runs the simulation and prints the result
+func (o *SimulateOptions) Run(ctx context.Context) error {
+	in := &simulation.Input{}
+	for _, name := range o.Filenames {
+		if err := loadFile(in, name); err != nil {
+			return err
+		}
+	}
+
+	var opts simulation.Options
+	if o.FairSharing {
+		opts.FairSharing = &config.FairSharing{}
+	}
+	// The scheduler logs are meant for a controller, not for the terminal.
+	result, err := simulation.Run(ctrl.LoggerInto(ctx, logr.Discard()), in, opts)
+	if err != nil {
+		return err
+	}
+
+	switch o.OutputFormat {
+	case outputJSON:
+		out, err := json.MarshalIndent(result, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(o.Out, string(out))
+		return err
+	case outputYAML:
+		out, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		_, err = o.Out.Write(out)
+		return err
+	}
+	return printResult(o.Out, result)
+}
+
+func loadFile(in *simulation.Input, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := in.Load(f); err != nil {
+		return fmt.Errorf("loading %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"sigs.k8s.io/kueue/pkg/scheduler/simulation"
+)
+
+func printResult(out io.Writer, result *simulation.Result) error {
+	fmt.Fprintf(out, "Simulated %s from %s in %d scheduling cycles.\n",
+		result.Duration.Duration, result.Start.UTC().Format(time.RFC3339), result.Cycles)
+
+	// Each table gets its own writer, so that columns are aligned per table.
+	fmt.Fprintln(out)
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tLOCALQUEUE\tCLUSTERQUEUE\tPRIORITY\tSTATE\tARRIVAL\tADMITTED\tWAIT\tFINISHED\tADMISSIONS\tPREEMPTIONS")
+	for _, wl := range result.Workloads {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\t%d\t%d\n",
+			wl.Namespace, wl.Name, wl.LocalQueue, valueOrNone(string(wl.ClusterQueue)), wl.Priority, wl.State,
+			wl.Arrival.Duration, durationOrNone(wl.FirstAdmission), durationOrNone(wl.WaitTime), durationOrNone(wl.Finished),
+			wl.Admissions, wl.Preemptions)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.Preemptions) > 0 {
+		fmt.Fprintln(out)
+		w = printers.GetNewTabWriter(out)
+		fmt.Fprintln(w, "TIME\tNAMESPACE\tNAME\tCLUSTERQUEUE\tREASON")
+		for _, p := range result.Preemptions {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.Time.Duration, p.Namespace, p.Name, p.ClusterQueue, p.Reason)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(out)
+	w = printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, "CLUSTERQUEUE\tFLAVOR\tRESOURCE\tNOMINAL QUOTA\tAVERAGE USAGE\tPEAK USAGE\tUTILIZATION")
+	for _, cq := range result.ClusterQueues {
+		for _, r := range cq.Resources {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s%%\n",
+				cq.Name, r.Flavor, r.Resource, r.NominalQuota.String(), r.AverageUsage.String(), r.PeakUsage.String(),
+				strconv.FormatFloat(r.Utilization, 'f', 1, 64))
+		}
+	}
+	return w.Flush()
+}
+
+func durationOrNone(d *metav1.Duration) string {
+	if d == nil {
+		return "<none>"
+	}
+	return d.Duration.String()
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
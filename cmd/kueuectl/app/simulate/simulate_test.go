@@ -0,0 +1,170 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+const queuesYAML = `apiVersion: kueue.x-k8s.io/v1beta2
+kind: ResourceFlavor
+metadata:
+  name: default
+---
+apiVersion: kueue.x-k8s.io/v1beta2
+kind: ClusterQueue
+metadata:
+  name: cq
+spec:
+  namespaceSelector: {}
+  preemption:
+    withinClusterQueue: LowerPriority
+  resourceGroups:
+  - coveredResources: ["cpu"]
+    flavors:
+    - name: default
+      resources:
+      - name: cpu
+        nominalQuota: 4
+---
+apiVersion: kueue.x-k8s.io/v1beta2
+kind: LocalQueue
+metadata:
+  name: lq
+  namespace: default
+spec:
+  clusterQueue: cq
+`
+
+const traceYAML = `apiVersion: kueue.x-k8s.io/v1beta2
+kind: WorkloadList
+items:
+- apiVersion: kueue.x-k8s.io/v1beta2
+  kind: Workload
+  metadata:
+    name: a
+    namespace: default
+    creationTimestamp: "2026-01-01T00:00:00Z"
+  spec:
+    queueName: lq
+    maximumExecutionTimeSeconds: 600
+    podSets:
+    - name: main
+      count: 1
+      template:
+        spec:
+          containers:
+          - name: c
+            resources:
+              requests:
+                cpu: "3"
+- apiVersion: kueue.x-k8s.io/v1beta2
+  kind: Workload
+  metadata:
+    name: b
+    namespace: default
+    creationTimestamp: "2026-01-01T00:02:00Z"
+  spec:
+    queueName: lq
+    priority: 100
+    maximumExecutionTimeSeconds: 300
+    podSets:
+    - name: main
+      count: 1
+      template:
+        spec:
+          containers:
+          - name: c
+            resources:
+              requests:
+                cpu: "3"
+`
+
+func TestSimulateCmd(t *testing.T) {
+	dir := t.TempDir()
+	queues := filepath.Join(dir, "queues.yaml")
+	trace := filepath.Join(dir, "trace.yaml")
+	if err := os.WriteFile(queues, []byte(queuesYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(trace, []byte(traceYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		args    []string
+		wantOut string
+		wantErr string
+	}{
+		"simulate a trace": {
+			args: []string{"-f", queues, "-f", trace},
+			wantOut: `Simulated 17m0s from 2026-01-01T00:00:00Z in 5 scheduling cycles.
+
+NAMESPACE   NAME   LOCALQUEUE   CLUSTERQUEUE   PRIORITY   STATE      ARRIVAL   ADMITTED   WAIT   FINISHED   ADMISSIONS   PREEMPTIONS
+default     a      lq           cq             0          Finished   0s        0s         0s     17m0s      2            1
+default     b      lq           cq             100        Finished   2m0s      2m0s       0s     7m0s       1            0
+
+TIME   NAMESPACE   NAME   CLUSTERQUEUE   REASON
+2m0s   default     a      cq             InClusterQueue
+
+CLUSTERQUEUE   FLAVOR    RESOURCE   NOMINAL QUOTA   AVERAGE USAGE   PEAK USAGE   UTILIZATION
+cq             default   cpu        4               3               3            75.0%
+`,
+		},
+		"no files": {
+			wantErr: errNoFiles.Error(),
+		},
+		"invalid output format": {
+			args:    []string{"-f", queues, "-o", "wide"},
+			wantErr: errInvalidOutputFormat.Error(),
+		},
+		"missing file": {
+			args:    []string{"-f", filepath.Join(dir, "missing.yaml")},
+			wantErr: "open " + filepath.Join(dir, "missing.yaml") + ": no such file or directory",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, _ := genericiooptions.NewTestIOStreams()
+
+			cmd := NewSimulateCmd(streams)
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs(tc.args)
+
+			var gotErr string
+			if err := cmd.Execute(); err != nil {
+				gotErr = err.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Fatalf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if tc.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
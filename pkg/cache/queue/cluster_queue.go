@@ -63,6 +63,7 @@ const (
 	RequeueReasonPreemptionFailed       RequeueReason = "PreemptionFailed"
 	RequeueReasonNoFit                  RequeueReason = "NoFit"
 	RequeueReasonPreemptionNoCandidates RequeueReason = "PreemptionNoCandidates"
+	RequeueReasonBudgetExhausted        RequeueReason = "BudgetExhausted"
 )
 
 // QuotaReservedReason represents the reason for the WorkloadQuotaReserved condition
@@ -184,6 +185,16 @@ type ClusterQueue struct {
 
 	sw *stickyWorkload
 
+	// orderByDeadline is set when the ClusterQueue uses the EarliestDeadlineFirst
+	// queueing strategy. Written under rwm, so that heap operations never
+	// observe it changing mid-sort, and read once per sort by Snapshot.
+	orderByDeadline *atomic.Bool
+
+	// deprioritizedLQs holds the LocalQueues whose budget is exhausted with the
+	// Deprioritize action; their workloads are ordered after the others.
+	// Replaced, never mutated, under rwm, and read once per sort by Snapshot.
+	deprioritizedLQs *atomic.Pointer[sets.Set[utilqueue.LocalQueueReference]]
+
 	ConcurrentAdmissionPolicy *kueue.ConcurrentAdmissionPolicy
 }
 
@@ -254,6 +265,8 @@ func newClusterQueueImpl(ctx context.Context, client client.Client, cl *metrics.
 		opt(options)
 	}
 	sw := stickyWorkload{}
+	orderByDeadline := &atomic.Bool{}
+	deprioritizedLQs := &atomic.Pointer[sets.Set[utilqueue.LocalQueueReference]]{}
 	// lqWeights is shared by reference with the ClusterQueue struct below so
 	// weight updates are visible to the comparator. All access holds rwm.
 	lqWeights := make(map[utilqueue.LocalQueueReference]float64)
@@ -265,13 +278,13 @@ func newClusterQueueImpl(ctx context.Context, client client.Client, cl *metrics.
 	}
 	// The comparator reads the sticky workload and cached weights live; safe
 	// because those writes and heap operations all hold rwm.
-	compareFunc := queueOrderingFunc(ctx, getLQWeight, wo, options.fsResWeights, options.enableAdmissionFs, options.afsUsageLedger, sw.matches)
+	compareFunc := queueOrderingFunc(ctx, getLQWeight, wo, options.fsResWeights, options.enableAdmissionFs, options.afsUsageLedger, sw.matches, orderByDeadline.Load, liveMatcher(deprioritizedLQs))
 	// Derive lessFunc from compareFunc for the heap.
 	lessFunc := func(a, b *workload.Info) bool { return compareFunc(a, b) < 0 }
 	// Snapshot sorts without the lock, so it captures the sticky workload once
 	// per sort rather than reading it live. See Kueue#12740.
 	snapshotSort := buildSnapshotSort(
-		ctx, wo, &sw, client,
+		ctx, wo, &sw, orderByDeadline, deprioritizedLQs, client,
 		options.enableAdmissionFs, options.fsResWeights,
 		options.afsUsageLedger,
 	)
@@ -295,6 +308,8 @@ func newClusterQueueImpl(ctx context.Context, client client.Client, cl *metrics.
 		afsUsageLedger:         options.afsUsageLedger,
 		lqWeights:              lqWeights,
 		sw:                     &sw,
+		orderByDeadline:        orderByDeadline,
+		deprioritizedLQs:       deprioritizedLQs,
 	}
 }
 
@@ -304,6 +319,15 @@ func (c *ClusterQueue) Update(apiCQ *kueue.ClusterQueue) error {
 	defer c.rwm.Unlock()
 	c.name = kueue.ClusterQueueReference(apiCQ.Name)
 	c.queueingStrategy = apiCQ.Spec.QueueingStrategy
+	orderByDeadline := false
+	if c.queueingStrategy == kueue.EarliestDeadlineFirst {
+		// Apart from the ordering, the workloads are handled as in BestEffortFIFO.
+		c.queueingStrategy = kueue.BestEffortFIFO
+		orderByDeadline = features.Enabled(features.DeadlineAwareQueueing)
+	}
+	if c.orderByDeadline.Swap(orderByDeadline) != orderByDeadline {
+		c.workloads.RebuildActiveHeap()
+	}
 	nsSelector, err := metav1.LabelSelectorAsSelector(apiCQ.Spec.NamespaceSelector)
 	if err != nil {
 		return err
@@ -375,6 +399,7 @@ func (c *ClusterQueue) PushOrUpdate(wInfo *workload.Info) {
 		if !specChangedSinceEval &&
 			equality.Semantic.DeepEqual(oldInfo.Obj.Spec, wInfo.Obj.Spec) &&
 			!priorityBoostAnnotationChanged(oldInfo, wInfo) &&
+			!deadlineAnnotationChanged(oldInfo, wInfo) &&
 			equality.Semantic.DeepEqual(oldInfo.Obj.Status.ReclaimablePods, wInfo.Obj.Status.ReclaimablePods) &&
 			equality.Semantic.DeepEqual(apimeta.FindStatusCondition(oldInfo.Obj.Status.Conditions, kueue.WorkloadEvicted),
 				apimeta.FindStatusCondition(wInfo.Obj.Status.Conditions, kueue.WorkloadEvicted)) &&
@@ -410,6 +435,13 @@ func priorityBoostAnnotationChanged(oldInfo, newInfo *workload.Info) bool {
 	return oldInfo.Obj.Annotations[controllerconstants.PriorityBoostAnnotationKey] != newInfo.Obj.Annotations[controllerconstants.PriorityBoostAnnotationKey]
 }
 
+func deadlineAnnotationChanged(oldInfo, newInfo *workload.Info) bool {
+	if !features.Enabled(features.DeadlineAwareQueueing) {
+		return false
+	}
+	return oldInfo.Obj.Annotations[controllerconstants.DeadlineAnnotationKey] != newInfo.Obj.Annotations[controllerconstants.DeadlineAnnotationKey]
+}
+
 // draRequestsChanged returns true if DRA preprocessing changed TotalRequests.
 // DRA extended resources are resolved in Reconcile, which can modify TotalRequests
 // without changing the workload Spec.
@@ -695,6 +727,8 @@ func buildSnapshotSort(
 	ctx context.Context,
 	wo workload.Ordering,
 	sw *stickyWorkload,
+	orderByDeadline *atomic.Bool,
+	deprioritizedLQs *atomic.Pointer[sets.Set[utilqueue.LocalQueueReference]],
 	cl client.Client,
 	enableAdmissionFs bool,
 	fsResWeights map[corev1.ResourceName]float64,
@@ -703,7 +737,7 @@ func buildSnapshotSort(
 	log := ctrl.LoggerFrom(ctx)
 	if !enableAdmissionFs {
 		return func(elements []*workload.Info) {
-			slices.SortFunc(elements, baseCompareFunc(log, wo, sw.capturedMatcher()))
+			slices.SortFunc(elements, baseCompareFunc(log, wo, sw.capturedMatcher(), capturedBool(orderByDeadline), capturedMatcher(deprioritizedLQs)))
 		}
 	}
 
@@ -723,7 +757,8 @@ func buildSnapshotSort(
 	return func(elements []*workload.Info) {
 		// Capture the sticky workload once so the sort stays transitive without
 		// holding the lock. See Kueue#12740.
-		baseCmp := baseCompareFunc(log, wo, sw.capturedMatcher())
+		deprioritized := capturedMatcher(deprioritizedLQs)
+		baseCmp := baseCompareFunc(log, wo, sw.capturedMatcher(), capturedBool(orderByDeadline), deprioritized)
 		usageCache := make(map[utilqueue.LocalQueueReference]float64)
 		for _, wInfo := range elements {
 			lqKey := utilqueue.KeyFromWorkload(wInfo.Obj)
@@ -749,6 +784,9 @@ func buildSnapshotSort(
 		}
 
 		slices.SortFunc(elements, func(a, b *workload.Info) int {
+			if cmpResult := compareDeprioritized(deprioritized, a.Obj, b.Obj); cmpResult != 0 {
+				return cmpResult
+			}
 			lqA := utilqueue.KeyFromWorkload(a.Obj)
 			lqB := utilqueue.KeyFromWorkload(b.Obj)
 			usageA, okA := usageCache[lqA]
@@ -804,7 +842,7 @@ func (c *ClusterQueue) RequeueIfNotPresent(ctx context.Context, wInfo *workload.
 	log := ctrl.LoggerFrom(ctx)
 	var immediate bool
 	if c.queueingStrategy == kueue.StrictFIFO {
-		immediate = reason != RequeueReasonNamespaceMismatch
+		immediate = reason != RequeueReasonNamespaceMismatch && reason != RequeueReasonBudgetExhausted
 	} else {
 		immediate = reason == RequeueReasonFailedAfterNomination ||
 			reason == RequeueReasonPendingPreemption ||
@@ -814,11 +852,44 @@ func (c *ClusterQueue) RequeueIfNotPresent(ctx context.Context, wInfo *workload.
 	return c.requeueIfNotPresent(log, wInfo, immediate, reason, quotaReservedReason)
 }
 
-// baseCompareFunc orders workloads by sticky status, priority, timestamp, and UID.
+// capturedBool captures the current value of b once and returns a function
+// bound to that fixed value, so that a lock-free sort stays transitive.
+func capturedBool(b *atomic.Bool) func() bool {
+	captured := b.Load()
+	return func() bool { return captured }
+}
+
+// liveMatcher returns a function reporting whether a LocalQueue is in the
+// current value of set.
+func liveMatcher(set *atomic.Pointer[sets.Set[utilqueue.LocalQueueReference]]) func(utilqueue.LocalQueueReference) bool {
+	return func(lqKey utilqueue.LocalQueueReference) bool {
+		lqs := set.Load()
+		return lqs != nil && lqs.Has(lqKey)
+	}
+}
+
+// capturedMatcher captures the current value of set once and returns a
+// function bound to it, so that a lock-free sort stays transitive.
+func capturedMatcher(set *atomic.Pointer[sets.Set[utilqueue.LocalQueueReference]]) func(utilqueue.LocalQueueReference) bool {
+	lqs := set.Load()
+	return func(lqKey utilqueue.LocalQueueReference) bool {
+		return lqs != nil && lqs.Has(lqKey)
+	}
+}
+
+// baseCompareFunc orders workloads by sticky status, exhausted LocalQueue budget
+// (deprioritized returns true), deadline (when orderByDeadline returns true),
+// priority, timestamp, and UID.
 // stickyMatches reports whether a workload is the sticky one; callers pass a
 // live matcher (stickyWorkload.matches) for the heap or a captured one
 // (stickyWorkload.capturedMatcher) for the lock-free Snapshot sort. See Kueue#12740.
-func baseCompareFunc(log logr.Logger, wo workload.Ordering, stickyMatches func(workload.Reference) bool) func(a, b *workload.Info) int {
+func baseCompareFunc(
+	log logr.Logger,
+	wo workload.Ordering,
+	stickyMatches func(workload.Reference) bool,
+	orderByDeadline func() bool,
+	deprioritized func(utilqueue.LocalQueueReference) bool,
+) func(a, b *workload.Info) int {
 	return func(a, b *workload.Info) int {
 		aSticky := stickyMatches(workload.Key(a.Obj))
 		bSticky := stickyMatches(workload.Key(b.Obj))
@@ -831,6 +902,16 @@ func baseCompareFunc(log logr.Logger, wo workload.Ordering, stickyMatches func(w
 			return 1
 		}
 
+		if cmpResult := compareDeprioritized(deprioritized, a.Obj, b.Obj); cmpResult != 0 {
+			return cmpResult
+		}
+
+		if orderByDeadline() {
+			if cmpResult := compareDeadlines(a, b); cmpResult != 0 {
+				return cmpResult
+			}
+		}
+
 		p1 := utilpriority.EffectivePriority(log, a.Obj)
 		p2 := utilpriority.EffectivePriority(log, b.Obj)
 		// Higher priority comes first (reverse order).
@@ -850,6 +931,34 @@ func baseCompareFunc(log logr.Logger, wo workload.Ordering, stickyMatches func(w
 	}
 }
 
+// compareDeprioritized orders the workloads of deprioritized LocalQueues after
+// the others.
+func compareDeprioritized(deprioritized func(utilqueue.LocalQueueReference) bool, a, b *kueue.Workload) int {
+	aDeprioritized := deprioritized(utilqueue.KeyFromWorkload(a))
+	bDeprioritized := deprioritized(utilqueue.KeyFromWorkload(b))
+	switch {
+	case aDeprioritized == bDeprioritized:
+		return 0
+	case aDeprioritized:
+		return 1
+	}
+	return -1
+}
+
+// compareDeadlines orders workloads by their deadline, earliest first.
+// Workloads without a deadline come after the ones with a deadline.
+func compareDeadlines(a, b *workload.Info) int {
+	switch {
+	case a.Deadline != nil && b.Deadline != nil:
+		return a.Deadline.Compare(*b.Deadline)
+	case a.Deadline != nil:
+		return -1
+	case b.Deadline != nil:
+		return 1
+	}
+	return 0
+}
+
 // queueOrderingFunc composes fair-sharing usage (when enabled) with baseCompareFunc.
 // It reads the LocalQueue weight via getLQWeight (backed by the cached weights)
 // instead of a fallible API read, so a failed LocalQueue lookup can no longer flip
@@ -862,13 +971,18 @@ func queueOrderingFunc(
 	enableAdmissionFs bool,
 	afsUsageLedger *queueafs.AfsUsageLedger,
 	stickyMatches func(workload.Reference) bool,
+	orderByDeadline func() bool,
+	deprioritized func(utilqueue.LocalQueueReference) bool,
 ) func(a, b *workload.Info) int {
 	log := ctrl.LoggerFrom(ctx)
-	baseCmp := baseCompareFunc(log, wo, stickyMatches)
+	baseCmp := baseCompareFunc(log, wo, stickyMatches, orderByDeadline, deprioritized)
 	if !enableAdmissionFs {
 		return baseCmp
 	}
 	return func(a, b *workload.Info) int {
+		if cmpResult := compareDeprioritized(deprioritized, a.Obj, b.Obj); cmpResult != 0 {
+			return cmpResult
+		}
 		lqAUsage := a.ComputeLocalQueueFSUsage(getLQWeight(utilqueue.KeyFromWorkload(a.Obj)), fsResWeights, afsUsageLedger)
 		lqBUsage := b.ComputeLocalQueueFSUsage(getLQWeight(utilqueue.KeyFromWorkload(b.Obj)), fsResWeights, afsUsageLedger)
 		log.V(3).Info("Resource usage from LocalQueue", "localQueue", klog.KRef(a.Obj.Namespace, string(a.Obj.Spec.QueueName)), "usage", lqAUsage)
@@ -907,3 +1021,26 @@ func (c *ClusterQueue) UpdateLocalQueueWeight(lqKey utilqueue.LocalQueueReferenc
 	c.lqWeights[lqKey] = weight
 	c.workloads.RebuildActiveHeap()
 }
+
+// setLocalQueueDeprioritized sets whether the workloads of a LocalQueue are
+// ordered after the workloads of the other LocalQueues, and reheapifies the
+// pending workloads if it changed.
+func (c *ClusterQueue) setLocalQueueDeprioritized(lqKey utilqueue.LocalQueueReference, deprioritized bool) {
+	c.rwm.Lock()
+	defer c.rwm.Unlock()
+	lqs := c.deprioritizedLQs.Load()
+	if (lqs != nil && lqs.Has(lqKey)) == deprioritized {
+		return
+	}
+	updated := sets.New[utilqueue.LocalQueueReference]()
+	if lqs != nil {
+		updated = lqs.Clone()
+	}
+	if deprioritized {
+		updated.Insert(lqKey)
+	} else {
+		updated.Delete(lqKey)
+	}
+	c.deprioritizedLQs.Store(&updated)
+	c.workloads.RebuildActiveHeap()
+}
@@ -39,6 +39,7 @@ import (
 	config "sigs.k8s.io/kueue/apis/config/v1beta2"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	queueafs "sigs.k8s.io/kueue/pkg/cache/queue/afs"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
@@ -1625,6 +1626,183 @@ func TestStrictFIFO(t *testing.T) {
 	}
 }
 
+func TestEarliestDeadlineFirst(t *testing.T) {
+	t1 := time.Now()
+	t2 := t1.Add(time.Second)
+	t3 := t2.Add(time.Second)
+	early := t1.Add(time.Hour).UTC().Format(time.RFC3339)
+	late := t1.Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	workloads := []*kueue.Workload{
+		utiltestingapi.MakeWorkload("no-deadline", "").
+			Creation(t1).
+			Priority(highPriority).
+			Obj(),
+		utiltestingapi.MakeWorkload("late", "").
+			Creation(t1).
+			Annotation(controllerconstants.DeadlineAnnotationKey, late).
+			Obj(),
+		utiltestingapi.MakeWorkload("early-low-priority", "").
+			Creation(t2).
+			Annotation(controllerconstants.DeadlineAnnotationKey, early).
+			Priority(lowPriority).
+			Obj(),
+		utiltestingapi.MakeWorkload("early-high-priority", "").
+			Creation(t3).
+			Annotation(controllerconstants.DeadlineAnnotationKey, early).
+			Priority(highPriority).
+			Obj(),
+	}
+	for name, tc := range map[string]struct {
+		enableDeadlineAwareQueueing bool
+		queueingStrategy            kueue.QueueingStrategy
+		want                        []workload.Reference
+	}{
+		"earliest deadline first": {
+			enableDeadlineAwareQueueing: true,
+			queueingStrategy:            kueue.EarliestDeadlineFirst,
+			want:                        []workload.Reference{"/early-high-priority", "/early-low-priority", "/late", "/no-deadline"},
+		},
+		"feature disabled": {
+			queueingStrategy: kueue.EarliestDeadlineFirst,
+			want:             []workload.Reference{"/no-deadline", "/early-high-priority", "/late", "/early-low-priority"},
+		},
+		"other strategy": {
+			enableDeadlineAwareQueueing: true,
+			queueingStrategy:            kueue.BestEffortFIFO,
+			want:                        []workload.Reference{"/no-deadline", "/early-high-priority", "/late", "/early-low-priority"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.DeadlineAwareQueueing, tc.enableDeadlineAwareQueueing)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			q, err := newClusterQueue(ctx, nil,
+				&kueue.ClusterQueue{
+					Spec: kueue.ClusterQueueSpec{
+						QueueingStrategy: tc.queueingStrategy,
+					},
+				}, nil, defaultOrdering, nil, nil)
+			if err != nil {
+				t.Fatalf("Failed creating ClusterQueue %v", err)
+			}
+			for _, wl := range workloads {
+				q.PushOrUpdate(workload.NewInfo(wl))
+			}
+
+			snapshot := make([]workload.Reference, 0, len(workloads))
+			for _, info := range q.Snapshot() {
+				snapshot = append(snapshot, workload.Key(info.Obj))
+			}
+			if diff := cmp.Diff(tc.want, snapshot); diff != "" {
+				t.Errorf("Unexpected snapshot order (-want,+got):\n%s", diff)
+			}
+
+			popped := make([]workload.Reference, 0, len(workloads))
+			for info := q.Pop(); info != nil; info = q.Pop() {
+				popped = append(popped, workload.Key(info.Obj))
+			}
+			if diff := cmp.Diff(tc.want, popped); diff != "" {
+				t.Errorf("Unexpected pop order (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEarliestDeadlineFirstStrategyUpdate(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.DeadlineAwareQueueing, true)
+	now := time.Now()
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cq := utiltestingapi.MakeClusterQueue("cq").QueueingStrategy(kueue.BestEffortFIFO).Obj()
+	q, err := newClusterQueue(ctx, nil, cq, nil, defaultOrdering, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed creating ClusterQueue %v", err)
+	}
+	q.PushOrUpdate(workload.NewInfo(utiltestingapi.MakeWorkload("old", "").
+		Creation(now).
+		Annotation(controllerconstants.DeadlineAnnotationKey, now.Add(2*time.Hour).UTC().Format(time.RFC3339)).
+		Obj()))
+	q.PushOrUpdate(workload.NewInfo(utiltestingapi.MakeWorkload("urgent", "").
+		Creation(now.Add(time.Second)).
+		Annotation(controllerconstants.DeadlineAnnotationKey, now.Add(time.Hour).UTC().Format(time.RFC3339)).
+		Obj()))
+
+	cq.Spec.QueueingStrategy = kueue.EarliestDeadlineFirst
+	if err := q.Update(cq); err != nil {
+		t.Fatalf("Failed updating ClusterQueue %v", err)
+	}
+	if got := q.Pop(); got == nil || got.Obj.Name != "urgent" {
+		t.Errorf("Popped workload %v, want %q", got, "urgent")
+	}
+}
+
+func TestDeprioritizedLocalQueue(t *testing.T) {
+	t1 := time.Now()
+	t2 := t1.Add(time.Second)
+	t3 := t2.Add(time.Second)
+	workloads := []*kueue.Workload{
+		utiltestingapi.MakeWorkload("a1", defaultNamespace).
+			Queue("lq-a").
+			Creation(t1).
+			Priority(highPriority).
+			Obj(),
+		utiltestingapi.MakeWorkload("b1", defaultNamespace).
+			Queue("lq-b").
+			Creation(t2).
+			Priority(lowPriority).
+			Obj(),
+		utiltestingapi.MakeWorkload("b2", defaultNamespace).
+			Queue("lq-b").
+			Creation(t3).
+			Priority(lowPriority).
+			Obj(),
+	}
+	for name, tc := range map[string]struct {
+		enableAdmissionFs bool
+		deprioritized     bool
+		want              []workload.Reference
+	}{
+		"no deprioritized LocalQueue": {
+			want: []workload.Reference{"default/a1", "default/b1", "default/b2"},
+		},
+		"deprioritized LocalQueue": {
+			deprioritized: true,
+			want:          []workload.Reference{"default/b1", "default/b2", "default/a1"},
+		},
+		"deprioritized LocalQueue with admission fair sharing": {
+			enableAdmissionFs: true,
+			deprioritized:     true,
+			want:              []workload.Reference{"default/b1", "default/b2", "default/a1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			q := newClusterQueueImpl(ctx, nil, nil, defaultOrdering, testingclock.NewFakeClock(t1),
+				withEnableAdmissionFs(tc.enableAdmissionFs),
+				withAfsUsageLedger(queueafs.NewAfsUsageLedger()),
+			)
+			for _, wl := range workloads {
+				q.PushOrUpdate(workload.NewInfo(wl))
+			}
+			q.setLocalQueueDeprioritized(utilqueue.LocalQueueReference("default/lq-a"), tc.deprioritized)
+
+			snapshot := make([]workload.Reference, 0, len(workloads))
+			for _, info := range q.Snapshot() {
+				snapshot = append(snapshot, workload.Key(info.Obj))
+			}
+			if diff := cmp.Diff(tc.want, snapshot); diff != "" {
+				t.Errorf("Unexpected snapshot order (-want,+got):\n%s", diff)
+			}
+
+			popped := make([]workload.Reference, 0, len(workloads))
+			for info := q.Pop(); info != nil; info = q.Pop() {
+				popped = append(popped, workload.Key(info.Obj))
+			}
+			if diff := cmp.Diff(tc.want, popped); diff != "" {
+				t.Errorf("Unexpected pop order (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestStrictFIFORequeueIfNotPresent(t *testing.T) {
 	tests := map[RequeueReason]struct {
 		wantInadmissible bool
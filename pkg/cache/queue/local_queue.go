@@ -34,6 +34,10 @@ type LocalQueue struct {
 	finishedWorkloads sets.Set[workload.Reference]
 
 	labels map[string]string
+
+	// budgetExhausted is the action taken on the pending workloads while the
+	// LocalQueue budget is exhausted; empty if it isn't.
+	budgetExhausted kueue.BudgetExhaustedAction
 }
 
 func newLocalQueue(q *kueue.LocalQueue) *LocalQueue {
@@ -52,6 +56,10 @@ func (q *LocalQueue) update(apiQueue *kueue.LocalQueue) {
 	q.labels = apiQueue.GetLabels()
 }
 
+func (q *LocalQueue) budgetDeprioritized() bool {
+	return q.budgetExhausted == kueue.BudgetExhaustedDeprioritize
+}
+
 func (q *LocalQueue) AddOrUpdate(info *workload.Info) {
 	key := workload.Key(info.Obj)
 	q.items[key] = info
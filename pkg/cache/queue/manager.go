@@ -351,6 +351,7 @@ func (m *Manager) AddClusterQueue(ctx context.Context, cq *kueue.ClusterQueue) e
 			// Seed the cached weight before pushing workloads so the heap
 			// orders them under the correct weight from the first push.
 			cqImpl.addLocalQueue(queue.Key(&q), afs.LQWeightAsFloat64(&q))
+			cqImpl.setLocalQueueDeprioritized(queue.Key(&q), qImpl.budgetDeprioritized())
 			added := cqImpl.AddFromLocalQueue(qImpl, m.roleTracker, m.customLabels)
 			addedWorkloads = addedWorkloads || added
 			if features.Enabled(features.UnadmittedWorkloadsObservability) {
@@ -576,11 +577,13 @@ func (m *Manager) UpdateLocalQueue(log logr.Logger, q *kueue.LocalQueue) error {
 		if oldCQ != nil {
 			oldCQ.DeleteFromLocalQueue(log, qImpl, m.roleTracker, m.customLabels)
 			oldCQ.deleteLocalQueue(queue.Key(q))
+			oldCQ.setLocalQueueDeprioritized(queue.Key(q), false)
 		}
 		newCQ := m.hm.ClusterQueue(q.Spec.ClusterQueue)
 		if newCQ != nil {
 			// Seed the weight before pushing so the heap uses it from the start.
 			newCQ.addLocalQueue(queue.Key(q), afs.LQWeightAsFloat64(q))
+			newCQ.setLocalQueueDeprioritized(queue.Key(q), qImpl.budgetDeprioritized())
 			newCQ.AddFromLocalQueue(qImpl, m.roleTracker, m.customLabels)
 			m.Broadcast()
 		}
@@ -610,6 +613,7 @@ func (m *Manager) DeleteLocalQueue(log logr.Logger, q *kueue.LocalQueue) {
 	if cq != nil {
 		cq.DeleteFromLocalQueue(log, qImpl, m.roleTracker, m.customLabels)
 		cq.deleteLocalQueue(key)
+		cq.setLocalQueueDeprioritized(key, false)
 	}
 	if m.lqMetrics.IsEnabled() {
 		clearLQMetrics(key)
@@ -913,6 +917,24 @@ func (m *Manager) Heads(ctx context.Context) []workload.Info {
 	}
 }
 
+// HasHeads reports whether Heads would return workloads without blocking.
+func (m *Manager) HasHeads() bool {
+	m.RLock()
+	defer m.RUnlock()
+	if m.secondPassQueue.hasReady() {
+		return true
+	}
+	for cqName, cq := range m.hm.ClusterQueues() {
+		if m.statusChecker != nil && !m.statusChecker.ClusterQueueActive(cqName) {
+			continue
+		}
+		if active, _ := cq.Pending(); active > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Manager) heads() []workload.Info {
 	workloads := m.secondPassQueue.takeAllReady()
 	for cqName, cq := range m.hm.ClusterQueues() {
@@ -967,6 +989,29 @@ func (m *Manager) PendingWorkloadsInfo(cqName kueue.ClusterQueueReference) []*wo
 	return cq.Snapshot()
 }
 
+// PendingWorkloadInfo returns a copy of the info of the pending workload with
+// the given key, including the ClusterQueue it's queued in; nil if the
+// workload isn't pending.
+func (m *Manager) PendingWorkloadInfo(wlKey workload.Reference) *workload.Info {
+	m.RLock()
+	defer m.RUnlock()
+	q := m.localQueues[m.workloadAssignedQueues[wlKey]]
+	if q == nil {
+		return nil
+	}
+	cq := m.hm.ClusterQueue(q.ClusterQueue)
+	if cq == nil {
+		return nil
+	}
+	wl := cq.trackedInfo(wlKey)
+	if wl == nil {
+		return nil
+	}
+	wlCopy := *wl
+	wlCopy.ClusterQueue = q.ClusterQueue
+	return &wlCopy
+}
+
 // ClusterQueueFromLocalQueue returns ClusterQueue name and whether it's found,
 // given a QueueKey(namespace/localQueueName) as the parameter
 func (m *Manager) ClusterQueueFromLocalQueue(localQueueKey queue.LocalQueueReference) (kueue.ClusterQueueReference, bool) {
@@ -1131,3 +1176,39 @@ func (m *Manager) LocalQueueExistsWithoutLock(lqRef queue.LocalQueueReference) b
 	_, ok := m.localQueues[lqRef]
 	return ok
 }
+
+// SetLocalQueueBudgetExhausted records the action taken on the pending workloads
+// of a LocalQueue whose budget is exhausted, or an empty action once the budget
+// is renewed. When the workloads are no longer blocked, the inadmissible
+// workloads of the ClusterQueue are retried.
+func (m *Manager) SetLocalQueueBudgetExhausted(lqRef queue.LocalQueueReference, action kueue.BudgetExhaustedAction) error {
+	m.Lock()
+	defer m.Unlock()
+	qImpl, ok := m.localQueues[lqRef]
+	if !ok {
+		return ErrLocalQueueDoesNotExistOrInactive
+	}
+	if qImpl.budgetExhausted == action {
+		return nil
+	}
+	wasBlocked := qImpl.budgetExhausted == kueue.BudgetExhaustedBlock
+	qImpl.budgetExhausted = action
+	cq := m.hm.ClusterQueue(qImpl.ClusterQueue)
+	if cq == nil {
+		return nil
+	}
+	cq.setLocalQueueDeprioritized(lqRef, qImpl.budgetDeprioritized())
+	if wasBlocked {
+		notifyRetryInadmissibleWithoutLock(m, sets.New(cq.name))
+	}
+	return nil
+}
+
+// LocalQueueBudgetBlocked returns whether the budget of the LocalQueue is
+// exhausted and its pending workloads must not be admitted.
+func (m *Manager) LocalQueueBudgetBlocked(lqRef queue.LocalQueueReference) bool {
+	m.RLock()
+	defer m.RUnlock()
+	qImpl, ok := m.localQueues[lqRef]
+	return ok && qImpl.budgetExhausted == kueue.BudgetExhaustedBlock
+}
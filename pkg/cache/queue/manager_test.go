@@ -856,6 +856,55 @@ func TestUpdateLocalQueue(t *testing.T) {
 	}
 }
 
+func TestSetLocalQueueBudgetExhausted(t *testing.T) {
+	ctx, log := utiltesting.ContextWithLog(t)
+	cq := utiltestingapi.MakeClusterQueue("cq").Obj()
+	queues := []*kueue.LocalQueue{
+		utiltestingapi.MakeLocalQueue("foo", "").ClusterQueue("cq").Obj(),
+		utiltestingapi.MakeLocalQueue("bar", "").ClusterQueue("cq").Obj(),
+	}
+	now := time.Now()
+	workloads := []*kueue.Workload{
+		utiltestingapi.MakeWorkload("a", "").Queue("foo").Creation(now).Obj(),
+		utiltestingapi.MakeWorkload("b", "").Queue("bar").Creation(now.Add(time.Second)).Obj(),
+	}
+	manager := NewManagerForUnitTests(utiltesting.NewFakeClient(), nil, WithPreemptionExpectations(preemptexpectations.New()))
+	if err := manager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Failed adding clusterQueue %s: %v", cq.Name, err)
+	}
+	for _, q := range queues {
+		if err := manager.AddLocalQueue(ctx, q); err != nil {
+			t.Fatalf("Failed adding queue %s: %v", q.Name, err)
+		}
+	}
+	for _, w := range workloads {
+		if err := manager.AddOrUpdateWorkload(log, w); err != nil {
+			t.Errorf("Failed to add or update workload: %v", err)
+		}
+	}
+
+	lqRef := queue.Key(queues[0])
+	if err := manager.SetLocalQueueBudgetExhausted(lqRef, kueue.BudgetExhaustedBlock); err != nil {
+		t.Fatalf("Failed setting the budget exhausted: %v", err)
+	}
+	if !manager.LocalQueueBudgetBlocked(lqRef) {
+		t.Error("Expected the LocalQueue to be blocked by its budget")
+	}
+	if err := manager.SetLocalQueueBudgetExhausted(lqRef, kueue.BudgetExhaustedDeprioritize); err != nil {
+		t.Fatalf("Failed setting the budget exhausted: %v", err)
+	}
+	if manager.LocalQueueBudgetBlocked(lqRef) {
+		t.Error("Unexpected LocalQueue blocked by its budget")
+	}
+	if err := manager.SetLocalQueueBudgetExhausted("/missing", kueue.BudgetExhaustedBlock); !errors.Is(err, ErrLocalQueueDoesNotExistOrInactive) {
+		t.Errorf("Unexpected error for a missing LocalQueue: %v", err)
+	}
+
+	if diff := cmp.Diff([]workload.Reference{"/b", "/a"}, popNamesFromCQ(manager.hm.ClusterQueue("cq"))); diff != "" {
+		t.Errorf("Workloads popped in the wrong order (-want,+got):\n%s", diff)
+	}
+}
+
 // TestDeleteLocalQueue tests that when a LocalQueue is deleted, all its
 // workloads are not listed in the ClusterQueue.
 func TestDeleteLocalQueue(t *testing.T) {
@@ -62,6 +62,12 @@ func (q *secondPassQueue) takeAllReady() []workload.Info {
 	return result
 }
 
+func (q *secondPassQueue) hasReady() bool {
+	q.Lock()
+	defer q.Unlock()
+	return len(q.queued) > 0
+}
+
 func (q *secondPassQueue) prequeueIfAbsent(obj *kueue.Workload) bool {
 	q.Lock()
 	defer q.Unlock()
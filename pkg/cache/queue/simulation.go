@@ -0,0 +1,80 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// syncInadmissibleWorkloadRequeuer buffers requeue events
+// to be processed synchronously by the caller.
+type syncInadmissibleWorkloadRequeuer struct {
+	manager *Manager
+	cqs     sets.Set[kueue.ClusterQueueReference]
+	cohorts sets.Set[kueue.CohortReference]
+}
+
+func (r *syncInadmissibleWorkloadRequeuer) notifyClusterQueue(cqName kueue.ClusterQueueReference) {
+	r.cqs.Insert(cqName)
+}
+
+func (r *syncInadmissibleWorkloadRequeuer) notifyCohort(cohortName kueue.CohortReference) {
+	r.cohorts.Insert(cohortName)
+}
+
+func (r *syncInadmissibleWorkloadRequeuer) setManager(manager *Manager) {
+	r.manager = manager
+}
+
+// ProcessRequeues requeues all the inadmissible workloads
+// belonging to Cohorts/Queues which were notified.
+// Returns the total number of workloads moved.
+func (r *syncInadmissibleWorkloadRequeuer) ProcessRequeues(ctx context.Context) int {
+	total := 0
+	for cqName := range r.cqs {
+		total += requeueWorkloadsCQ(ctx, r.manager, cqName)
+	}
+	for cohortName := range r.cohorts {
+		total += requeueWorkloadsCohort(ctx, r.manager, cohortName)
+	}
+	r.cqs.Clear()
+	r.cohorts.Clear()
+	return total
+}
+
+func newManagerWithSyncRequeuer(client client.Client, checker StatusChecker, options ...Option) (*Manager, *syncInadmissibleWorkloadRequeuer) {
+	requeuer := &syncInadmissibleWorkloadRequeuer{
+		cqs:     sets.New[kueue.ClusterQueueReference](),
+		cohorts: sets.New[kueue.CohortReference](),
+	}
+	manager := NewManager(client, checker, requeuer, options...)
+	return manager, requeuer
+}
+
+// NewManagerForSimulation creates a Manager for offline scheduling simulations.
+// Inadmissible workloads are only moved back to the active queues when the
+// returned function is called, so the simulation decides when that happens.
+// The function returns the number of workloads moved.
+func NewManagerForSimulation(client client.Client, checker StatusChecker, options ...Option) (*Manager, func(ctx context.Context) int) {
+	manager, requeuer := newManagerWithSyncRequeuer(client, checker, options...)
+	return manager, requeuer.ProcessRequeues
+}
@@ -17,50 +17,9 @@ limitations under the License.
 package queue
 
 import (
-	"context"
-
-	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
-// testInadmissibleWorkloadRequeuer buffers requeue events
-// to be processed synchronously, for use in unit tests.
-type testInadmissibleWorkloadRequeuer struct {
-	manager *Manager
-	cqs     sets.Set[kueue.ClusterQueueReference]
-	cohorts sets.Set[kueue.CohortReference]
-}
-
-func (r *testInadmissibleWorkloadRequeuer) notifyClusterQueue(cqName kueue.ClusterQueueReference) {
-	r.cqs.Insert(cqName)
-}
-
-func (r *testInadmissibleWorkloadRequeuer) notifyCohort(cohortName kueue.CohortReference) {
-	r.cohorts.Insert(cohortName)
-}
-
-func (r *testInadmissibleWorkloadRequeuer) setManager(manager *Manager) {
-	r.manager = manager
-}
-
-// ProcessRequeues requeues all the inadmissible workloads
-// belonging to Cohorts/Queues which were notified.
-// Returns the total number of workloads moved.
-func (w *testInadmissibleWorkloadRequeuer) ProcessRequeues(ctx context.Context) int {
-	total := 0
-	for cqName := range w.cqs {
-		total += requeueWorkloadsCQ(ctx, w.manager, cqName)
-	}
-	for cohortName := range w.cohorts {
-		total += requeueWorkloadsCohort(ctx, w.manager, cohortName)
-	}
-	w.cqs.Clear()
-	w.cohorts.Clear()
-	return total
-}
-
 // NewManagerForUnitTests creates a new Manager for testing purposes.
 // This test manager, though exported, is not included in Kueue binary.
 // Note that this function is not found when running:
@@ -70,13 +29,7 @@ func NewManagerForUnitTests(client client.Client, checker StatusChecker, options
 	return manager
 }
 
-// NewManagerForUnitTestsWithRequeuer creates a new Manager for testing purposes, pre-configured with a testInadmissibleWorkloadRequeuer.
-func NewManagerForUnitTestsWithRequeuer(client client.Client, checker StatusChecker, options ...Option) (*Manager, *testInadmissibleWorkloadRequeuer) {
-	requeuer := &testInadmissibleWorkloadRequeuer{
-		cqs:     sets.New[kueue.ClusterQueueReference](),
-		cohorts: sets.New[kueue.CohortReference](),
-	}
-
-	manager := NewManager(client, checker, requeuer, options...)
-	return manager, requeuer
+// NewManagerForUnitTestsWithRequeuer creates a new Manager for testing purposes, pre-configured with a syncInadmissibleWorkloadRequeuer.
+func NewManagerForUnitTestsWithRequeuer(client client.Client, checker StatusChecker, options ...Option) (*Manager, *syncInadmissibleWorkloadRequeuer) {
+	return newManagerWithSyncRequeuer(client, checker, options...)
 }
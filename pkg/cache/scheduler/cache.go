@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -133,6 +135,13 @@ func WithCustomLabels(cl *metrics.CustomLabels) Option {
 	}
 }
 
+// WithClock sets the clock used to evaluate quota schedules.
+func WithClock(clock clock.PassiveClock) Option {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
 // WithLocalQueueMetrics sets the configuration for local queue metrics.
 func WithLocalQueueMetrics(value *metrics.LocalQueueMetricsConfig) Option {
 	return func(c *Cache) {
@@ -156,6 +165,13 @@ type Cache struct {
 	resourceFormatter      *resources.ResourceFormatter
 	// Tracks Workload's ClusterQueue assignment throughout its presence in the cache, which is when they reserve quota (`QuotaReserved=True`).
 	workloadAssignedQueues map[workload.Reference]kueue.ClusterQueueReference
+	// Tracks the ClusterQueues, other than the assigned one, charged for some PodSets of multi-queue Workloads.
+	workloadSecondaryQueues map[workload.Reference]sets.Set[kueue.ClusterQueueReference]
+	// Tracks the QuotaReservations by name.
+	quotaReservations map[string]*quotaReservation
+	// Tracks the quota reserved for the preemptors during the grace period
+	// of their victims, by preemptor.
+	preemptionReservations map[workload.Reference]*preemptionReservation
 
 	hm hierarchy.Manager[*clusterQueue, *cohort]
 
@@ -166,18 +182,24 @@ type Cache struct {
 	lqMetrics    *metrics.LocalQueueMetricsConfig
 
 	schedulingSimulator simulator.SchedulingSimulator
+
+	clock clock.PassiveClock
 }
 
 func New(client client.Client, options ...Option) *Cache {
 	resourceFormatter := resources.NewResourceFormatter()
 	cache := &Cache{
-		client:                 client,
-		resourceFlavors:        make(map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor),
-		admissionChecks:        make(map[kueue.AdmissionCheckReference]AdmissionCheck),
-		workloadAssignedQueues: make(map[workload.Reference]kueue.ClusterQueueReference),
-		hm:                     hierarchy.NewManager(newCohort),
-		resourceFormatter:      resourceFormatter,
-		schedulingSimulator:    newDefaultSimulator(),
+		client:                  client,
+		resourceFlavors:         make(map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor),
+		admissionChecks:         make(map[kueue.AdmissionCheckReference]AdmissionCheck),
+		workloadAssignedQueues:  make(map[workload.Reference]kueue.ClusterQueueReference),
+		workloadSecondaryQueues: make(map[workload.Reference]sets.Set[kueue.ClusterQueueReference]),
+		quotaReservations:       make(map[string]*quotaReservation),
+		preemptionReservations:  make(map[workload.Reference]*preemptionReservation),
+		hm:                      hierarchy.NewManager(newCohort),
+		resourceFormatter:       resourceFormatter,
+		schedulingSimulator:     newDefaultSimulator(),
+		clock:                   clock.RealClock{},
 	}
 	for _, option := range options {
 		option(cache)
@@ -204,9 +226,11 @@ func (c *Cache) newClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) (*clust
 		lqMetrics:           c.lqMetrics,
 		customLabels:        c.customLabels,
 	}
+	now := c.clock.Now()
+	cqImpl.resourceNode.ReservedQuota = c.reservedQuotaAt(cqImpl.Name, "", now)
 	c.hm.AddClusterQueue(cqImpl)
 	c.hm.UpdateClusterQueueEdge(kueue.ClusterQueueReference(cq.Name), cq.Spec.CohortName)
-	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, nil); err != nil {
+	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, nil, now); err != nil {
 		return nil, err
 	}
 
@@ -341,6 +365,17 @@ func (c *Cache) DeleteResourceFlavor(log logr.Logger, rf *kueue.ResourceFlavor)
 	return c.updateClusterQueues(log)
 }
 
+// ResourceFlavorPrices returns the prices declared by the ResourceFlavor,
+// or nil if the ResourceFlavor doesn't exist.
+func (c *Cache) ResourceFlavorPrices(name kueue.ResourceFlavorReference) []kueue.ResourcePrice {
+	c.RLock()
+	defer c.RUnlock()
+	if rf, found := c.resourceFlavors[name]; found {
+		return rf.Spec.Prices
+	}
+	return nil
+}
+
 func (c *Cache) AddOrUpdateTopology(log logr.Logger, topology *kueue.Topology) sets.Set[kueue.ClusterQueueReference] {
 	c.Lock()
 	defer c.Unlock()
@@ -464,6 +499,7 @@ func (c *Cache) AddClusterQueue(ctx context.Context, cq *kueue.ClusterQueue) err
 	if err != nil {
 		return err
 	}
+	cqImpl.restorePreemptionRecords(cq.Status.PreemptionBudget, c.clock.Now())
 
 	// On controller restart, an add ClusterQueue event may come after
 	// add queue and workload, so here we explicitly list and add existing queues
@@ -518,9 +554,12 @@ func (c *Cache) UpdateClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) erro
 	}
 	oldParent := cqImpl.Parent()
 	c.hm.UpdateClusterQueueEdge(kueue.ClusterQueueReference(cq.Name), cq.Spec.CohortName)
-	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, oldParent); err != nil {
+	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, oldParent, c.clock.Now()); err != nil {
 		return err
 	}
+	// Only the leader issues preemptions, the other replicas learn about them
+	// from the status, so that the budget is up to date when they take over.
+	cqImpl.restorePreemptionRecords(cq.Status.PreemptionBudget, c.clock.Now())
 	c.handleParentUpdate(oldParent)
 	for _, qImpl := range cqImpl.localQueues {
 		if qImpl == nil {
@@ -535,6 +574,31 @@ func (c *Cache) UpdateClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) erro
 	return nil
 }
 
+// ApplyClusterQueueQuotaSchedules re-evaluates the quota schedules of the
+// ClusterQueue at the current time. It returns whether the quotas in effect
+// changed, and the time until the next window starts or ends; zero if the
+// ClusterQueue has no quota schedules.
+func (c *Cache) ApplyClusterQueueQuotaSchedules(name kueue.ClusterQueueReference) (bool, time.Duration, error) {
+	c.Lock()
+	defer c.Unlock()
+	cqImpl := c.hm.ClusterQueue(name)
+	if cqImpl == nil {
+		return false, 0, ErrCqNotFound
+	}
+	now := c.clock.Now()
+	changed := cqImpl.applyQuotaSchedules(now)
+	if changed {
+		if err := cqImpl.updateQuotaTree(); err != nil {
+			return changed, 0, err
+		}
+	}
+	next, found := cqImpl.quotaSchedules.nextBoundary(now)
+	if !found {
+		return changed, 0, nil
+	}
+	return changed, next.Sub(now), nil
+}
+
 func (c *Cache) resyncClusterQueueGaugeMetricsLocked(cq *clusterQueue) {
 	if cq == nil {
 		return
@@ -642,7 +706,9 @@ func (c *Cache) AddOrUpdateCohort(apiCohort *kueue.Cohort) error {
 	cohort := c.hm.Cohort(cohortName)
 	oldParent := cohort.Parent()
 	c.hm.UpdateCohortEdge(cohortName, apiCohort.Spec.ParentName)
-	if err := cohort.updateCohort(apiCohort, oldParent); err != nil {
+	now := c.clock.Now()
+	cohort.resourceNode.ReservedQuota = c.reservedQuotaAt("", cohortName, now)
+	if err := cohort.updateCohort(apiCohort, oldParent, now); err != nil {
 		return err
 	}
 	c.handleParentUpdate(oldParent)
@@ -651,6 +717,31 @@ func (c *Cache) AddOrUpdateCohort(apiCohort *kueue.Cohort) error {
 	return nil
 }
 
+// ApplyCohortQuotaSchedules re-evaluates the quota schedules of the Cohort
+// at the current time. It returns whether the quotas in effect changed,
+// and the time until the next window starts or ends; zero if the Cohort
+// has no quota schedules.
+func (c *Cache) ApplyCohortQuotaSchedules(name kueue.CohortReference) (bool, time.Duration, error) {
+	c.Lock()
+	defer c.Unlock()
+	cohort := c.hm.Cohort(name)
+	if cohort == nil {
+		return false, 0, ErrCohortNotFound
+	}
+	now := c.clock.Now()
+	changed := cohort.applyQuotaSchedules(now)
+	if changed {
+		if err := updateCohortTreeResources(cohort); err != nil {
+			return changed, 0, err
+		}
+	}
+	next, found := cohort.quotaSchedules.nextBoundary(now)
+	if !found {
+		return changed, 0, nil
+	}
+	return changed, next.Sub(now), nil
+}
+
 // DeleteCohort removes the cohort from the cache and updates the SubtreeQuota
 // of ancestor cohorts to reflect the removal.
 func (c *Cache) DeleteCohort(cohortName kueue.CohortReference) {
@@ -815,6 +906,7 @@ func (c *Cache) addOrUpdateWorkloadWithoutLock(log logr.Logger, wl *kueue.Worklo
 			c.deleteFromQueueIfPresent(log, wlKey, assignedCqName)
 			delete(c.workloadAssignedQueues, wlKey)
 		}
+		c.deleteFromSecondaryQueues(log, wlKey, nil)
 		return false, nil
 	}
 
@@ -822,10 +914,18 @@ func (c *Cache) addOrUpdateWorkloadWithoutLock(log logr.Logger, wl *kueue.Worklo
 	if cq == nil {
 		return false, ErrCqNotFound
 	}
+	secondaryQueues := workload.SecondaryClusterQueues(wl)
+	for cqName := range secondaryQueues {
+		if c.hm.ClusterQueue(cqName) == nil {
+			return false, ErrCqNotFound
+		}
+	}
 
 	if assigned && assignedCqName != cq.Name {
 		c.deleteFromQueueIfPresent(log, wlKey, assignedCqName)
 	}
+	c.releasePreemptionReservations(wlKey, wl)
+	c.deleteFromSecondaryQueues(log, wlKey, secondaryQueues)
 
 	if c.podsReadyTracking {
 		c.podsReadyCond.Broadcast()
@@ -833,10 +933,27 @@ func (c *Cache) addOrUpdateWorkloadWithoutLock(log logr.Logger, wl *kueue.Worklo
 
 	c.workloadAssignedQueues[wlKey] = cq.Name
 	cq.addOrUpdateWorkload(log, wl)
+	for cqName := range secondaryQueues {
+		c.hm.ClusterQueue(cqName).addOrUpdateWorkload(log, wl)
+	}
+	if len(secondaryQueues) > 0 {
+		c.workloadSecondaryQueues[wlKey] = secondaryQueues
+	}
 
 	return true, nil
 }
 
+// deleteFromSecondaryQueues removes the Workload from the secondary
+// ClusterQueues it was charged to, except for the ones to keep.
+func (c *Cache) deleteFromSecondaryQueues(log logr.Logger, wlKey workload.Reference, keep sets.Set[kueue.ClusterQueueReference]) {
+	for cqName := range c.workloadSecondaryQueues[wlKey] {
+		if !keep.Has(cqName) {
+			c.deleteFromQueueIfPresent(log, wlKey, cqName)
+		}
+	}
+	delete(c.workloadSecondaryQueues, wlKey)
+}
+
 func (c *Cache) deleteFromQueueIfPresent(log logr.Logger, wlKey workload.Reference, cqName kueue.ClusterQueueReference) {
 	if cq := c.hm.ClusterQueue(cqName); cq != nil {
 		cq.deleteWorkload(log, wlKey)
@@ -847,6 +964,7 @@ func (c *Cache) DeleteWorkload(log logr.Logger, wlKey workload.Reference) error
 	c.Lock()
 	defer c.Unlock()
 
+	c.releasePreemptionReservations(wlKey, nil)
 	cqName, assigned := c.workloadAssignedQueues[wlKey]
 	if !assigned {
 		return nil
@@ -859,6 +977,12 @@ func (c *Cache) DeleteWorkload(log logr.Logger, wlKey workload.Reference) error
 
 	cq.forgetWorkload(log, wlKey)
 	delete(c.workloadAssignedQueues, wlKey)
+	for cqName := range c.workloadSecondaryQueues[wlKey] {
+		if secondary := c.hm.ClusterQueue(cqName); secondary != nil {
+			secondary.forgetWorkload(log, wlKey)
+		}
+	}
+	delete(c.workloadSecondaryQueues, wlKey)
 
 	if c.podsReadyTracking {
 		c.podsReadyCond.Broadcast()
@@ -1921,6 +1921,82 @@ func TestClusterQueueUsage(t *testing.T) {
 	}
 }
 
+func TestMultiQueueWorkloadUsage(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cpuCQ := utiltestingapi.MakeClusterQueue("cpu-cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Cohort("one").Obj()
+	gpuCQ := utiltestingapi.MakeClusterQueue("gpu-cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("model_a").Resource("example.com/gpu", "8").Obj()).
+		Cohort("one").Obj()
+	wl := utiltestingapi.MakeWorkload("one", "").
+		Queue("cpu-queue").
+		PodSets(
+			*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "2").Obj(),
+			*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu-queue").Request("example.com/gpu", "1").Obj(),
+		).
+		ReserveQuotaAt(utiltestingapi.MakeAdmission("cpu-cq").
+			PodSets(
+				utiltestingapi.MakePodSetAssignment("driver").
+					Assignment(corev1.ResourceCPU, "default", "2").
+					Obj(),
+				utiltestingapi.MakePodSetAssignment("workers").
+					Assignment("example.com/gpu", "model_a", "4").
+					Count(4).
+					ClusterQueue("gpu-cq").
+					Obj(),
+			).
+			Obj(), now).
+		Obj()
+
+	cache := New(utiltesting.NewFakeClient())
+	ctx, log := utiltesting.ContextWithLog(t)
+	for _, cq := range []*kueue.ClusterQueue{cpuCQ, gpuCQ} {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Adding ClusterQueue: %v", err)
+		}
+	}
+	if added := cache.AddOrUpdateWorkload(log, wl); !added {
+		t.Fatalf("Workload %s was not added", workload.Key(wl))
+	}
+
+	wantReserved := map[kueue.ClusterQueueReference][]kueue.FlavorUsage{
+		"cpu-cq": {{
+			Name:      "default",
+			Resources: []kueue.ResourceUsage{{Name: corev1.ResourceCPU, Total: resource.MustParse("2")}},
+		}},
+		"gpu-cq": {{
+			Name:      "model_a",
+			Resources: []kueue.ResourceUsage{{Name: "example.com/gpu", Total: resource.MustParse("4")}},
+		}},
+	}
+	for _, cq := range []*kueue.ClusterQueue{cpuCQ, gpuCQ} {
+		stats, err := cache.Usage(cq)
+		if err != nil {
+			t.Fatalf("Couldn't get usage: %v", err)
+		}
+		if diff := cmp.Diff(wantReserved[kueue.ClusterQueueReference(cq.Name)], stats.ReservedResources); diff != "" {
+			t.Errorf("Unexpected reserved resources in %s (-want,+got):\n%s", cq.Name, diff)
+		}
+		if stats.ReservingWorkloads != 1 {
+			t.Errorf("Got %d reserving workloads in %s, want 1", stats.ReservingWorkloads, cq.Name)
+		}
+	}
+
+	if err := cache.DeleteWorkload(log, workload.Key(wl)); err != nil {
+		t.Fatalf("Deleting workload: %v", err)
+	}
+	for _, cq := range []*kueue.ClusterQueue{cpuCQ, gpuCQ} {
+		stats, err := cache.Usage(cq)
+		if err != nil {
+			t.Fatalf("Couldn't get usage: %v", err)
+		}
+		if stats.ReservingWorkloads != 0 {
+			t.Errorf("Got %d reserving workloads in %s after deletion, want 0", stats.ReservingWorkloads, cq.Name)
+		}
+	}
+}
+
 func TestLocalQueueUsage(t *testing.T) {
 	now := time.Now().Truncate(time.Second)
 	cq := *utiltestingapi.MakeClusterQueue("foo").
@@ -23,6 +23,7 @@ import (
 	"math"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -90,6 +91,18 @@ type clusterQueue struct {
 	resourceNode resourceNode
 	hierarchy.ClusterQueue[*cohort]
 
+	// quotaSchedules holds the quotas from the spec and the windows
+	// overriding them; resourceNode.Quotas holds the ones in effect.
+	quotaSchedules quotaSchedules
+
+	// preemptionRecords are the preemptions issued by the workloads of the
+	// ClusterQueue within the window of its preemption budget, oldest first.
+	preemptionRecords []preemptionRecord
+
+	// priorityDecayAppliedAt is the time at which the priority decay of the
+	// workloads was last applied.
+	priorityDecayAppliedAt time.Time
+
 	tasCache *tasCache
 
 	// isTASSynced determines if the TAS cached is synced, ie: initialized,
@@ -154,20 +167,14 @@ func (c *clusterQueue) updateClusterQueue(
 	resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor,
 	admissionChecks map[kueue.AdmissionCheckReference]AdmissionCheck,
 	oldParent *cohort,
+	now time.Time,
 ) error {
-	if c.updateQuotasAndResourceGroups(in.Spec.ResourceGroups) || oldParent != c.Parent() {
+	if c.updateQuotasAndResourceGroups(in.Spec.ResourceGroups, now) || oldParent != c.Parent() {
 		if oldParent != nil && oldParent != c.Parent() {
 			updateCohortTreeResourcesIfNoCycle(oldParent)
 		}
-		if c.HasParent() {
-			// clusterQueue will be updated as part of tree update.
-			if err := updateCohortTreeResources(c.Parent()); err != nil {
-				return err
-			}
-		} else {
-			// since ClusterQueue has no parent, it won't be updated
-			// as part of tree update.
-			updateClusterQueueResourceNode(c)
+		if err := c.updateQuotaTree(); err != nil {
+			return err
 		}
 	}
 
@@ -231,13 +238,27 @@ func createdResourceGroups(kueueRgs []kueue.ResourceGroup) []resourcegroups.Reso
 	return rgs
 }
 
+// updateQuotaTree propagates changes of the ClusterQueue quotas
+// to the SubtreeQuota of the ClusterQueue and its Cohort tree.
+func (c *clusterQueue) updateQuotaTree() error {
+	if c.HasParent() {
+		// clusterQueue will be updated as part of tree update.
+		return updateCohortTreeResources(c.Parent())
+	}
+	// since ClusterQueue has no parent, it won't be updated
+	// as part of tree update.
+	updateClusterQueueResourceNode(c)
+	return nil
+}
+
 // updateQuotasAndResourceGroups updates Quotas and ResourceGroups.
 // It returns true if any changes were made.
-func (c *clusterQueue) updateQuotasAndResourceGroups(in []kueue.ResourceGroup) bool {
+func (c *clusterQueue) updateQuotasAndResourceGroups(in []kueue.ResourceGroup, now time.Time) bool {
 	oldRG := c.ResourceGroups
 	oldQuotas := c.resourceNode.Quotas
 	c.ResourceGroups = createdResourceGroups(in)
-	c.resourceNode.Quotas = createResourceQuotas(in)
+	c.quotaSchedules = createQuotaSchedules(in)
+	c.resourceNode.Quotas = c.quotaSchedules.quotasAt(now)
 
 	// Start at 1, for backwards compatibility.
 	// Use maps.EqualFunc with ResourceQuota.Equal for the Quotas map: it holds
@@ -248,6 +269,17 @@ func (c *clusterQueue) updateQuotasAndResourceGroups(in []kueue.ResourceGroup) b
 		!maps.EqualFunc(oldQuotas, c.resourceNode.Quotas, ResourceQuota.Equal)
 }
 
+// applyQuotaSchedules updates the quotas in effect at the given time.
+// It returns true if they changed.
+func (c *clusterQueue) applyQuotaSchedules(now time.Time) bool {
+	quotas := c.quotaSchedules.quotasAt(now)
+	if maps.EqualFunc(c.resourceNode.Quotas, quotas, ResourceQuota.Equal) {
+		return false
+	}
+	c.resourceNode.Quotas = quotas
+	return true
+}
+
 func (c *clusterQueue) updateQueueStatus(log logr.Logger) {
 	c.ensureTASIsSynced(log)
 	status := active
@@ -500,7 +532,7 @@ func (c *clusterQueue) addOrUpdateWorkload(log logr.Logger, w *kueue.Workload) {
 	if _, exist := c.Workloads[k]; exist {
 		c.deleteWorkload(log, k)
 	}
-	wi := workload.NewInfo(w, c.workloadInfoOptions...)
+	wi := workload.NewInfo(w, append(slices.Clone(c.workloadInfoOptions), workload.WithClusterQueue(c.Name))...)
 	wi.UpdateSchedulingHash(log)
 	c.Workloads[k] = wi
 	if features.Enabled(features.CustomMetricLabels) {
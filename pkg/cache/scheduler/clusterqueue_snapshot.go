@@ -73,6 +73,19 @@ type ClusterQueueSnapshot struct {
 	ResourceNode resourceNode
 	hierarchy.ClusterQueue[*CohortSnapshot]
 
+	// PreemptionBudgetUsage is the consumption of the preemption budget
+	// within the current window, or nil if there is no preemption budget.
+	PreemptionBudgetUsage *PreemptionBudgetUsage
+
+	// QuotaReservations are the ongoing QuotaReservations covering the
+	// ClusterQueue, by name.
+	QuotaReservations map[string]*QuotaReservationSnapshot
+
+	// PreemptionReservations are the quota reserved for the preemptors of
+	// the ClusterQueue during the grace period of their victims, by
+	// preemptor.
+	PreemptionReservations map[workload.Reference]*PreemptionReservationSnapshot
+
 	TASFlavors map[kueue.ResourceFlavorReference]*TASFlavorSnapshot
 	tasOnly    bool
 
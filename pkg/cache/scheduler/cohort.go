@@ -18,6 +18,8 @@ package scheduler
 
 import (
 	"iter"
+	"maps"
+	"time"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
@@ -29,6 +31,9 @@ type cohort struct {
 	hierarchy.Cohort[*clusterQueue, *cohort]
 
 	resourceNode resourceNode
+	// quotaSchedules holds the quotas from the spec and the windows
+	// overriding them; resourceNode.Quotas holds the ones in effect.
+	quotaSchedules quotaSchedules
 
 	FairWeight float64
 
@@ -43,16 +48,28 @@ func newCohort(name kueue.CohortReference) *cohort {
 	}
 }
 
-func (c *cohort) updateCohort(apiCohort *kueue.Cohort, oldParent *cohort) error {
+func (c *cohort) updateCohort(apiCohort *kueue.Cohort, oldParent *cohort, now time.Time) error {
 	c.FairWeight = parseFairWeight(apiCohort.Spec.FairSharing)
 
-	c.resourceNode.Quotas = createResourceQuotas(apiCohort.Spec.ResourceGroups)
+	c.quotaSchedules = createQuotaSchedules(apiCohort.Spec.ResourceGroups)
+	c.resourceNode.Quotas = c.quotaSchedules.quotasAt(now)
 	if oldParent != nil && oldParent != c.Parent() {
 		updateCohortTreeResourcesIfNoCycle(oldParent)
 	}
 	return updateCohortTreeResources(c)
 }
 
+// applyQuotaSchedules updates the quotas in effect at the given time.
+// It returns true if they changed.
+func (c *cohort) applyQuotaSchedules(now time.Time) bool {
+	quotas := c.quotaSchedules.quotasAt(now)
+	if maps.EqualFunc(c.resourceNode.Quotas, quotas, ResourceQuota.Equal) {
+		return false
+	}
+	c.resourceNode.Quotas = quotas
+	return true
+}
+
 func (c *cohort) GetName() kueue.CohortReference {
 	return c.Name
 }
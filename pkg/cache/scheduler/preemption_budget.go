@@ -0,0 +1,222 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// preemptionRecord is a preemption issued by a workload of a ClusterQueue.
+// A record restored from the ClusterQueue status accounts all the preemptions
+// of the window at the time of the last one.
+type preemptionRecord struct {
+	time      time.Time
+	workloads int
+	requests  resources.Requests
+}
+
+// PreemptionBudgetUsage is the consumption of the preemption budget of a
+// ClusterQueue within the current window.
+type PreemptionBudgetUsage struct {
+	Workloads          int
+	Requests           resources.Requests
+	LastPreemptionTime *time.Time
+}
+
+// preemptionBudgetUsage returns the consumption of the preemption budget
+// within the window ending at now. It returns nil if the ClusterQueue has no
+// preemption budget.
+func (c *clusterQueue) preemptionBudgetUsage(now time.Time) *PreemptionBudgetUsage {
+	budget := c.Preemption.PreemptionBudget
+	if !features.Enabled(features.PreemptionBudgets) || budget == nil {
+		return nil
+	}
+	usage := &PreemptionBudgetUsage{Requests: resources.NewRequests()}
+	windowStart := now.Add(-budget.Window.Duration)
+	for _, record := range c.preemptionRecords {
+		if !record.time.After(windowStart) {
+			continue
+		}
+		usage.Workloads += record.workloads
+		usage.Requests.Add(record.requests)
+		usage.LastPreemptionTime = &record.time
+	}
+	return usage
+}
+
+// prunePreemptionRecords drops the preemptions which are out of the window
+// ending at now. It returns the number of dropped records, and the time until
+// the oldest remaining record falls out of the window; zero if there are no
+// remaining records.
+func (c *clusterQueue) prunePreemptionRecords(now time.Time) (int, time.Duration) {
+	budget := c.Preemption.PreemptionBudget
+	if budget == nil {
+		pruned := len(c.preemptionRecords)
+		c.preemptionRecords = nil
+		return pruned, 0
+	}
+	windowStart := now.Add(-budget.Window.Duration)
+	pruned := 0
+	for pruned < len(c.preemptionRecords) && !c.preemptionRecords[pruned].time.After(windowStart) {
+		pruned++
+	}
+	c.preemptionRecords = c.preemptionRecords[pruned:]
+	if len(c.preemptionRecords) == 0 {
+		return pruned, 0
+	}
+	return pruned, c.preemptionRecords[0].time.Sub(windowStart)
+}
+
+// RecordPreemption accounts the preemption of the victim, issued by a workload
+// of the ClusterQueue, in the preemption budget of the ClusterQueue.
+func (c *Cache) RecordPreemption(cqName kueue.ClusterQueueReference, victim *workload.Info) {
+	if !features.Enabled(features.PreemptionBudgets) {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	cq := c.hm.ClusterQueue(cqName)
+	if cq == nil || cq.Preemption.PreemptionBudget == nil {
+		return
+	}
+	now := c.clock.Now()
+	cq.prunePreemptionRecords(now)
+	cq.preemptionRecords = append(cq.preemptionRecords, preemptionRecord{
+		time:      now,
+		workloads: 1,
+		requests:  preemptedRequests(victim),
+	})
+}
+
+// restorePreemptionRecords rebuilds the consumption of the preemption budget
+// from the ClusterQueue status, so that it survives restarts and changes of
+// leader. The preemptions are accounted at the time of the last one, so they
+// are kept until it falls out of the window. The records are kept if they
+// already account a preemption at least as recent as the status.
+func (c *clusterQueue) restorePreemptionRecords(status *kueue.PreemptionBudgetStatus, now time.Time) {
+	budget := c.Preemption.PreemptionBudget
+	if !features.Enabled(features.PreemptionBudgets) || budget == nil || status == nil || status.LastPreemptionTime == nil {
+		return
+	}
+	if !status.LastPreemptionTime.Time.After(now.Add(-budget.Window.Duration)) {
+		return
+	}
+	if n := len(c.preemptionRecords); n > 0 && !status.LastPreemptionTime.Time.After(c.preemptionRecords[n-1].time) {
+		return
+	}
+	c.preemptionRecords = []preemptionRecord{{
+		time:      status.LastPreemptionTime.Time,
+		workloads: int(status.PreemptedWorkloads),
+		requests:  resources.NewRequestsFromResourceList(status.PreemptedResources),
+	}}
+}
+
+// PrunePreemptionRecords drops the preemptions of the ClusterQueue which fell
+// out of the window of its preemption budget. It returns whether any
+// preemption was dropped, and the time until the next one is; zero if there
+// are none.
+func (c *Cache) PrunePreemptionRecords(cqName kueue.ClusterQueueReference) (bool, time.Duration, error) {
+	c.Lock()
+	defer c.Unlock()
+	cq := c.hm.ClusterQueue(cqName)
+	if cq == nil {
+		return false, 0, ErrCqNotFound
+	}
+	pruned, requeueAfter := cq.prunePreemptionRecords(c.clock.Now())
+	return pruned > 0, requeueAfter, nil
+}
+
+// PreemptionBudgetStatus returns the consumption of the preemption budget of
+// the ClusterQueue, or nil if it has no preemption budget.
+func (c *Cache) PreemptionBudgetStatus(cqName kueue.ClusterQueueReference) (*kueue.PreemptionBudgetStatus, error) {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(cqName)
+	if cq == nil {
+		return nil, ErrCqNotFound
+	}
+	usage := cq.preemptionBudgetUsage(c.clock.Now())
+	if usage == nil {
+		return nil, nil
+	}
+	status := &kueue.PreemptionBudgetStatus{
+		PreemptedWorkloads: int32(usage.Workloads),
+	}
+	if !usage.Requests.IsEmpty() {
+		status.PreemptedResources = usage.Requests.ToResourceList(c.resourceFormatter)
+	}
+	if usage.LastPreemptionTime != nil {
+		status.LastPreemptionTime = &metav1.Time{Time: *usage.LastPreemptionTime}
+	}
+	return status, nil
+}
+
+// PreemptionBudgetAllows returns whether preempting the victims keeps the
+// ClusterQueue within its preemption budget.
+func (c *ClusterQueueSnapshot) PreemptionBudgetAllows(victims []*workload.Info) bool {
+	budget := c.Preemption.PreemptionBudget
+	if c.PreemptionBudgetUsage == nil || budget == nil {
+		return true
+	}
+	if budget.MaxWorkloads != nil && c.PreemptionBudgetUsage.Workloads+len(victims) > int(*budget.MaxWorkloads) {
+		return false
+	}
+	if len(budget.MaxResources) == 0 {
+		return true
+	}
+	requests := c.PreemptionBudgetUsage.Requests.Clone()
+	for _, victim := range victims {
+		requests.Add(preemptedRequests(victim))
+	}
+	for name := range budget.MaxResources {
+		if requests.ResourceValue(name) > resources.ResourceValue(name, budget.MaxResources[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordPreemptions accounts the preemption of the victims, issued by a
+// workload of the ClusterQueue, in the consumption of the preemption budget of
+// the snapshot.
+func (c *ClusterQueueSnapshot) RecordPreemptions(victims []*workload.Info) {
+	if c.PreemptionBudgetUsage == nil {
+		return
+	}
+	for _, victim := range victims {
+		c.PreemptionBudgetUsage.Workloads++
+		c.PreemptionBudgetUsage.Requests.Add(preemptedRequests(victim))
+	}
+}
+
+// preemptedRequests returns the resources requested by the preempted workload.
+func preemptedRequests(wl *workload.Info) resources.Requests {
+	requests := resources.NewRequests()
+	for _, psReqs := range wl.TotalRequests {
+		if psReqs.Requests != nil {
+			requests.Add(psReqs.Requests)
+		}
+	}
+	return requests
+}
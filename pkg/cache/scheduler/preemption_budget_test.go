@@ -0,0 +1,368 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestPreemptionBudget(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	victim := func(name, cpu string) *workload.Info {
+		return workload.NewInfo(utiltestingapi.MakeWorkload(name, "ns").Request(corev1.ResourceCPU, cpu).Obj())
+	}
+
+	cases := map[string]struct {
+		enablePreemptionBudgets bool
+		budget                  *kueue.PreemptionBudget
+		preemptions             []time.Duration
+		advance                 time.Duration
+		candidates              []*workload.Info
+		wantPruned              bool
+		wantRequeueAfter        time.Duration
+		wantStatus              *kueue.PreemptionBudgetStatus
+		wantAllowed             bool
+	}{
+		"feature disabled": {
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(0)),
+			},
+			preemptions: []time.Duration{0},
+			candidates:  []*workload.Info{victim("c", "1")},
+			wantAllowed: true,
+		},
+		"no budget": {
+			enablePreemptionBudgets: true,
+			preemptions:             []time.Duration{0},
+			candidates:              []*workload.Info{victim("c", "1")},
+			wantAllowed:             true,
+		},
+		"within the max workloads": {
+			enablePreemptionBudgets: true,
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(2)),
+			},
+			preemptions:      []time.Duration{0},
+			candidates:       []*workload.Info{victim("c", "1")},
+			wantRequeueAfter: time.Hour,
+			wantStatus: &kueue.PreemptionBudgetStatus{
+				PreemptedWorkloads: 1,
+				PreemptedResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				LastPreemptionTime: &metav1.Time{Time: now},
+			},
+			wantAllowed: true,
+		},
+		"exceeds the max workloads": {
+			enablePreemptionBudgets: true,
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(2)),
+			},
+			preemptions:      []time.Duration{0, 10 * time.Minute},
+			candidates:       []*workload.Info{victim("c", "1")},
+			wantRequeueAfter: 50 * time.Minute,
+			wantStatus: &kueue.PreemptionBudgetStatus{
+				PreemptedWorkloads: 2,
+				PreemptedResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				LastPreemptionTime: &metav1.Time{Time: now.Add(10 * time.Minute)},
+			},
+		},
+		"exceeds the max resources": {
+			enablePreemptionBudgets: true,
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+			},
+			preemptions:      []time.Duration{0},
+			candidates:       []*workload.Info{victim("c", "1"), victim("d", "2")},
+			wantRequeueAfter: time.Hour,
+			wantStatus: &kueue.PreemptionBudgetStatus{
+				PreemptedWorkloads: 1,
+				PreemptedResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				LastPreemptionTime: &metav1.Time{Time: now},
+			},
+		},
+		"preemptions fell out of the window": {
+			enablePreemptionBudgets: true,
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(1)),
+			},
+			preemptions:      []time.Duration{0, 30 * time.Minute},
+			advance:          45 * time.Minute,
+			candidates:       []*workload.Info{victim("c", "1")},
+			wantPruned:       true,
+			wantRequeueAfter: 15 * time.Minute,
+			wantStatus: &kueue.PreemptionBudgetStatus{
+				PreemptedWorkloads: 1,
+				PreemptedResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				LastPreemptionTime: &metav1.Time{Time: now.Add(30 * time.Minute)},
+			},
+		},
+		"all preemptions fell out of the window": {
+			enablePreemptionBudgets: true,
+			budget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(1)),
+			},
+			preemptions: []time.Duration{0},
+			advance:     2 * time.Hour,
+			candidates:  []*workload.Info{victim("c", "1")},
+			wantPruned:  true,
+			wantStatus:  &kueue.PreemptionBudgetStatus{},
+			wantAllowed: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PreemptionBudgets, tc.enablePreemptionBudgets)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(now)
+			cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+
+			if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+					PreemptionBudget:   tc.budget,
+				}).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()); err != nil {
+				t.Fatal(err)
+			}
+
+			var elapsed time.Duration
+			for i, at := range tc.preemptions {
+				fakeClock.Step(at - elapsed)
+				elapsed = at
+				cache.RecordPreemption("cq", victim("preempted"+string(rune('a'+i)), "1"))
+			}
+			fakeClock.Step(tc.advance)
+
+			pruned, requeueAfter, err := cache.PrunePreemptionRecords("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pruned != tc.wantPruned {
+				t.Errorf("Unexpected pruned, want=%v, got=%v", tc.wantPruned, pruned)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter, want=%v, got=%v", tc.wantRequeueAfter, requeueAfter)
+			}
+
+			status, err := cache.PreemptionBudgetStatus("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, status); diff != "" {
+				t.Errorf("Unexpected preemption budget status (-want,+got):\n%s", diff)
+			}
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed := snapshot.ClusterQueue("cq").PreemptionBudgetAllows(tc.candidates); allowed != tc.wantAllowed {
+				t.Errorf("Unexpected PreemptionBudgetAllows, want=%v, got=%v", tc.wantAllowed, allowed)
+			}
+		})
+	}
+}
+
+func TestPreemptionBudgetRestoredFromStatus(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	budget := &kueue.PreemptionBudget{
+		Window:       metav1.Duration{Duration: time.Hour},
+		MaxWorkloads: new(int32(2)),
+	}
+	victim := func(name string) *workload.Info {
+		return workload.NewInfo(utiltestingapi.MakeWorkload(name, "ns").Request(corev1.ResourceCPU, "1").Obj())
+	}
+
+	cases := map[string]struct {
+		sinceLastPreemption time.Duration
+		wantStatus          *kueue.PreemptionBudgetStatus
+		wantRequeueAfter    time.Duration
+		wantAllowed         bool
+	}{
+		"within the window": {
+			sinceLastPreemption: 20 * time.Minute,
+			wantStatus: &kueue.PreemptionBudgetStatus{
+				PreemptedWorkloads: 2,
+				PreemptedResources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				LastPreemptionTime: &metav1.Time{Time: now.Add(10 * time.Minute)},
+			},
+			wantRequeueAfter: 40 * time.Minute,
+		},
+		"out of the window": {
+			sinceLastPreemption: 2 * time.Hour,
+			wantStatus:          &kueue.PreemptionBudgetStatus{},
+			wantAllowed:         true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PreemptionBudgets, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(now)
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+					PreemptionBudget:   budget,
+				}).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()
+
+			cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatal(err)
+			}
+			cache.RecordPreemption("cq", victim("a"))
+			fakeClock.Step(10 * time.Minute)
+			cache.RecordPreemption("cq", victim("b"))
+			status, err := cache.PreemptionBudgetStatus("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cq.Status.PreemptionBudget = status
+
+			// Re-create the cache, as done when Kueue restarts.
+			fakeClock.Step(tc.sinceLastPreemption)
+			cache = New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatal(err)
+			}
+
+			_, requeueAfter, err := cache.PrunePreemptionRecords("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter, want=%v, got=%v", tc.wantRequeueAfter, requeueAfter)
+			}
+			gotStatus, err := cache.PreemptionBudgetStatus("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, gotStatus); diff != "" {
+				t.Errorf("Unexpected preemption budget status (-want,+got):\n%s", diff)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed := snapshot.ClusterQueue("cq").PreemptionBudgetAllows([]*workload.Info{victim("c")}); allowed != tc.wantAllowed {
+				t.Errorf("Unexpected PreemptionBudgetAllows, want=%v, got=%v", tc.wantAllowed, allowed)
+			}
+		})
+	}
+}
+
+func TestPreemptionBudgetRestoredOnClusterQueueUpdate(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PreemptionBudgets, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	fakeClock := testingclock.NewFakeClock(now)
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+			PreemptionBudget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(2)),
+			},
+		}).
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Obj()
+	victim := func(name string) *workload.Info {
+		return workload.NewInfo(utiltestingapi.MakeWorkload(name, "ns").Request(corev1.ResourceCPU, "1").Obj())
+	}
+	newCache := func() *Cache {
+		cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+		cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+		if err := cache.AddClusterQueue(ctx, cq.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+		return cache
+	}
+
+	// Both replicas watch the ClusterQueue, but only the leader preempts.
+	leader := newCache()
+	follower := newCache()
+	leader.RecordPreemption("cq", victim("a"))
+	fakeClock.Step(10 * time.Minute)
+	leader.RecordPreemption("cq", victim("b"))
+	status, err := leader.PreemptionBudgetStatus("cq")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := cq.DeepCopy()
+	updated.Status.PreemptionBudget = status
+	fakeClock.Step(5 * time.Minute)
+	if err := leader.UpdateClusterQueue(log, updated); err != nil {
+		t.Fatal(err)
+	}
+	if err := follower.UpdateClusterQueue(log, updated); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, cache := range map[string]*Cache{"leader": leader, "follower": follower} {
+		t.Run(name, func(t *testing.T) {
+			gotStatus, err := cache.PreemptionBudgetStatus("cq")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(status, gotStatus); diff != "" {
+				t.Errorf("Unexpected preemption budget status (-want,+got):\n%s", diff)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if snapshot.ClusterQueue("cq").PreemptionBudgetAllows([]*workload.Info{victim("c")}) {
+				t.Error("The preemption budget allows more preemptions than its maxWorkloads")
+			}
+		})
+	}
+
+	// The leader keeps the time of each preemption, so the first one falls
+	// out of the window before the restored ones.
+	fakeClock.Step(46 * time.Minute)
+	leaderStatus, err := leader.PreemptionBudgetStatus("cq")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leaderStatus.PreemptedWorkloads != 1 {
+		t.Errorf("Unexpected preempted workloads accounted by the leader, want=1, got=%d", leaderStatus.PreemptedWorkloads)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// preemptionReservation is the quota reserved for a workload while the
+// workloads it preempts are given their grace period to checkpoint, so that
+// the quota they release isn't taken by other workloads.
+type preemptionReservation struct {
+	clusterQueue kueue.ClusterQueueReference
+	quantities   resources.FlavorResourceQuantities
+	// victims are the ClusterQueues of the workloads notified of their
+	// preemption, by workload.
+	victims map[workload.Reference]kueue.ClusterQueueReference
+	at      time.Time
+	// expiry is the time at which the preemption notices of the victims
+	// are withdrawn.
+	expiry time.Time
+}
+
+// ReservePreemptionQuota reserves the quota needed by the preemptor until the
+// victims, notified of their preemption, are evicted, or until their notices
+// expire.
+func (c *Cache) ReservePreemptionQuota(preemptor *workload.Info, quantities resources.FlavorResourceQuantities, victims []*workload.Info, expiry time.Time) {
+	c.Lock()
+	defer c.Unlock()
+	now := c.clock.Now()
+	for key, r := range c.preemptionReservations {
+		if !now.Before(r.expiry) {
+			delete(c.preemptionReservations, key)
+		}
+	}
+	r := &preemptionReservation{
+		clusterQueue: preemptor.ClusterQueue,
+		quantities:   quantities.Clone(),
+		victims:      make(map[workload.Reference]kueue.ClusterQueueReference, len(victims)),
+		at:           now,
+		expiry:       expiry,
+	}
+	if old := c.preemptionReservations[workload.Key(preemptor.Obj)]; old != nil && old.clusterQueue == r.clusterQueue {
+		r.at = old.at
+		maps.Copy(r.victims, old.victims)
+	}
+	for _, victim := range victims {
+		r.victims[workload.Key(victim.Obj)] = victim.ClusterQueue
+	}
+	c.preemptionReservations[workload.Key(preemptor.Obj)] = r
+}
+
+// releasePreemptionReservations releases the quota reserved for the
+// workload, once it's admitted or deleted, and the quota reserved for the
+// preemptors of the workload, once its preemption notice is withdrawn.
+func (c *Cache) releasePreemptionReservations(wlKey workload.Reference, wl *kueue.Workload) {
+	delete(c.preemptionReservations, wlKey)
+	if wl == nil || !workload.PreemptionNoticeWithdrawn(wl) {
+		return
+	}
+	withdrawn := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreemptionPending).LastTransitionTime.Time
+	for key, r := range c.preemptionReservations {
+		if _, found := r.victims[wlKey]; found && !withdrawn.Before(r.at) {
+			delete(c.preemptionReservations, key)
+		}
+	}
+}
+
+// PreemptionReservationSnapshot is the quota reserved for a workload in the
+// snapshot while the workloads it preempts are given their grace period.
+type PreemptionReservationSnapshot struct {
+	// Unused is the reserved quota which isn't used by the victims. It is
+	// charged as usage to the ClusterQueue of the preemptor.
+	Unused resources.FlavorResourceQuantities
+}
+
+// SimulatePreemptionReservationRelease modifies the snapshot by removing
+// the unused quota reserved for the workload, so that the workload can use
+// it. It returns a function which can be used to charge the unused quota
+// again.
+func (s *Snapshot) SimulatePreemptionReservationRelease(wl *workload.Info) func() {
+	cq := s.ClusterQueue(wl.ClusterQueue)
+	if cq == nil {
+		return func() {}
+	}
+	r := cq.PreemptionReservations[workload.Key(wl.Obj)]
+	if r == nil {
+		return func() {}
+	}
+	for fr, v := range r.Unused {
+		removeUsage(cq, fr, v)
+	}
+	return func() {
+		for fr, v := range r.Unused {
+			addUsage(cq, fr, v)
+		}
+	}
+}
+
+// snapshotPreemptionReservations charges the quota reserved for the
+// preemptors, beyond the quota still used by their victims, to their
+// ClusterQueues in the snapshot.
+func (c *Cache) snapshotPreemptionReservations(snap *Snapshot, now time.Time) {
+	for _, key := range slices.Sorted(maps.Keys(c.preemptionReservations)) {
+		r := c.preemptionReservations[key]
+		if !now.Before(r.expiry) {
+			continue
+		}
+		cq := snap.ClusterQueue(r.clusterQueue)
+		if cq == nil {
+			continue
+		}
+		used := make(resources.FlavorResourceQuantities, len(r.quantities))
+		for victimKey, victimCQName := range r.victims {
+			victimCQ := snap.ClusterQueue(victimCQName)
+			if victimCQ == nil {
+				continue
+			}
+			if victim := victimCQ.Workloads[victimKey]; victim != nil {
+				for fr, q := range victim.ResourceUsage().Assigned {
+					used[fr] = used[fr].Add(q)
+				}
+			}
+		}
+		prs := &PreemptionReservationSnapshot{Unused: make(resources.FlavorResourceQuantities, len(r.quantities))}
+		for fr, q := range r.quantities {
+			if unused := q.Sub(used[fr]); unused.CmpInt64(0) > 0 {
+				prs.Unused[fr] = unused
+				addUsage(cq, fr, unused)
+			}
+		}
+		if cq.PreemptionReservations == nil {
+			cq.PreemptionReservations = make(map[workload.Reference]*PreemptionReservationSnapshot)
+		}
+		cq.PreemptionReservations[key] = prs
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"time"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+)
+
+// ApplyPriorityDecay re-evaluates the priority decay of the workloads of the
+// ClusterQueue at the current time. It returns whether the effective priority
+// of some workloads decayed since the previous evaluation, and the time until
+// the next decay step; zero if the ClusterQueue has no priority decay or no
+// workload decays further.
+func (c *Cache) ApplyPriorityDecay(name kueue.ClusterQueueReference) (bool, time.Duration, error) {
+	c.Lock()
+	defer c.Unlock()
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil {
+		return false, 0, ErrCqNotFound
+	}
+	changed, requeueAfter := cq.applyPriorityDecay(c.clock.Now())
+	return changed, requeueAfter, nil
+}
+
+func (c *clusterQueue) applyPriorityDecay(now time.Time) (bool, time.Duration) {
+	decay := c.Preemption.PriorityDecay
+	if !features.Enabled(features.PriorityDecay) || decay == nil {
+		c.priorityDecayAppliedAt = time.Time{}
+		return false, 0
+	}
+	changed := false
+	var requeueAfter time.Duration
+	for _, wl := range c.Workloads {
+		if !c.priorityDecayAppliedAt.IsZero() &&
+			priority.Decay(wl.Obj, decay, c.priorityDecayAppliedAt) != priority.Decay(wl.Obj, decay, now) {
+			changed = true
+		}
+		if next := priority.NextDecay(wl.Obj, decay, now); next > 0 && (requeueAfter == 0 || next < requeueAfter) {
+			requeueAfter = next
+		}
+	}
+	c.priorityDecayAppliedAt = now
+	return changed, requeueAfter
+}
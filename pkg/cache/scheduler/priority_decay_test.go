@@ -0,0 +1,118 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestApplyPriorityDecay(t *testing.T) {
+	start := time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		enablePriorityDecay bool
+		priorityDecay       *kueue.PriorityDecay
+		advance             time.Duration
+		wantChanged         bool
+		wantRequeueAfter    time.Duration
+	}{
+		"feature disabled": {
+			priorityDecay: &kueue.PriorityDecay{WindowSeconds: 600, Step: 10},
+			advance:       15 * time.Minute,
+		},
+		"no priority decay": {
+			enablePriorityDecay: true,
+			advance:             15 * time.Minute,
+		},
+		"within the window": {
+			enablePriorityDecay: true,
+			priorityDecay:       &kueue.PriorityDecay{WindowSeconds: 600, Step: 10},
+			advance:             time.Minute,
+			wantRequeueAfter:    5 * time.Minute,
+		},
+		"past a window": {
+			enablePriorityDecay: true,
+			priorityDecay:       &kueue.PriorityDecay{WindowSeconds: 600, Step: 10},
+			advance:             15 * time.Minute,
+			wantChanged:         true,
+			wantRequeueAfter:    time.Minute,
+		},
+		"capped by maxDecay": {
+			enablePriorityDecay: true,
+			priorityDecay:       &kueue.PriorityDecay{WindowSeconds: 600, Step: 10, MaxDecay: new(int32(10))},
+			advance:             15 * time.Minute,
+			wantChanged:         true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PriorityDecay, tc.enablePriorityDecay)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(start)
+			cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+					PriorityDecay:      tc.priorityDecay,
+				}).
+				Obj()); err != nil {
+				t.Fatal(err)
+			}
+			// The workloads were admitted 10 and 4 minutes ago.
+			for name, admittedAgo := range map[string]time.Duration{"a": 10 * time.Minute, "b": 4 * time.Minute} {
+				cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload(name, "ns").
+					Request(corev1.ResourceCPU, "1").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj()).Obj(), start.Add(-admittedAgo)).
+					Obj())
+			}
+			if _, _, err := cache.ApplyPriorityDecay("cq"); err != nil {
+				t.Fatalf("Unexpected error applying the priority decay: %v", err)
+			}
+
+			fakeClock.Step(tc.advance)
+			changed, requeueAfter, err := cache.ApplyPriorityDecay("cq")
+			if err != nil {
+				t.Fatalf("Unexpected error applying the priority decay: %v", err)
+			}
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed: got %v, want %v", changed, tc.wantChanged)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", requeueAfter, tc.wantRequeueAfter)
+			}
+		})
+	}
+}
+
+func TestApplyPriorityDecayNotFound(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PriorityDecay, true)
+	cache := New(utiltesting.NewFakeClient())
+	if _, _, err := cache.ApplyPriorityDecay("missing"); err != ErrCqNotFound {
+		t.Errorf("Unexpected error for a missing ClusterQueue: got %v, want %v", err, ErrCqNotFound)
+	}
+}
@@ -0,0 +1,370 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/quotareservation"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// quotaReservation is a QuotaReservation tracked by the cache.
+type quotaReservation struct {
+	name              string
+	clusterQueue      kueue.ClusterQueueReference
+	cohort            kueue.CohortReference
+	allowedNamespaces sets.Set[string]
+	quantities        resources.FlavorResourceQuantities
+	window            quotareservation.Window
+}
+
+func newQuotaReservation(qr *kueue.QuotaReservation) *quotaReservation {
+	r := &quotaReservation{
+		name:              qr.Name,
+		clusterQueue:      qr.Spec.ClusterQueue,
+		cohort:            qr.Spec.Cohort,
+		allowedNamespaces: sets.New(qr.Spec.AllowedNamespaces...),
+		quantities:        make(resources.FlavorResourceQuantities, len(qr.Spec.Resources)),
+		window:            quotareservation.WindowOf(qr),
+	}
+	for _, res := range qr.Spec.Resources {
+		fr := resources.FlavorResource{Flavor: qr.Spec.Flavor, Resource: res.Name}
+		r.quantities[fr] = resources.AmountFromQuantity(res.Name, res.Quantity)
+	}
+	return r
+}
+
+// withheldAt returns the reserved quota withheld from lending at the given
+// time.
+func (r *quotaReservation) withheldAt(now time.Time) resources.FlavorResourceQuantities {
+	steps := int64(r.window.WithheldSteps(now))
+	if steps == 0 {
+		return nil
+	}
+	withheld := make(resources.FlavorResourceQuantities, len(r.quantities))
+	for fr, q := range r.quantities {
+		withheld[fr] = resources.NewAmount(q.Int64() * steps / quotareservation.RampUpSteps)
+	}
+	return withheld
+}
+
+// AddOrUpdateQuotaReservation adds or updates the QuotaReservation in the
+// cache, and withholds its reserved quota from lending as of the current
+// time. It returns the ClusterQueues of the affected Cohort trees, and the
+// time until the withheld quota or the phase of the reservation changes
+// next; zero once the reservation expired.
+func (c *Cache) AddOrUpdateQuotaReservation(qr *kueue.QuotaReservation) (sets.Set[kueue.ClusterQueueReference], time.Duration, error) {
+	c.Lock()
+	defer c.Unlock()
+	now := c.clock.Now()
+	r := newQuotaReservation(qr)
+	old := c.quotaReservations[r.name]
+	c.quotaReservations[r.name] = r
+
+	affected := sets.New[kueue.ClusterQueueReference]()
+	if old != nil && (old.clusterQueue != r.clusterQueue || old.cohort != r.cohort) {
+		cqs, err := c.updateReservedQuota(old.clusterQueue, old.cohort, now)
+		if err != nil {
+			return nil, 0, err
+		}
+		affected.Insert(cqs...)
+	}
+	cqs, err := c.updateReservedQuota(r.clusterQueue, r.cohort, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	affected.Insert(cqs...)
+
+	next := r.window.NextBoundary(now)
+	if next.IsZero() {
+		return affected, 0, nil
+	}
+	return affected, next.Sub(now), nil
+}
+
+// DeleteQuotaReservation removes the QuotaReservation from the cache, and
+// releases the quota it withheld. It returns the ClusterQueues of the
+// affected Cohort tree.
+func (c *Cache) DeleteQuotaReservation(name string) (sets.Set[kueue.ClusterQueueReference], error) {
+	c.Lock()
+	defer c.Unlock()
+	r, found := c.quotaReservations[name]
+	if !found {
+		return nil, nil
+	}
+	delete(c.quotaReservations, name)
+	cqs, err := c.updateReservedQuota(r.clusterQueue, r.cohort, c.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return sets.New(cqs...), nil
+}
+
+// reservedQuotaAt returns the quota withheld from lending at the given time
+// by the QuotaReservations targeting the ClusterQueue or Cohort.
+func (c *Cache) reservedQuotaAt(cqName kueue.ClusterQueueReference, cohortName kueue.CohortReference, now time.Time) resources.FlavorResourceQuantities {
+	var reserved resources.FlavorResourceQuantities
+	for _, r := range c.quotaReservations {
+		if r.clusterQueue != cqName || r.cohort != cohortName {
+			continue
+		}
+		for fr, q := range r.withheldAt(now) {
+			if reserved == nil {
+				reserved = make(resources.FlavorResourceQuantities)
+			}
+			reserved[fr] = reserved[fr].Add(q)
+		}
+	}
+	return reserved
+}
+
+// updateReservedQuota recomputes the quota withheld from lending by the
+// ClusterQueue or Cohort, and propagates it to its Cohort tree. It returns
+// the ClusterQueues of the tree.
+func (c *Cache) updateReservedQuota(cqName kueue.ClusterQueueReference, cohortName kueue.CohortReference, now time.Time) ([]kueue.ClusterQueueReference, error) {
+	if cqName != "" {
+		cq := c.hm.ClusterQueue(cqName)
+		if cq == nil {
+			return nil, nil
+		}
+		cq.resourceNode.ReservedQuota = c.reservedQuotaAt(cqName, "", now)
+		if err := cq.updateQuotaTree(); err != nil {
+			return nil, err
+		}
+		if !cq.HasParent() {
+			return []kueue.ClusterQueueReference{cqName}, nil
+		}
+		return treeClusterQueues(cq.Parent().getRootUnsafe(), nil), nil
+	}
+	cohort := c.hm.Cohort(cohortName)
+	if cohort == nil {
+		return nil, nil
+	}
+	cohort.resourceNode.ReservedQuota = c.reservedQuotaAt("", cohortName, now)
+	if err := updateCohortTreeResources(cohort); err != nil {
+		return nil, err
+	}
+	return treeClusterQueues(cohort.getRootUnsafe(), nil), nil
+}
+
+func treeClusterQueues(cohort *cohort, cqs []kueue.ClusterQueueReference) []kueue.ClusterQueueReference {
+	for _, child := range cohort.ChildCohorts() {
+		cqs = treeClusterQueues(child, cqs)
+	}
+	for _, cq := range cohort.ChildCQs() {
+		cqs = append(cqs, cq.Name)
+	}
+	return cqs
+}
+
+// QuotaReservationSnapshot is an ongoing QuotaReservation in the snapshot.
+type QuotaReservationSnapshot struct {
+	Name string
+	// Unused is the reserved quota which isn't used by the Workloads holding
+	// the reservation. It is charged as usage to the ClusterQueue or Cohort
+	// of the reservation, so that other Workloads can't use it.
+	Unused resources.FlavorResourceQuantities
+
+	node              hierarchicalResourceNode
+	allowedNamespaces sets.Set[string]
+	// usingReservedQuota are the Workloads which use the reserved quota
+	// without holding the reservation.
+	usingReservedQuota sets.Set[workload.Reference]
+}
+
+// IsHeldBy returns whether the workload holds the reservation, that is,
+// whether it references the reservation and belongs to one of its allowed
+// namespaces.
+func (r *QuotaReservationSnapshot) IsHeldBy(wl *kueue.Workload) bool {
+	return workload.QuotaReservationName(wl) == r.Name && r.allowedNamespaces.Has(wl.Namespace)
+}
+
+// UsesReservedQuota returns whether the workload uses the reserved quota
+// without holding the reservation.
+func (r *QuotaReservationSnapshot) UsesReservedQuota(wl *workload.Info) bool {
+	return r.usingReservedQuota.Has(workload.Key(wl.Obj))
+}
+
+// HeldQuotaReservation returns the ongoing QuotaReservation held by the
+// workload which covers the ClusterQueue, or nil if there is none.
+func (c *ClusterQueueSnapshot) HeldQuotaReservation(wl *kueue.Workload) *QuotaReservationSnapshot {
+	name := workload.QuotaReservationName(wl)
+	if name == "" {
+		return nil
+	}
+	if r := c.QuotaReservations[name]; r != nil && r.IsHeldBy(wl) {
+		return r
+	}
+	return nil
+}
+
+// SimulateQuotaReservationRelease modifies the snapshot by removing the
+// unused quota of the QuotaReservation held by the workload, so that the
+// workload can use it. It returns a function which can be used to charge
+// the unused quota again.
+func (s *Snapshot) SimulateQuotaReservationRelease(wl *workload.Info) func() {
+	cq := s.ClusterQueue(wl.ClusterQueue)
+	if cq == nil {
+		return func() {}
+	}
+	r := cq.HeldQuotaReservation(wl.Obj)
+	if r == nil {
+		return func() {}
+	}
+	for fr, v := range r.Unused {
+		removeUsage(r.node, fr, v)
+	}
+	return func() {
+		for fr, v := range r.Unused {
+			addUsage(r.node, fr, v)
+		}
+	}
+}
+
+// snapshotQuotaReservations charges the unused quota of the ongoing
+// QuotaReservations to their ClusterQueue or Cohort in the snapshot.
+func (c *Cache) snapshotQuotaReservations(snap *Snapshot, now time.Time) {
+	for _, name := range slices.Sorted(maps.Keys(c.quotaReservations)) {
+		r := c.quotaReservations[name]
+		if !r.window.Active(now) {
+			continue
+		}
+		var node hierarchicalResourceNode
+		var cqs []*ClusterQueueSnapshot
+		if r.clusterQueue != "" {
+			cq := snap.ClusterQueue(r.clusterQueue)
+			if cq == nil {
+				continue
+			}
+			node, cqs = cq, []*ClusterQueueSnapshot{cq}
+		} else {
+			cohort := snap.Cohort(r.cohort)
+			if cohort == nil {
+				continue
+			}
+			node, cqs = cohort, cohort.SubtreeClusterQueues()
+		}
+		qrs := &QuotaReservationSnapshot{
+			Name:               r.name,
+			Unused:             make(resources.FlavorResourceQuantities, len(r.quantities)),
+			node:               node,
+			allowedNamespaces:  r.allowedNamespaces,
+			usingReservedQuota: sets.New[workload.Reference](),
+		}
+		used := make(resources.FlavorResourceQuantities, len(r.quantities))
+		var others []*workload.Info
+		for _, cq := range cqs {
+			for _, wl := range cq.Workloads {
+				if !qrs.IsHeldBy(wl.Obj) {
+					others = append(others, wl)
+					continue
+				}
+				for fr, q := range wl.ResourceUsage().Assigned {
+					used[fr] = used[fr].Add(q)
+				}
+			}
+		}
+		qrs.markUsingReservedQuota(r.quantities, used, node.getResourceNode().SubtreeQuota, others, now)
+		for fr, q := range r.quantities {
+			if unused := q.Sub(used[fr]); unused.CmpInt64(0) > 0 {
+				qrs.Unused[fr] = unused
+				addUsage(node, fr, unused)
+			}
+		}
+		for _, cq := range cqs {
+			if cq.QuotaReservations == nil {
+				cq.QuotaReservations = make(map[string]*QuotaReservationSnapshot)
+			}
+			cq.QuotaReservations[r.name] = qrs
+		}
+	}
+}
+
+// markUsingReservedQuota finds the Workloads not holding the reservation
+// which use its reserved quota. The quota they use beyond the part of the
+// quota left to them is accounted to the ones which got their quota reserved
+// last, as the other ones fit in the quota left to them.
+func (r *QuotaReservationSnapshot) markUsingReservedQuota(reserved, heldUsage, quota resources.FlavorResourceQuantities, others []*workload.Info, now time.Time) {
+	usage := make(resources.FlavorResourceQuantities, len(reserved))
+	for _, wl := range others {
+		for fr, q := range wl.ResourceUsage().Assigned {
+			if _, found := reserved[fr]; found {
+				usage[fr] = usage[fr].Add(q)
+			}
+		}
+	}
+	excess := make(resources.FlavorResourceQuantities, len(reserved))
+	for fr, q := range reserved {
+		unreserved := quota[fr].Sub(resources.MaxAmount(q, heldUsage[fr]))
+		if e := resources.MinAmount(usage[fr], quota[fr]).Sub(unreserved); e.CmpInt64(0) > 0 {
+			excess[fr] = e
+		}
+	}
+	if len(excess) == 0 {
+		return
+	}
+	slices.SortStableFunc(others, func(a, b *workload.Info) int {
+		if c := quotaReservationTime(b.Obj, now).Compare(quotaReservationTime(a.Obj, now)); c != 0 {
+			return c
+		}
+		return strings.Compare(string(workload.Key(a.Obj)), string(workload.Key(b.Obj)))
+	})
+	for _, wl := range others {
+		if len(excess) == 0 {
+			return
+		}
+		assigned := wl.ResourceUsage().Assigned
+		usesExcess := false
+		for fr := range excess {
+			if assigned[fr].CmpInt64(0) > 0 {
+				usesExcess = true
+				break
+			}
+		}
+		if !usesExcess {
+			continue
+		}
+		r.usingReservedQuota.Insert(workload.Key(wl.Obj))
+		for fr, e := range excess {
+			if e = e.Sub(assigned[fr]); e.CmpInt64(0) > 0 {
+				excess[fr] = e
+			} else {
+				delete(excess, fr)
+			}
+		}
+	}
+}
+
+// quotaReservationTime returns the time at which the workload got its quota
+// reserved, or now if it isn't known yet.
+func quotaReservationTime(wl *kueue.Workload, now time.Time) time.Time {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return now
+	}
+	return cond.LastTransitionTime.Time
+}
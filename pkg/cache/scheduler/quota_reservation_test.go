@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestQuotaReservationLending(t *testing.T) {
+	start := time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC)
+	fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	cases := map[string]struct {
+		now              time.Time
+		deleted          bool
+		wantRequeueAfter time.Duration
+		wantCohortQuota  resources.FlavorResourceQuantities
+		wantUnused       resources.FlavorResourceQuantities
+	}{
+		"before the ramp-up": {
+			now:              start.Add(-time.Hour),
+			wantRequeueAfter: time.Hour - 1000*time.Second,
+			wantCohortQuota:  resources.FlavorResourceQuantities{fr: resources.NewAmount(20_000)},
+		},
+		"halfway through the ramp-up": {
+			now:              start.Add(-500 * time.Second),
+			wantRequeueAfter: 100 * time.Second,
+			wantCohortQuota:  resources.FlavorResourceQuantities{fr: resources.NewAmount(15_200)},
+		},
+		"during the window": {
+			now:              start.Add(time.Hour),
+			wantRequeueAfter: time.Hour,
+			wantCohortQuota:  resources.FlavorResourceQuantities{fr: resources.NewAmount(12_000)},
+			wantUnused:       resources.FlavorResourceQuantities{fr: resources.NewAmount(8_000)},
+		},
+		"after the window": {
+			now:             start.Add(3 * time.Hour),
+			wantCohortQuota: resources.FlavorResourceQuantities{fr: resources.NewAmount(20_000)},
+		},
+		"deleted during the window": {
+			now:              start.Add(time.Hour),
+			deleted:          true,
+			wantRequeueAfter: time.Hour,
+			wantCohortQuota:  resources.FlavorResourceQuantities{fr: resources.NewAmount(20_000)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.QuotaReservations, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(tc.now)))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+
+			for _, cq := range []*kueue.ClusterQueue{
+				utiltestingapi.MakeClusterQueue("cq-a").
+					Cohort("cohort").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+					Obj(),
+				utiltestingapi.MakeClusterQueue("cq-b").
+					Cohort("cohort").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+					Obj(),
+			} {
+				if err := cache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// 8 CPUs of cq-a are reserved from 09:00 to 11:00, withheld from
+			// lending over the 1000 seconds before.
+			qr := &kueue.QuotaReservation{
+				ObjectMeta: metav1.ObjectMeta{Name: "training"},
+				Spec: kueue.QuotaReservationSpec{
+					ClusterQueue:      "cq-a",
+					AllowedNamespaces: []string{"team-a"},
+					Flavor:            "default",
+					Resources:         []kueue.ReservedResource{{Name: corev1.ResourceCPU, Quantity: resource.MustParse("8")}},
+					StartTime:         metav1.NewTime(start),
+					DurationSeconds:   2 * 3600,
+					RampUpSeconds:     ptr.To[int64](1000),
+				},
+			}
+			affected, requeueAfter, err := cache.AddOrUpdateQuotaReservation(qr)
+			if err != nil {
+				t.Fatalf("Unexpected error adding the QuotaReservation: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.ClusterQueueReference{"cq-a", "cq-b"}, sets.List(affected)); diff != "" {
+				t.Errorf("Unexpected affected ClusterQueues (-want,+got):\n%s", diff)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", requeueAfter, tc.wantRequeueAfter)
+			}
+			if tc.deleted {
+				if _, err := cache.DeleteQuotaReservation(qr.Name); err != nil {
+					t.Fatalf("Unexpected error deleting the QuotaReservation: %v", err)
+				}
+			}
+			if diff := cmp.Diff(tc.wantCohortQuota, cache.hm.Cohort("cohort").getResourceNode().SubtreeQuota); diff != "" {
+				t.Errorf("Unexpected Cohort SubtreeQuota (-want,+got):\n%s", diff)
+			}
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Unexpected error taking the snapshot: %v", err)
+			}
+			var gotUnused resources.FlavorResourceQuantities
+			if r := snapshot.ClusterQueue("cq-a").QuotaReservations[qr.Name]; r != nil {
+				gotUnused = r.Unused
+			}
+			if diff := cmp.Diff(tc.wantUnused, gotUnused); diff != "" {
+				t.Errorf("Unexpected unused reserved quota (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQuotaReservationHolders(t *testing.T) {
+	start := time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC)
+	now := start.Add(time.Hour)
+	features.SetFeatureGateDuringTest(t, features.QuotaReservations, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(now)))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Obj()
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatal(err)
+	}
+	// 8 of the 10 CPUs of cq are reserved for the Workloads of team-a.
+	qr := &kueue.QuotaReservation{
+		ObjectMeta: metav1.ObjectMeta{Name: "training"},
+		Spec: kueue.QuotaReservationSpec{
+			ClusterQueue:      "cq",
+			AllowedNamespaces: []string{"team-a"},
+			Flavor:            "default",
+			Resources:         []kueue.ReservedResource{{Name: corev1.ResourceCPU, Quantity: resource.MustParse("8")}},
+			StartTime:         metav1.NewTime(start),
+			DurationSeconds:   2 * 3600,
+		},
+	}
+	if _, _, err := cache.AddOrUpdateQuotaReservation(qr); err != nil {
+		t.Fatalf("Unexpected error adding the QuotaReservation: %v", err)
+	}
+
+	admission := utiltestingapi.MakeAdmission("cq").PodSets(
+		utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "2").Obj(),
+	).Obj()
+	workloads := map[string]*kueue.Workload{
+		"holder": utiltestingapi.MakeWorkload("holder", "team-a").
+			Annotation(constants.QuotaReservationAnnotationKey, qr.Name).
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuotaAt(admission, start).
+			Obj(),
+		// The annotation is ignored outside of the allowed namespaces.
+		"intruder": utiltestingapi.MakeWorkload("intruder", "team-b").
+			Annotation(constants.QuotaReservationAnnotationKey, qr.Name).
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuotaAt(admission, start.Add(time.Minute)).
+			Obj(),
+		"oldest": utiltestingapi.MakeWorkload("oldest", "team-b").
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuotaAt(admission, start.Add(-time.Hour)).
+			Obj(),
+		"newest": utiltestingapi.MakeWorkload("newest", "team-b").
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuotaAt(admission, start.Add(2*time.Minute)).
+			Obj(),
+	}
+	for _, wl := range workloads {
+		cache.AddOrUpdateWorkload(log, wl)
+	}
+
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error taking the snapshot: %v", err)
+	}
+	cqSnapshot := snapshot.ClusterQueue("cq")
+	r := cqSnapshot.QuotaReservations[qr.Name]
+	if r == nil {
+		t.Fatal("The QuotaReservation is missing from the snapshot")
+	}
+	fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	if diff := cmp.Diff(resources.FlavorResourceQuantities{fr: resources.NewAmount(6_000)}, r.Unused); diff != "" {
+		t.Errorf("Unexpected unused reserved quota (-want,+got):\n%s", diff)
+	}
+	var gotHolders, gotUsingReservedQuota []string
+	for name, wl := range workloads {
+		if cqSnapshot.HeldQuotaReservation(wl) != nil {
+			gotHolders = append(gotHolders, name)
+		}
+		if r.UsesReservedQuota(cqSnapshot.Workloads[workload.Key(wl)]) {
+			gotUsingReservedQuota = append(gotUsingReservedQuota, name)
+		}
+	}
+	if diff := cmp.Diff([]string{"holder"}, gotHolders); diff != "" {
+		t.Errorf("Unexpected holders of the reservation (-want,+got):\n%s", diff)
+	}
+	// The 2 CPUs left to the other Workloads are used by the one which got
+	// its quota reserved first.
+	if diff := cmp.Diff([]string{"intruder", "newest"}, gotUsingReservedQuota, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected Workloads using the reserved quota (-want,+got):\n%s", diff)
+	}
+}
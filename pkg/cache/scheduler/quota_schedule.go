@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"maps"
+	"time"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/quotaschedule"
+)
+
+// scheduledQuota is a quota schedule window along with the values
+// it overrides while active.
+type scheduledQuota struct {
+	window         *quotaschedule.Window
+	nominal        *resources.Amount
+	borrowingLimit *resources.Amount
+	lendingLimit   *resources.Amount
+}
+
+// quotaSchedules holds the quotas specified for a ClusterQueue or Cohort,
+// and the windows overriding them.
+type quotaSchedules struct {
+	base    map[resources.FlavorResource]ResourceQuota
+	windows map[resources.FlavorResource][]scheduledQuota
+}
+
+func createQuotaSchedules(kueueRgs []kueue.ResourceGroup) quotaSchedules {
+	s := quotaSchedules{
+		base: createResourceQuotas(kueueRgs),
+	}
+	if !features.Enabled(features.QuotaSchedules) {
+		return s
+	}
+	for _, kueueRg := range kueueRgs {
+		for _, kueueFlavor := range kueueRg.Flavors {
+			for _, kueueQuota := range kueueFlavor.Resources {
+				fr := resources.FlavorResource{Flavor: kueueFlavor.Name, Resource: kueueQuota.Name}
+				for i := range kueueQuota.Schedules {
+					kueueSchedule := &kueueQuota.Schedules[i]
+					// Invalid schedules are rejected by the webhooks.
+					window, err := quotaschedule.Parse(kueueSchedule)
+					if err != nil {
+						continue
+					}
+					sq := scheduledQuota{window: window}
+					if kueueSchedule.NominalQuota != nil {
+						sq.nominal = new(resources.AmountFromQuantity(kueueQuota.Name, *kueueSchedule.NominalQuota))
+					}
+					if kueueSchedule.BorrowingLimit != nil {
+						sq.borrowingLimit = new(resources.AmountFromQuantity(kueueQuota.Name, *kueueSchedule.BorrowingLimit))
+					}
+					if kueueSchedule.LendingLimit != nil {
+						sq.lendingLimit = new(resources.AmountFromQuantity(kueueQuota.Name, *kueueSchedule.LendingLimit))
+					}
+					if s.windows == nil {
+						s.windows = make(map[resources.FlavorResource][]scheduledQuota)
+					}
+					s.windows[fr] = append(s.windows[fr], sq)
+				}
+			}
+		}
+	}
+	return s
+}
+
+// quotasAt returns the quotas in effect at the given time. When several
+// windows of a [flavor, resource] are active, the first one takes precedence.
+func (s *quotaSchedules) quotasAt(now time.Time) map[resources.FlavorResource]ResourceQuota {
+	if len(s.windows) == 0 {
+		return s.base
+	}
+	quotas := maps.Clone(s.base)
+	for fr, windows := range s.windows {
+		for _, sq := range windows {
+			if !sq.window.Active(now) {
+				continue
+			}
+			quota := quotas[fr]
+			if sq.nominal != nil {
+				quota.Nominal = *sq.nominal
+			}
+			if sq.borrowingLimit != nil {
+				quota.BorrowingLimit = sq.borrowingLimit
+			}
+			if sq.lendingLimit != nil {
+				quota.LendingLimit = sq.lendingLimit
+			}
+			quotas[fr] = quota
+			break
+		}
+	}
+	return quotas
+}
+
+// nextBoundary returns the earliest time after now at which any of the
+// windows starts or ends. It returns false if there are no windows.
+func (s *quotaSchedules) nextBoundary(now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, windows := range s.windows {
+		for _, sq := range windows {
+			boundary := sq.window.NextBoundary(now)
+			if !boundary.IsZero() && (next.IsZero() || boundary.Before(next)) {
+				next = boundary
+			}
+		}
+	}
+	return next, !next.IsZero()
+}
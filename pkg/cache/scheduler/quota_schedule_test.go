@@ -0,0 +1,152 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestApplyQuotaSchedules(t *testing.T) {
+	day := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	cases := map[string]struct {
+		enableQuotaSchedules bool
+		now                  time.Time
+		advance              time.Duration
+		wantChanged          bool
+		wantRequeueAfter     time.Duration
+		wantCQQuota          resources.FlavorResourceQuantities
+		wantCohortQuota      resources.FlavorResourceQuantities
+	}{
+		"feature disabled": {
+			now:             day.Add(21 * time.Hour),
+			wantCQQuota:     resources.FlavorResourceQuantities{fr: resources.NewAmount(10_000)},
+			wantCohortQuota: resources.FlavorResourceQuantities{fr: resources.NewAmount(30_000)},
+		},
+		"outside the windows": {
+			enableQuotaSchedules: true,
+			now:                  day.Add(12 * time.Hour),
+			wantRequeueAfter:     8 * time.Hour,
+			wantCQQuota:          resources.FlavorResourceQuantities{fr: resources.NewAmount(10_000)},
+			wantCohortQuota:      resources.FlavorResourceQuantities{fr: resources.NewAmount(30_000)},
+		},
+		"cohort window active": {
+			enableQuotaSchedules: true,
+			now:                  day.Add(19 * time.Hour),
+			wantRequeueAfter:     time.Hour,
+			wantCQQuota:          resources.FlavorResourceQuantities{fr: resources.NewAmount(10_000)},
+			wantCohortQuota:      resources.FlavorResourceQuantities{fr: resources.NewAmount(15_000)},
+		},
+		"both windows active": {
+			enableQuotaSchedules: true,
+			now:                  day.Add(19 * time.Hour),
+			advance:              90 * time.Minute,
+			wantChanged:          true,
+			wantRequeueAfter:     90 * time.Minute,
+			wantCQQuota:          resources.FlavorResourceQuantities{fr: resources.NewAmount(40_000)},
+			wantCohortQuota:      resources.FlavorResourceQuantities{fr: resources.NewAmount(45_000)},
+		},
+		"window started after the queues were added": {
+			enableQuotaSchedules: true,
+			now:                  day.Add(12 * time.Hour),
+			advance:              9 * time.Hour,
+			wantChanged:          true,
+			wantRequeueAfter:     time.Hour,
+			wantCQQuota:          resources.FlavorResourceQuantities{fr: resources.NewAmount(40_000)},
+			wantCohortQuota:      resources.FlavorResourceQuantities{fr: resources.NewAmount(60_000)},
+		},
+		"window ended after the queues were added": {
+			enableQuotaSchedules: true,
+			now:                  day.Add(21 * time.Hour),
+			advance:              3 * time.Hour,
+			wantChanged:          true,
+			wantRequeueAfter:     20 * time.Hour,
+			wantCQQuota:          resources.FlavorResourceQuantities{fr: resources.NewAmount(10_000)},
+			wantCohortQuota:      resources.FlavorResourceQuantities{fr: resources.NewAmount(30_000)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.QuotaSchedules, tc.enableQuotaSchedules)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(tc.now)
+			cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+
+			// The Cohort has 20 CPUs, decreased to 5 from 18:00 to 21:00.
+			if err := cache.AddOrUpdateCohort(utiltestingapi.MakeCohort("cohort").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+					ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("20").Schedule("0 18 * * *", 3*3600, "5").Append().
+					Obj()).
+				Obj()); err != nil {
+				t.Fatal(err)
+			}
+			// The ClusterQueue has 10 CPUs, increased to 40 from 20:00 to 22:00.
+			if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				Cohort("cohort").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+					ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("10").Schedule("0 20 * * *", 2*3600, "40").Append().
+					Obj()).
+				Obj()); err != nil {
+				t.Fatal(err)
+			}
+
+			fakeClock.Step(tc.advance)
+			cohortChanged, _, err := cache.ApplyCohortQuotaSchedules("cohort")
+			if err != nil {
+				t.Fatalf("Unexpected error applying Cohort quota schedules: %v", err)
+			}
+			cqChanged, requeueAfter, err := cache.ApplyClusterQueueQuotaSchedules("cq")
+			if err != nil {
+				t.Fatalf("Unexpected error applying ClusterQueue quota schedules: %v", err)
+			}
+			if gotChanged := cohortChanged || cqChanged; gotChanged != tc.wantChanged {
+				t.Errorf("Unexpected changed: got %v, want %v", gotChanged, tc.wantChanged)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", requeueAfter, tc.wantRequeueAfter)
+			}
+			if diff := cmp.Diff(tc.wantCQQuota, cache.hm.ClusterQueue("cq").getResourceNode().SubtreeQuota); diff != "" {
+				t.Errorf("Unexpected ClusterQueue SubtreeQuota (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantCohortQuota, cache.hm.Cohort("cohort").getResourceNode().SubtreeQuota); diff != "" {
+				t.Errorf("Unexpected Cohort SubtreeQuota (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyQuotaSchedulesNotFound(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.QuotaSchedules, true)
+	cache := New(utiltesting.NewFakeClient())
+	if _, _, err := cache.ApplyClusterQueueQuotaSchedules("missing"); err != ErrCqNotFound {
+		t.Errorf("Unexpected error for a missing ClusterQueue: got %v, want %v", err, ErrCqNotFound)
+	}
+	if _, _, err := cache.ApplyCohortQuotaSchedules("missing"); err != ErrCohortNotFound {
+		t.Errorf("Unexpected error for a missing Cohort: got %v, want %v", err, ErrCohortNotFound)
+	}
+}
@@ -41,6 +41,9 @@ type resourceNode struct {
 	// usage. For Cohorts, this is the sum of childrens'
 	// usages past childrens' localQuota.
 	Usage resources.FlavorResourceQuantities
+	// ReservedQuota is the quota withheld from lending to the parent
+	// Cohort by the QuotaReservations targeting this node.
+	ReservedQuota resources.FlavorResourceQuantities
 }
 
 func NewResourceNode() resourceNode {
@@ -52,12 +55,14 @@ func NewResourceNode() resourceNode {
 }
 
 // Clone clones the mutable field Usage, while returning copies to
-// Quota and SubtreeQuota (these are replaced with new maps upon update).
+// Quota, SubtreeQuota and ReservedQuota (these are replaced with new maps
+// upon update).
 func (r resourceNode) Clone() resourceNode {
 	return resourceNode{
-		Quotas:       r.Quotas,
-		SubtreeQuota: r.SubtreeQuota,
-		Usage:        maps.Clone(r.Usage),
+		Quotas:        r.Quotas,
+		SubtreeQuota:  r.SubtreeQuota,
+		Usage:         maps.Clone(r.Usage),
+		ReservedQuota: r.ReservedQuota,
 	}
 }
 
@@ -65,10 +70,14 @@ func (r resourceNode) Clone() resourceNode {
 // defined by this node due to lending limits. As a consequence,
 // this capacity will never be lent out to the parent Cohort.
 func (r resourceNode) localQuota(fr resources.FlavorResource) resources.Amount {
+	local := resources.NewAmount(0)
 	if lendingLimit := r.Quotas[fr].LendingLimit; lendingLimit != nil {
-		return resources.MaxAmount(resources.NewAmount(0), r.SubtreeQuota[fr].Sub(*lendingLimit))
+		local = resources.MaxAmount(local, r.SubtreeQuota[fr].Sub(*lendingLimit))
 	}
-	return resources.NewAmount(0)
+	if reserved, found := r.ReservedQuota[fr]; found {
+		local = resources.MaxAmount(local, resources.MinAmount(reserved, r.SubtreeQuota[fr]))
+	}
+	return local
 }
 
 // hierarchicalResourceNode extends flatResourceNode
@@ -262,6 +262,12 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 			}
 		}
 	}
+	if features.Enabled(features.QuotaReservations) {
+		c.snapshotQuotaReservations(&snap, c.clock.Now())
+	}
+	if features.Enabled(features.CheckpointAwarePreemption) {
+		c.snapshotPreemptionReservations(&snap, c.clock.Now())
+	}
 	// Shallow copy is enough
 	maps.Copy(snap.ResourceFlavors, c.resourceFlavors)
 	return &snap, nil
@@ -327,10 +333,20 @@ func (c *Cache) snapshotClusterQueue(
 		tasOnly:                       cq.isTASOnly(),
 		flavorsForProvReqACs:          cq.flavorsWithProvReqAdmissionCheck(),
 		hasMultiKueueAC:               cq.hasMultiKueueAdmissionCheck(),
+		PreemptionBudgetUsage:         cq.preemptionBudgetUsage(c.clock.Now()),
 	}
 	for i, rg := range cq.ResourceGroups {
 		cc.ResourceGroups[i] = rg.Clone()
 	}
+	if features.Enabled(features.PriorityDecay) && cq.Preemption.PriorityDecay != nil {
+		for key, wl := range cc.Workloads {
+			// The Infos in cc.Workloads are shared with the live cache, so the
+			// policy is stored on snapshot-owned copies.
+			wlCopy := *wl
+			wlCopy.PriorityDecay = cq.Preemption.PriorityDecay
+			cc.Workloads[key] = &wlCopy
+		}
+	}
 	if afs.Enabled(c.admissionFairSharing) {
 		if cq.AdmissionScope != nil {
 			cc.AdmissionScope = *cq.AdmissionScope.DeepCopy()
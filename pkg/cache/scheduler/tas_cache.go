@@ -28,6 +28,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/cache/scheduler/simulator"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
@@ -109,6 +110,9 @@ func (t *tasCache) AddTopology(topology *kueue.Topology) {
 	tInfo := topologyInformation{
 		Levels: utiltas.Levels(topology),
 	}
+	if features.Enabled(features.TASDeviceTopologyLevels) {
+		tInfo.DeviceLevels = utiltas.DeviceLevels(topology)
+	}
 	t.topologies[name] = tInfo
 	for fName, flavorInfo := range t.flavors {
 		if flavorInfo.TopologyName != name {
@@ -168,6 +172,25 @@ func (t *tasCache) SyncNode(node *corev1.Node) {
 	t.nodesCache.sync(node)
 }
 
+// SyncNodeDeviceLabels replaces the values of the Topology levels read from the
+// DRA devices of the node. It returns true if the nodes matching the flavors
+// may have changed.
+func (t *tasCache) SyncNodeDeviceLabels(nodeName string, labels map[string]string) bool {
+	return t.nodesCache.syncDeviceLabels(nodeName, labels)
+}
+
+// DeviceTopologyLevels returns the levels of all the Topologies which are
+// backed by a device attribute.
+func (t *tasCache) DeviceTopologyLevels() []kueue.TopologyLevel {
+	t.RLock()
+	defer t.RUnlock()
+	var levels []kueue.TopologyLevel
+	for _, name := range slices.Sorted(maps.Keys(t.topologies)) {
+		levels = append(levels, t.topologies[name].DeviceLevels...)
+	}
+	return levels
+}
+
 func (t *tasCache) DeleteNodeByName(nodeName string) {
 	t.nodesCache.delete(nodeName)
 }
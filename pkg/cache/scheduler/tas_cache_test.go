@@ -65,6 +65,7 @@ func TestFindTopologyAssignments(t *testing.T) {
 		tasBlockLabel      = "cloud.com/topology-block"
 		tasRackLabel       = "cloud.com/topology-rack"
 		tasSubBlockLabel   = "cloud.com/topology-subblock"
+		tasCliqueLabel     = "example.com/nvlink-clique"
 	)
 
 	//      b1                   b2
@@ -397,7 +398,62 @@ func TestFindTopologyAssignments(t *testing.T) {
 		priorOwnUsage          []workload.TopologyDomainRequests
 		workload               *kueue.Workload
 		podSets                []PodSetTestCase
+		deviceLabels           map[string]map[string]string
 	}{
+		"required level backed by a device attribute": {
+			nodes: []corev1.Node{
+				*testingnode.MakeNode("x1").
+					Label(tasBlockLabel, "b1").
+					Label(corev1.LabelHostname, "x1").
+					StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourcePods: resource.MustParse("10")}).
+					Ready().
+					Obj(),
+				*testingnode.MakeNode("x2").
+					Label(tasBlockLabel, "b1").
+					Label(corev1.LabelHostname, "x2").
+					StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourcePods: resource.MustParse("10")}).
+					Ready().
+					Obj(),
+				*testingnode.MakeNode("x3").
+					Label(tasBlockLabel, "b1").
+					Label(corev1.LabelHostname, "x3").
+					StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourcePods: resource.MustParse("10")}).
+					Ready().
+					Obj(),
+				*testingnode.MakeNode("x4").
+					Label(tasBlockLabel, "b1").
+					Label(corev1.LabelHostname, "x4").
+					StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+					Ready().
+					Obj(),
+				*testingnode.MakeNode("x5").
+					Label(tasBlockLabel, "b1").
+					Label(corev1.LabelHostname, "x5").
+					StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+					Ready().
+					Obj(),
+			},
+			// x5 publishes no devices, so it doesn't belong to any clique.
+			deviceLabels: map[string]map[string]string{
+				"x1": {tasCliqueLabel: "c1"},
+				"x2": {tasCliqueLabel: "c1"},
+				"x3": {tasCliqueLabel: "c2"},
+				"x4": {tasCliqueLabel: "c2"},
+			},
+			levels: []string{tasBlockLabel, tasCliqueLabel, corev1.LabelHostname},
+			podSets: []PodSetTestCase{{
+				topologyRequest: &kueue.PodSetTopologyRequest{Required: new(tasCliqueLabel)},
+				requests:        map[corev1.ResourceName]int64{corev1.ResourceCPU: 1000},
+				count:           3,
+				wantAssignment: &tas.TopologyAssignment{
+					Levels: defaultOneLevel,
+					Domains: []tas.TopologyDomainAssignment{
+						{Count: 1, Values: []string{"x3"}},
+						{Count: 2, Values: []string{"x4"}},
+					},
+				},
+			}},
+		},
 		"node replacement skipped for single-Pod-owned workload; gate on": {
 			featureGates: map[featuregate.Feature]bool{features.SkipReassignmentForPodOwnedWorkloads: true},
 			nodes: []corev1.Node{
@@ -8353,6 +8409,9 @@ func TestFindTopologyAssignments(t *testing.T) {
 				for i := range tc.nodes {
 					tasCache.SyncNode(&tc.nodes[i])
 				}
+				for nodeName, labels := range tc.deviceLabels {
+					tasCache.SyncNodeDeviceLabels(nodeName, labels)
+				}
 
 				topologyInformation := topologyInformation{
 					Levels: tc.levels,
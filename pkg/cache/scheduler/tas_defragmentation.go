@@ -0,0 +1,276 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// DefragmentationPlan is the set of admitted workloads which should be evicted
+// to free a topology domain for a pending workload.
+type DefragmentationPlan struct {
+	// Flavor is the TAS flavor of the freed domain.
+	Flavor kueue.ResourceFlavorReference
+	// Domain is the ID of the freed domain.
+	Domain utiltas.TopologyDomainID
+	// Victims are the workloads to evict, sorted by their keys.
+	Victims []*workload.Info
+}
+
+// FindDefragmentationPlan returns the plan with the fewest victims, but no more
+// than maxVictims, which frees a domain at the topology level required by the
+// pending workload in one of the TAS flavors of the ClusterQueue. The victims
+// are the admitted workloads, accepted by isCandidate, which are placed in the
+// domain. The plan is only returned when, after evicting the victims, the
+// pending workload fits, and the victims can be placed again in the remaining
+// capacity of the flavor.
+//
+// It returns nil when the pending workload doesn't require a topology level,
+// already fits in one of the flavors, or no such plan exists.
+func (s *Snapshot) FindDefragmentationPlan(
+	ctx context.Context,
+	cqName kueue.ClusterQueueReference,
+	wl *workload.Info,
+	isCandidate func(*workload.Info) bool,
+	maxVictims int,
+) *DefragmentationPlan {
+	cq := s.ClusterQueue(cqName)
+	if cq == nil || maxVictims <= 0 {
+		return nil
+	}
+	var best *DefragmentationPlan
+	for _, flavor := range slices.Sorted(maps.Keys(cq.TASFlavors)) {
+		plan, fits := s.findDefragmentationPlanForFlavor(ctx, cq, flavor, wl, isCandidate, maxVictims)
+		if fits {
+			return nil
+		}
+		if plan != nil && (best == nil || len(plan.Victims) < len(best.Victims)) {
+			best = plan
+		}
+	}
+	return best
+}
+
+// findDefragmentationPlanForFlavor returns the defragmentation plan for the
+// flavor, and whether the pending workload already fits in the flavor.
+func (s *Snapshot) findDefragmentationPlanForFlavor(
+	ctx context.Context,
+	cq *ClusterQueueSnapshot,
+	flavor kueue.ResourceFlavorReference,
+	wl *workload.Info,
+	isCandidate func(*workload.Info) bool,
+	maxVictims int,
+) (*DefragmentationPlan, bool) {
+	tasSnapshot := cq.TASFlavors[flavor]
+	requests, levelKey := pendingTASRequests(wl, flavor)
+	if len(requests) == 0 {
+		return nil, false
+	}
+	levelIdx, found := tasSnapshot.resolveLevelIdx(levelKey)
+	if !found {
+		return nil, false
+	}
+	if s.fitsInFlavor(ctx, cq, tasSnapshot, flavor, wl, requests, nil) {
+		return nil, true
+	}
+
+	victimsPerDomain := make(map[utiltas.TopologyDomainID][]*workload.Info)
+	for _, admittedCQ := range s.ClusterQueues() {
+		for _, admitted := range admittedCQ.Workloads {
+			if !isCandidate(admitted) {
+				continue
+			}
+			for domainID := range tasSnapshot.domainsAtLevel(admitted.TASUsage()[flavor], levelIdx) {
+				victimsPerDomain[domainID] = append(victimsPerDomain[domainID], admitted)
+			}
+		}
+	}
+	domainIDs := slices.Collect(maps.Keys(victimsPerDomain))
+	slices.SortFunc(domainIDs, func(a, b utiltas.TopologyDomainID) int {
+		if diff := len(victimsPerDomain[a]) - len(victimsPerDomain[b]); diff != 0 {
+			return diff
+		}
+		return strings.Compare(string(a), string(b))
+	})
+	for _, domainID := range domainIDs {
+		victims := victimsPerDomain[domainID]
+		if len(victims) > maxVictims {
+			break
+		}
+		slices.SortFunc(victims, func(a, b *workload.Info) int {
+			return strings.Compare(string(workload.Key(a.Obj)), string(workload.Key(b.Obj)))
+		})
+		restore := s.SimulateWorkloadRemoval(victims)
+		fits := s.fitsInFlavor(ctx, cq, tasSnapshot, flavor, wl, requests, victims)
+		restore()
+		if fits {
+			return &DefragmentationPlan{Flavor: flavor, Domain: domainID, Victims: victims}, false
+		}
+	}
+	return nil, false
+}
+
+// fitsInFlavor checks if the pending workload fits in the quota and the
+// topology of the flavor, and the victims can then be placed again in the
+// flavor.
+func (s *Snapshot) fitsInFlavor(
+	ctx context.Context,
+	cq *ClusterQueueSnapshot,
+	tasSnapshot *TASFlavorSnapshot,
+	flavor kueue.ResourceFlavorReference,
+	wl *workload.Info,
+	requests FlavorTASRequests,
+	victims []*workload.Info,
+) bool {
+	for _, tr := range requests {
+		for resource, quantity := range tr.TotalRequests().Iter() {
+			rg := cq.RGByResource(resource)
+			if rg == nil || !slices.Contains(rg.Flavors, flavor) {
+				continue
+			}
+			if cq.Available(resources.FlavorResource{Flavor: flavor, Resource: resource}).CmpInt64(quantity) < 0 {
+				return false
+			}
+		}
+	}
+	result := tasSnapshot.FindTopologyAssignmentsForFlavor(ctx, requests, WithWorkload(wl.Obj))
+	if result.Failure() != nil {
+		return false
+	}
+	if len(victims) == 0 {
+		return true
+	}
+
+	assumedUsage := make(map[utiltas.TopologyDomainID]resources.Requests)
+	defer func() {
+		for domainID, usage := range assumedUsage {
+			tasSnapshot.removeTASUsage(domainID, usage)
+		}
+	}()
+	assume := func(requests FlavorTASRequests, result TASAssignmentsResult) {
+		usage := make(map[utiltas.TopologyDomainID]resources.Requests)
+		for i := range requests {
+			addAssumedUsage(usage, result[requests[i].PodSet.Name].TopologyAssignment, &requests[i])
+		}
+		for domainID, u := range usage {
+			tasSnapshot.addTASUsage(domainID, u)
+		}
+		addUsagePerDomain(assumedUsage, usage)
+	}
+	assume(requests, result)
+	for _, victim := range victims {
+		victimRequests := admittedTASRequests(victim, flavor)
+		if len(victimRequests) == 0 {
+			continue
+		}
+		victimResult := tasSnapshot.FindTopologyAssignmentsForFlavor(ctx, victimRequests, WithWorkload(victim.Obj))
+		if victimResult.Failure() != nil {
+			return false
+		}
+		assume(victimRequests, victimResult)
+	}
+	return true
+}
+
+// domainsAtLevel returns the IDs of the domains at the level which hold the
+// usage.
+func (s *TASFlavorSnapshot) domainsAtLevel(usage workload.TASFlavorUsage, levelIdx int) sets.Set[utiltas.TopologyDomainID] {
+	domainIDs := sets.New[utiltas.TopologyDomainID]()
+	for _, domainUsage := range usage {
+		leaf, found := s.leaves[utiltas.DomainID(domainUsage.Values)]
+		if !found {
+			continue
+		}
+		d := &leaf.domain
+		for i := len(s.levelKeys) - 1; i > levelIdx && d != nil; i-- {
+			d = d.parent
+		}
+		if d != nil {
+			domainIDs.Insert(d.id)
+		}
+	}
+	return domainIDs
+}
+
+// pendingTASRequests returns the TAS requests of the pending workload in the
+// flavor, and the topology level required by the workload, if any of its
+// PodSets requires a topology level.
+func pendingTASRequests(wl *workload.Info, flavor kueue.ResourceFlavorReference) (FlavorTASRequests, string) {
+	var levelKey string
+	requests := make(FlavorTASRequests, 0, len(wl.Obj.Spec.PodSets))
+	for i := range wl.Obj.Spec.PodSets {
+		ps := &wl.Obj.Spec.PodSets[i]
+		if ps.TopologyRequest != nil && ps.TopologyRequest.Required != nil && levelKey == "" {
+			levelKey = *ps.TopologyRequest.Required
+		}
+		requests = append(requests, TASPodSetRequests{
+			Count:             ps.Count,
+			SinglePodRequests: resources.NewRequestsFromPodSpec(&ps.Template.Spec),
+			PodSet:            ps,
+			Flavor:            flavor,
+			Implied:           ps.TopologyRequest == nil,
+			PodSetGroupName:   podSetGroupName(ps),
+		})
+	}
+	if levelKey == "" {
+		return nil, ""
+	}
+	return requests, levelKey
+}
+
+// admittedTASRequests returns the TAS requests of the PodSets of the admitted
+// workload which are placed in the flavor.
+func admittedTASRequests(wl *workload.Info, flavor kueue.ResourceFlavorReference) FlavorTASRequests {
+	if wl.Obj.Status.Admission == nil {
+		return nil
+	}
+	var requests FlavorTASRequests
+	for i := range wl.Obj.Spec.PodSets {
+		ps := &wl.Obj.Spec.PodSets[i]
+		psa := findPSA(wl.Obj, ps.Name)
+		if psa == nil || psa.TopologyAssignment == nil || !slices.Contains(slices.Collect(maps.Values(psa.Flavors)), flavor) {
+			continue
+		}
+		requests = append(requests, TASPodSetRequests{
+			Count:             ptr.Deref(psa.Count, ps.Count),
+			SinglePodRequests: resources.NewRequestsFromPodSpec(&ps.Template.Spec),
+			PodSet:            ps,
+			Flavor:            flavor,
+			Implied:           ps.TopologyRequest == nil,
+			PodSetGroupName:   podSetGroupName(ps),
+		})
+	}
+	return requests
+}
+
+func podSetGroupName(ps *kueue.PodSet) *string {
+	if ps.TopologyRequest == nil {
+		return nil
+	}
+	return ps.TopologyRequest.PodSetGroupName
+}
@@ -0,0 +1,160 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestFindDefragmentationPlan(t *testing.T) {
+	const (
+		rackLabel = "cloud.com/topology-rack"
+		cqName    = "tas-cq"
+		flavor    = "tas-flavor"
+	)
+	now := time.Now()
+
+	node := func(rack, host string) corev1.Node {
+		return *testingnode.MakeNode(host).
+			Label(rackLabel, rack).
+			Label(corev1.LabelHostname, host).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("1"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+	//     r1          r2
+	//   /    \      /    \
+	//  x1    x2    x3    x4
+	nodes := []corev1.Node{node("r1", "x1"), node("r1", "x2"), node("r2", "x3"), node("r2", "x4")}
+
+	admitted := func(name, host string, priority int32) *kueue.Workload {
+		return utiltestingapi.MakeWorkload(name, "default").
+			Priority(priority).
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 1).
+				Request(corev1.ResourceCPU, "1").
+				Obj()).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission(cqName).PodSets(
+				utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, flavor, "1").
+					TopologyAssignment(utiltestingapi.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+						Domain(utiltestingapi.MakeTopologyDomainAssignment([]string{host}, 1).Obj()).
+						Obj()).
+					Obj(),
+			).Obj(), now).
+			Obj()
+	}
+	pending := utiltestingapi.MakeWorkload("pending", "default").
+		Priority(100).
+		PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 2).
+			Request(corev1.ResourceCPU, "1").
+			RequiredTopologyRequest(rackLabel).
+			Obj()).
+		Obj()
+
+	lowPriority := func(wl *workload.Info) bool {
+		return wl.Obj.Spec.Priority != nil && *wl.Obj.Spec.Priority < 100
+	}
+
+	cases := map[string]struct {
+		workloads   []*kueue.Workload
+		isCandidate func(*workload.Info) bool
+		maxVictims  int
+		wantDomain  string
+		wantVictims []string
+	}{
+		"pending workload fits": {
+			workloads:   []*kueue.Workload{admitted("a", "x1", 0)},
+			isCandidate: lowPriority,
+			maxVictims:  5,
+		},
+		"evicts the workload fragmenting a rack": {
+			workloads:   []*kueue.Workload{admitted("a", "x1", 0), admitted("b", "x3", 0)},
+			isCandidate: lowPriority,
+			maxVictims:  5,
+			wantDomain:  "r1",
+			wantVictims: []string{"a"},
+		},
+		"victims are not candidates": {
+			workloads:   []*kueue.Workload{admitted("a", "x1", 100), admitted("b", "x3", 100)},
+			isCandidate: lowPriority,
+			maxVictims:  5,
+		},
+		"victims can't be placed again": {
+			workloads:   []*kueue.Workload{admitted("a", "x1", 0), admitted("b", "x3", 0), admitted("c", "x4", 0)},
+			isCandidate: lowPriority,
+			maxVictims:  5,
+		},
+		"victims exceed the budget": {
+			workloads:   []*kueue.Workload{admitted("a", "x1", 0), admitted("b", "x2", 0), admitted("c", "x3", 0), admitted("d", "x4", 50)},
+			isCandidate: func(wl *workload.Info) bool { return lowPriority(wl) && wl.Obj.Name != "d" },
+			maxVictims:  1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient())
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor(flavor).TopologyName("topology").Obj())
+			cache.AddOrUpdateTopology(log, utiltestingapi.MakeTopology("topology").Levels(rackLabel, corev1.LabelHostname).Obj())
+			if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(cqName).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas(flavor).Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()); err != nil {
+				t.Fatalf("Failed adding ClusterQueue: %v", err)
+			}
+			for i := range nodes {
+				cache.TASCache().SyncNode(&nodes[i])
+			}
+			for _, wl := range tc.workloads {
+				cache.AddOrUpdateWorkload(log, wl)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Failed building the snapshot: %v", err)
+			}
+
+			plan := snapshot.FindDefragmentationPlan(ctx, cqName, workload.NewInfo(pending), tc.isCandidate, tc.maxVictims)
+			var gotDomain string
+			var gotVictims []string
+			if plan != nil {
+				gotDomain = string(plan.Domain)
+				for _, victim := range plan.Victims {
+					gotVictims = append(gotVictims, victim.Obj.Name)
+				}
+			}
+			if gotDomain != tc.wantDomain {
+				t.Errorf("Unexpected domain, want %q, got %q", tc.wantDomain, gotDomain)
+			}
+			if diff := cmp.Diff(tc.wantVictims, gotVictims); diff != "" {
+				t.Errorf("Unexpected victims (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
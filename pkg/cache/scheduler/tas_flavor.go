@@ -67,6 +67,10 @@ type topologyInformation struct {
 	// levels is a list of levels defined in the Topology object referenced
 	// by the flavor corresponding to the cache.
 	Levels []string
+
+	// deviceLevels lists the levels backed by a device attribute. Their
+	// values are merged into the node labels by the nodesCache.
+	DeviceLevels []kueue.TopologyLevel
 }
 
 type TASFlavorCache struct {
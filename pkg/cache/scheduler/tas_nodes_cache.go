@@ -46,12 +46,19 @@ type nodesCache struct {
 
 	// schedulableAndReadyNodes tracks node names that are both schedulable and ready
 	schedulableAndReadyNodes sets.Set[string]
+
+	// deviceLabels stores, per node name, the values of the Topology levels
+	// read from the attributes of the DRA devices published for the node,
+	// keyed by the node label naming the level. They are merged into the
+	// node labels when finding the nodes of a flavor.
+	deviceLabels map[string]map[string]string
 }
 
 func newNodesCache() *nodesCache {
 	return &nodesCache{
 		nodes:                    make(map[string]*corev1.Node),
 		schedulableAndReadyNodes: sets.New[string](),
+		deviceLabels:             make(map[string]map[string]string),
 	}
 }
 
@@ -101,6 +108,27 @@ func (t *nodesCache) deleteWithoutLock(nodeName string) {
 	}
 }
 
+// syncDeviceLabels replaces the values of the Topology levels read from the
+// devices of the node. It returns true if the change affects a node in the
+// cache.
+func (t *nodesCache) syncDeviceLabels(nodeName string, labels map[string]string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if maps.Equal(t.deviceLabels[nodeName], labels) {
+		return false
+	}
+	if len(labels) == 0 {
+		delete(t.deviceLabels, nodeName)
+	} else {
+		t.deviceLabels[nodeName] = maps.Clone(labels)
+	}
+	if _, found := t.nodes[nodeName]; !found {
+		return false
+	}
+	t.generation++
+	return true
+}
+
 // find returns the nodes matching the flavor along with the generation at
 // which they were read, so that structures derived from the result can later
 // be revalidated against currentGeneration.
@@ -116,6 +144,9 @@ func (t *nodesCache) find(nodeLabels map[string]string, levels []string) ([]*cor
 		if shouldExcludeUnschedulableAndNotReadyNodes && !t.schedulableAndReadyNodes.Has(node.Name) {
 			continue
 		}
+		if deviceLabels, found := t.deviceLabels[node.Name]; found {
+			node = withDeviceLabels(node, deviceLabels)
+		}
 		if utiltas.NodeMatchesFlavor(node.Labels, nodeLabels, levels) {
 			filteredNodes = append(filteredNodes, node)
 		}
@@ -150,6 +181,17 @@ func copyAndStripNode(node *corev1.Node) *corev1.Node {
 	}
 }
 
+// withDeviceLabels returns a copy of the stripped node with the device labels
+// merged into its labels. Device labels take precedence over the node labels.
+func withDeviceLabels(node *corev1.Node, deviceLabels map[string]string) *corev1.Node {
+	labels := make(map[string]string, len(node.Labels)+len(deviceLabels))
+	maps.Copy(labels, node.Labels)
+	maps.Copy(labels, deviceLabels)
+	merged := *node
+	merged.Labels = labels
+	return &merged
+}
+
 func (t *nodesCache) getAllNodes() []*corev1.Node {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
@@ -156,6 +156,36 @@ func TestNodesCacheFind(t *testing.T) {
 	}
 }
 
+func TestNodesCacheFindWithDeviceLabels(t *testing.T) {
+	const (
+		blockLevel  = "cloud.provider.com/topology-block"
+		cliqueLevel = "example.com/nvlink-clique"
+	)
+	nc := newNodesCache()
+	node1 := node.MakeNode("test1").Label(blockLevel, "b1").Obj()
+	node2 := node.MakeNode("test2").Label(blockLevel, "b1").Obj()
+	node3 := node.MakeNode("test3").Label(blockLevel, "b1").Label(cliqueLevel, "stale").Obj()
+	for _, n := range []*corev1.Node{node1, node2, node3} {
+		nc.nodes[n.Name] = copyAndStripNode(n)
+	}
+	nc.syncDeviceLabels("test1", map[string]string{cliqueLevel: "c1"})
+	nc.syncDeviceLabels("test3", map[string]string{cliqueLevel: "c2"})
+
+	gotNodes, _ := nc.find(nil, []string{blockLevel, cliqueLevel})
+	wantNodes := []*corev1.Node{
+		copyAndStripNode(node.MakeNode("test1").Label(blockLevel, "b1").Label(cliqueLevel, "c1").Obj()),
+		copyAndStripNode(node.MakeNode("test3").Label(blockLevel, "b1").Label(cliqueLevel, "c2").Obj()),
+	}
+	if diff := cmp.Diff(wantNodes, gotNodes, cmpopts.SortSlices(func(a, b *corev1.Node) bool {
+		return a.Name < b.Name
+	})); diff != "" {
+		t.Errorf("Unexpected nodes (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{blockLevel: "b1", cliqueLevel: "stale"}, nc.nodes["test3"].Labels); diff != "" {
+		t.Errorf("Unexpected mutation of the cached node labels (-want,+got):\n%s", diff)
+	}
+}
+
 func TestNodesCacheGeneration(t *testing.T) {
 	baseNode := func() *node.NodeWrapper {
 		return node.MakeNode("gen-test").
@@ -258,6 +288,26 @@ func TestNodesCacheGeneration(t *testing.T) {
 			},
 			wantDelta: 0,
 		},
+		"device labels change bumps": {
+			prime: []*corev1.Node{baseNode().Obj()},
+			op: func(nc *nodesCache) {
+				nc.syncDeviceLabels("gen-test", map[string]string{"example.com/nvlink-clique": "c1"})
+			},
+			wantDelta: 1,
+		},
+		"device labels of an absent node do not bump": {
+			op: func(nc *nodesCache) {
+				nc.syncDeviceLabels("other", map[string]string{"example.com/nvlink-clique": "c1"})
+			},
+			wantDelta: 0,
+		},
+		"no device labels for a node without device labels does not bump": {
+			prime: []*corev1.Node{baseNode().Obj()},
+			op: func(nc *nodesCache) {
+				nc.syncDeviceLabels("gen-test", nil)
+			},
+			wantDelta: 0,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -80,7 +80,8 @@ type topologyTree struct {
 	generation int64
 
 	// levelKeys denotes the ordered list of topology keys set as label keys
-	// on the Topology object
+	// on the Topology object. The values of the levels backed by a device
+	// attribute are read from the labels merged into the nodes by nodesCache.
 	levelKeys []string
 
 	// leaves maps domainID to domains that are at the lowest level of topology structure
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
@@ -82,6 +83,8 @@ var (
 	visibilityServerBindPortPath          = field.NewPath("visibilityServer", "bindPort")
 	customLabelsPath                      = field.NewPath("metrics", "customLabels")
 	resourceQuotaCheckStrategyPath        = field.NewPath("resources", "quotaCheckStrategy")
+	admissionDecisionLogPathPath          = field.NewPath("admissionDecisionLog", "path")
+	tasDefragmentationPath                = field.NewPath("tasDefragmentation")
 	// Values in this map should never exceed metrics.MaxCustomLabelsForSourceKind.
 	maxCustomLabelsPerSourceKind = map[configapi.SourceKind]int{
 		configapi.SourceKindWorkload:     min(2, metrics.MaxCustomLabelsForSourceKind),
@@ -108,6 +111,8 @@ func Validate(c *configapi.Configuration, scheme *runtime.Scheme, integrationMan
 	allErrs = append(allErrs, validateVisibilityServer(c)...)
 	allErrs = append(allErrs, validateCustomLabels(c)...)
 	allErrs = append(allErrs, validateQuotaCheckStrategy(c)...)
+	allErrs = append(allErrs, validateAdmissionDecisionLog(c)...)
+	allErrs = append(allErrs, validateTASDefragmentation(c)...)
 	return allErrs
 }
 
@@ -168,6 +173,13 @@ func validateMultiKueue(c *configapi.Configuration, integrationManager *jobframe
 			allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("workerLostTimeout"),
 				c.MultiKueue.WorkerLostTimeout.Duration, apimachineryvalidation.IsNegativeErrorMsg))
 		}
+		if c.MultiKueue.MigrationCooldown != nil && c.MultiKueue.MigrationCooldown.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("migrationCooldown"),
+				c.MultiKueue.MigrationCooldown.Duration, apimachineryvalidation.IsNegativeErrorMsg))
+		}
+		if ch := c.MultiKueue.ClusterHealth; ch != nil {
+			allErrs = append(allErrs, validateClusterHealth(ch, multiKueuePath.Child("clusterHealth"))...)
+		}
 		if c.MultiKueue.Origin != nil {
 			if errs := content.IsLabelValue(*c.MultiKueue.Origin); len(errs) != 0 {
 				allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("origin"), *c.MultiKueue.Origin, strings.Join(errs, ",")))
@@ -233,10 +245,55 @@ func validateMultiKueue(c *configapi.Configuration, integrationManager *jobframe
 					"must be greater than or equal to 1"))
 			}
 		}
+
+		if ptr.Deref(c.MultiKueue.DispatcherName, "") == configapi.MultiKueueDispatcherModeCapacityAware && !features.Enabled(features.MultiKueueCapacityAwareDispatcher) {
+			allErrs = append(allErrs, field.Invalid(multiKueuePath.Child("dispatcherName"), *c.MultiKueue.DispatcherName,
+				"the capacity-aware dispatcher requires enabling the MultiKueueCapacityAwareDispatcher feature gate"))
+		}
+		if cdc := c.MultiKueue.CapacityAwareDispatcherConfig; cdc != nil {
+			cdcPath := multiKueuePath.Child("capacityAwareDispatcherConfig")
+			if ptr.Deref(c.MultiKueue.DispatcherName, "") != configapi.MultiKueueDispatcherModeCapacityAware {
+				allErrs = append(allErrs, field.Invalid(cdcPath, cdc,
+					"capacityAwareDispatcherConfig is only valid when dispatcherName is set to the capacity-aware dispatcher"))
+			}
+			if cdc.MaxNominatedClusters != nil && *cdc.MaxNominatedClusters < 1 {
+				allErrs = append(allErrs, field.Invalid(cdcPath.Child("maxNominatedClusters"), *cdc.MaxNominatedClusters,
+					"must be greater than or equal to 1"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateClusterHealth(ch *configapi.ClusterHealthConfig, chPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ch.Window != nil && ch.Window.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(chPath.Child("window"), ch.Window.Duration, "must be greater than 0"))
+	}
+	if ch.MinSamples != nil && *ch.MinSamples < 1 {
+		allErrs = append(allErrs, field.Invalid(chPath.Child("minSamples"), *ch.MinSamples,
+			"must be greater than or equal to 1"))
+	}
+	if ch.MinDispatchSuccessPercent != nil {
+		allErrs = append(allErrs, validatePercent(*ch.MinDispatchSuccessPercent, chPath.Child("minDispatchSuccessPercent"))...)
+	}
+	if ch.MaxAverageAdmissionLatency != nil && ch.MaxAverageAdmissionLatency.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(chPath.Child("maxAverageAdmissionLatency"),
+			ch.MaxAverageAdmissionLatency.Duration, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	if ch.MaxEvictionPercent != nil {
+		allErrs = append(allErrs, validatePercent(*ch.MaxEvictionPercent, chPath.Child("maxEvictionPercent"))...)
 	}
 	return allErrs
 }
 
+func validatePercent(value int32, fldPath *field.Path) field.ErrorList {
+	if value < 0 || value > 100 {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be between 0 and 100")}
+	}
+	return nil
+}
+
 func validateClusterProfileAccessProviders(providers []configapi.ClusterProfileAccessProvider, providersPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	execConfigPath := providersPath.Child("execConfig")
@@ -736,6 +793,33 @@ func validateVisibilityServer(c *configapi.Configuration) field.ErrorList {
 	return allErrs
 }
 
+func validateAdmissionDecisionLog(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.AdmissionDecisionLog == nil {
+		return allErrs
+	}
+	if path := c.AdmissionDecisionLog.Path; len(path) == 0 {
+		allErrs = append(allErrs, field.Required(admissionDecisionLogPathPath, ""))
+	} else if !filepath.IsAbs(path) {
+		allErrs = append(allErrs, field.Invalid(admissionDecisionLogPathPath, path, "must be an absolute path"))
+	}
+	return allErrs
+}
+
+func validateTASDefragmentation(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.TASDefragmentation == nil {
+		return allErrs
+	}
+	if interval := c.TASDefragmentation.Interval; interval != nil && interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(tasDefragmentationPath.Child("interval"), interval.Duration, "must be greater than 0"))
+	}
+	if maxEvictions := c.TASDefragmentation.MaxEvictionsPerRound; maxEvictions != nil && *maxEvictions <= 0 {
+		allErrs = append(allErrs, field.Invalid(tasDefragmentationPath.Child("maxEvictionsPerRound"), *maxEvictions, "must be greater than 0"))
+	}
+	return allErrs
+}
+
 var customLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
 
 func validateCustomLabels(c *configapi.Configuration) field.ErrorList {
@@ -402,6 +402,72 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"negative multiKueue.migrationCooldown": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					MigrationCooldown: &metav1.Duration{
+						Duration: -time.Second,
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.migrationCooldown",
+				},
+			},
+		},
+		"valid multiKueue.clusterHealth": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ClusterHealth: &configapi.ClusterHealthConfig{
+						Window:                     &metav1.Duration{Duration: time.Hour},
+						MinSamples:                 new(int32(5)),
+						MinDispatchSuccessPercent:  new(int32(90)),
+						MaxAverageAdmissionLatency: &metav1.Duration{Duration: 10 * time.Minute},
+						MaxEvictionPercent:         new(int32(20)),
+					},
+				},
+			},
+		},
+		"invalid multiKueue.clusterHealth": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ClusterHealth: &configapi.ClusterHealthConfig{
+						Window:                     &metav1.Duration{},
+						MinSamples:                 new(int32(0)),
+						MinDispatchSuccessPercent:  new(int32(101)),
+						MaxAverageAdmissionLatency: &metav1.Duration{Duration: -time.Second},
+						MaxEvictionPercent:         new(int32(-1)),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.clusterHealth.window",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.clusterHealth.minSamples",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.clusterHealth.minDispatchSuccessPercent",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.clusterHealth.maxAverageAdmissionLatency",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.clusterHealth.maxEvictionPercent",
+				},
+			},
+		},
 		"invalid .multiKueue.origin label value": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -475,6 +541,67 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid multiKueue.capacityAwareDispatcherConfig with capacity-aware dispatcher": {
+			featureGates: map[featuregate.Feature]bool{features.MultiKueueCapacityAwareDispatcher: true},
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &configapi.CapacityAwareDispatcherConfig{
+						MaxNominatedClusters: new(int32(3)),
+					},
+				},
+			},
+		},
+		"capacity-aware dispatcher without the feature gate": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeCapacityAware),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.dispatcherName",
+				},
+			},
+		},
+		"multiKueue.capacityAwareDispatcherConfig without capacity-aware dispatcher": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeAllAtOnce),
+					CapacityAwareDispatcherConfig: &configapi.CapacityAwareDispatcherConfig{
+						MaxNominatedClusters: new(int32(2)),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig",
+				},
+			},
+		},
+		"multiKueue.capacityAwareDispatcherConfig.maxNominatedClusters below minimum": {
+			featureGates: map[featuregate.Feature]bool{features.MultiKueueCapacityAwareDispatcher: true},
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &configapi.CapacityAwareDispatcherConfig{
+						MaxNominatedClusters: new(int32(0)),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig.maxNominatedClusters",
+				},
+			},
+		},
 		"empty multiKueue.clusterProfile.accessProviders.name": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -1163,6 +1290,69 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"empty .admissionDecisionLog.path": {
+			cfg: &configapi.Configuration{
+				Integrations:         defaultIntegrations,
+				AdmissionDecisionLog: &configapi.AdmissionDecisionLog{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "admissionDecisionLog.path",
+				},
+			},
+		},
+		"relative .admissionDecisionLog.path": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				AdmissionDecisionLog: &configapi.AdmissionDecisionLog{
+					Path: "decisions.jsonl",
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "admissionDecisionLog.path",
+				},
+			},
+		},
+		"valid .admissionDecisionLog.path": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				AdmissionDecisionLog: &configapi.AdmissionDecisionLog{
+					Path: "/var/log/kueue/decisions.jsonl",
+				},
+			},
+		},
+		"invalid .tasDefragmentation": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				TASDefragmentation: &configapi.TASDefragmentation{
+					Interval:             &metav1.Duration{},
+					MaxEvictionsPerRound: new(int32(0)),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "tasDefragmentation.interval",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "tasDefragmentation.maxEvictionsPerRound",
+				},
+			},
+		},
+		"valid .tasDefragmentation": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				TASDefragmentation: &configapi.TASDefragmentation{
+					Interval:             &metav1.Duration{Duration: time.Minute},
+					MaxEvictionsPerRound: new(int32(3)),
+					MaxVictimPriority:    new(int32(100)),
+				},
+			},
+		},
 		"quotaCheckStrategy with value ignoreUndeclared not allowed with excludeResourcePrefixes": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -26,6 +26,7 @@ const (
 	JobControllerName            = KueueName + "-job-controller"
 	WorkloadControllerName       = KueueName + "-workload-controller"
 	PodTerminationControllerName = KueueName + "-pod-termination-controller"
+	LocalQueueProvisioningName   = KueueName + "-localqueue-provisioning"
 	AdmissionName                = KueueName + "-admission"
 	ReclaimablePodsMgr           = KueueName + "-reclaimable-pods"
 
@@ -0,0 +1,192 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+)
+
+const (
+	// capacityDispatcherRoundTimeout is the time after which the
+	// capacity-aware dispatcher scores the clusters again, if none of the
+	// nominated clusters admitted the workload.
+	capacityDispatcherRoundTimeout = 5 * time.Minute
+
+	defaultMaxNominatedClusters = 2
+)
+
+// clusterCapacity is the predicted fit of a workload in the ClusterQueue of
+// a worker cluster.
+type clusterCapacity struct {
+	name string
+	// fits is true if the free quota of the ClusterQueue covers the
+	// requests of the workload.
+	fits bool
+	// pending is the number of pending workloads in the ClusterQueue.
+	pending int32
+	// headroom is the lowest fraction of the nominal quota, among the
+	// requested resources, that is left free after admitting the workload.
+	headroom float64
+}
+
+// compareClusterCapacity orders the clusters where the workload fits first,
+// then by the shortest backlog, then by the largest headroom.
+func compareClusterCapacity(a, b clusterCapacity) int {
+	if a.fits != b.fits {
+		if a.fits {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Or(
+		cmp.Compare(a.pending, b.pending),
+		cmp.Compare(b.headroom, a.headroom),
+		cmp.Compare(a.name, b.name),
+	)
+}
+
+// nominateByCapacity returns the clusters nominated by the capacity-aware
+// dispatcher and the time after which the nomination must be revisited.
+// The nomination is kept for a round; after that, the clusters are scored
+// again by their free quota and backlog, and the best ones are nominated.
+// Clusters whose ClusterQueue doesn't provide all the requested resources,
+// and the excluded ones, are never nominated.
+func (w *wlReconciler) nominateByCapacity(ctx context.Context, log logr.Logger, group *wlGroup, excluded sets.Set[string]) ([]string, time.Duration) {
+	key := client.ObjectKeyFromObject(group.local)
+	now := w.clock.Now()
+	if len(group.local.Status.NominatedClusterNames) > 0 {
+		if roundStart, found := w.capacityRoundStarts.Get(key); found {
+			if elapsed := now.Sub(roundStart); elapsed < capacityDispatcherRoundTimeout {
+				return group.local.Status.NominatedClusterNames, capacityDispatcherRoundTimeout - elapsed
+			}
+		}
+	}
+
+	requests := admissionRequests(group.local)
+	candidates := make([]clusterCapacity, 0, len(group.remotes))
+	for name := range group.remotes {
+		if excluded.Has(name) {
+			continue
+		}
+		capacity, eligible, err := predictCapacity(ctx, group, name, requests)
+		if err != nil {
+			log.V(2).Error(err, "Failed to read the remote ClusterQueue", "remote", name)
+			continue
+		}
+		if !eligible {
+			log.V(3).Info("Remote ClusterQueue can't admit the workload", "remote", name)
+			continue
+		}
+		candidates = append(candidates, capacity)
+	}
+	slices.SortFunc(candidates, compareClusterCapacity)
+
+	nominated := make([]string, 0, w.maxNominatedClusters)
+	for _, c := range candidates[:min(len(candidates), int(w.maxNominatedClusters))] {
+		nominated = append(nominated, c.name)
+	}
+	log.V(3).Info("Scored worker clusters by capacity", "candidates", len(candidates), "nominated", nominated)
+	w.capacityRoundStarts.Add(key, now)
+	return nominated, capacityDispatcherRoundTimeout
+}
+
+// predictCapacity scores the ClusterQueue targeted by the workload in the
+// worker cluster. It is not eligible if the LocalQueue or the ClusterQueue
+// don't exist, or the ClusterQueue doesn't provide all the requested
+// resources.
+func predictCapacity(ctx context.Context, group *wlGroup, cluster string, requests corev1.ResourceList) (clusterCapacity, bool, error) {
+	rc, found := group.remoteClients[cluster]
+	if !found {
+		return clusterCapacity{}, false, nil
+	}
+	wl := group.local
+	remoteClient := rc.getClient()
+	var lq kueue.LocalQueue
+	// Remote LocalQueues and ClusterQueues are cached (by the selectivelyCachingClient).
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: string(wl.Spec.QueueName)}, &lq); err != nil {
+		return clusterCapacity{}, false, client.IgnoreNotFound(err)
+	}
+	var cq kueue.ClusterQueue
+	if err := remoteClient.Get(ctx, types.NamespacedName{Name: string(lq.Spec.ClusterQueue)}, &cq); err != nil {
+		return clusterCapacity{}, false, client.IgnoreNotFound(err)
+	}
+	capacity, eligible := scoreClusterQueue(&cq, requests)
+	capacity.name = cluster
+	return capacity, eligible, nil
+}
+
+// scoreClusterQueue predicts the fit of the requests in the ClusterQueue,
+// aggregating the quotas of all its flavors.
+func scoreClusterQueue(cq *kueue.ClusterQueue, requests corev1.ResourceList) (clusterCapacity, bool) {
+	nominal := make(corev1.ResourceList)
+	for _, rg := range cq.Spec.ResourceGroups {
+		for _, flavor := range rg.Flavors {
+			for _, res := range flavor.Resources {
+				nominal = utilresource.MergeResourceListKeepSum(nominal, corev1.ResourceList{res.Name: res.NominalQuota})
+			}
+		}
+	}
+	reserved := make(corev1.ResourceList)
+	for _, flavor := range cq.Status.FlavorsReservation {
+		for _, res := range flavor.Resources {
+			reserved = utilresource.MergeResourceListKeepSum(reserved, corev1.ResourceList{res.Name: res.Total})
+		}
+	}
+
+	capacity := clusterCapacity{fits: true, pending: cq.Status.PendingWorkloads, headroom: 1}
+	for name, requested := range requests {
+		quota, found := nominal[name]
+		if !found || quota.IsZero() {
+			return clusterCapacity{}, false
+		}
+		left := quota.DeepCopy()
+		left.Sub(reserved[name])
+		left.Sub(requested)
+		if left.Sign() < 0 {
+			capacity.fits = false
+		}
+		capacity.headroom = min(capacity.headroom, utilresource.QuantityToFloat(&left)/utilresource.QuantityToFloat(&quota))
+	}
+	return capacity, true
+}
+
+// admissionRequests returns the total requests of the workload, as reserved
+// in the manager cluster, aggregated by resource.
+func admissionRequests(wl *kueue.Workload) corev1.ResourceList {
+	requests := make(corev1.ResourceList)
+	if wl.Status.Admission == nil {
+		return requests
+	}
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		for name, q := range psa.ResourceUsage {
+			requests = utilresource.MergeResourceListKeepSum(requests, corev1.ResourceList{name: q})
+		}
+	}
+	return requests
+}
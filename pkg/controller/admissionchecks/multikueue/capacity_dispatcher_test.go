@@ -0,0 +1,115 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestScoreClusterQueue(t *testing.T) {
+	reserve := func(cq *kueue.ClusterQueue, cpu string) *kueue.ClusterQueue {
+		cq.Status.FlavorsReservation = []kueue.FlavorUsage{{
+			Name:      "default",
+			Resources: []kueue.ResourceUsage{{Name: corev1.ResourceCPU, Total: resource.MustParse(cpu)}},
+		}}
+		return cq
+	}
+	requests := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+
+	cases := map[string]struct {
+		cq           *kueue.ClusterQueue
+		requests     corev1.ResourceList
+		wantCapacity clusterCapacity
+		wantEligible bool
+	}{
+		"fits in an empty queue": {
+			cq: utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "8").Obj()).
+				Obj(),
+			requests:     requests,
+			wantCapacity: clusterCapacity{fits: true, headroom: 0.75},
+			wantEligible: true,
+		},
+		"quota summed across flavors": {
+			cq: reserve(utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj(),
+					*utiltestingapi.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "2").Obj(),
+				).
+				PendingWorkloads(3).
+				Obj(), "1"),
+			requests:     requests,
+			wantCapacity: clusterCapacity{fits: true, pending: 3, headroom: 0.25},
+			wantEligible: true,
+		},
+		"doesn't fit in a busy queue": {
+			cq: reserve(utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+				Obj(), "3"),
+			requests:     requests,
+			wantCapacity: clusterCapacity{fits: false, headroom: -0.25},
+			wantEligible: true,
+		},
+		"missing resource is not eligible": {
+			cq: utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "8").Obj()).
+				Obj(),
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+				"example.com/gpu":  resource.MustParse("1"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotCapacity, gotEligible := scoreClusterQueue(tc.cq, tc.requests)
+			if gotEligible != tc.wantEligible {
+				t.Errorf("Unexpected eligibility, want=%v, got=%v", tc.wantEligible, gotEligible)
+			}
+			if diff := cmp.Diff(tc.wantCapacity, gotCapacity, cmp.AllowUnexported(clusterCapacity{})); diff != "" {
+				t.Errorf("Unexpected capacity (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareClusterCapacity(t *testing.T) {
+	clusters := []clusterCapacity{
+		{name: "full", fits: false, pending: 0, headroom: -0.5},
+		{name: "busy", fits: true, pending: 5, headroom: 0.9},
+		{name: "tight", fits: true, pending: 1, headroom: 0.1},
+		{name: "roomy", fits: true, pending: 1, headroom: 0.6},
+		{name: "also-roomy", fits: true, pending: 1, headroom: 0.6},
+	}
+	slices.SortFunc(clusters, compareClusterCapacity)
+	got := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		got = append(got, c.name)
+	}
+	want := []string{"also-roomy", "roomy", "tight", "busy", "full"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected order (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	defaultClusterHealthWindow     = time.Hour
+	defaultClusterHealthMinSamples = 10
+
+	// clusterHealthRefreshInterval is the interval at which the health in
+	// the status of the MultiKueueClusters is refreshed.
+	clusterHealthRefreshInterval = time.Minute
+)
+
+type healthObservationKind int
+
+const (
+	dispatchSucceeded healthObservationKind = iota
+	dispatchFailed
+	remoteAdmitted
+	remoteEvicted
+)
+
+type healthObservation struct {
+	kind    healthObservationKind
+	time    time.Time
+	latency time.Duration
+}
+
+// clusterHealthTracker records how the worker clusters handle the workloads
+// dispatched to them, and decides which of them are cordoned.
+type clusterHealthTracker struct {
+	clock clock.Clock
+
+	window                     time.Duration
+	minSamples                 int32
+	minDispatchSuccessPercent  *int32
+	maxAverageAdmissionLatency *time.Duration
+	maxEvictionPercent         *int32
+
+	lock         sync.Mutex
+	observations map[string][]healthObservation
+	cordoned     sets.Set[string]
+}
+
+func newClusterHealthTracker(cfg *configapi.ClusterHealthConfig) *clusterHealthTracker {
+	t := &clusterHealthTracker{
+		clock:        realClock,
+		window:       defaultClusterHealthWindow,
+		minSamples:   defaultClusterHealthMinSamples,
+		observations: make(map[string][]healthObservation),
+		cordoned:     sets.New[string](),
+	}
+	if cfg != nil {
+		if cfg.Window != nil {
+			t.window = cfg.Window.Duration
+		}
+		t.minSamples = ptr.Deref(cfg.MinSamples, t.minSamples)
+		t.minDispatchSuccessPercent = cfg.MinDispatchSuccessPercent
+		if cfg.MaxAverageAdmissionLatency != nil {
+			t.maxAverageAdmissionLatency = &cfg.MaxAverageAdmissionLatency.Duration
+		}
+		t.maxEvictionPercent = cfg.MaxEvictionPercent
+	}
+	return t
+}
+
+func (t *clusterHealthTracker) record(cluster string, o healthObservation) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	o.time = t.clock.Now()
+	t.observations[cluster] = append(t.pruneLocked(cluster), o)
+}
+
+// recordDispatch records an attempt to create a workload in the cluster.
+func (t *clusterHealthTracker) recordDispatch(cluster string, err error) {
+	kind := dispatchSucceeded
+	if err != nil {
+		kind = dispatchFailed
+	}
+	t.record(cluster, healthObservation{kind: kind})
+}
+
+// recordAdmission records the admission of a workload by the cluster, and
+// the time it took since the workload was created in the cluster.
+func (t *clusterHealthTracker) recordAdmission(cluster string, latency time.Duration) {
+	t.record(cluster, healthObservation{kind: remoteAdmitted, latency: max(latency, 0)})
+}
+
+// recordEviction records the eviction of an admitted workload by the cluster.
+func (t *clusterHealthTracker) recordEviction(cluster string) {
+	t.record(cluster, healthObservation{kind: remoteEvicted})
+}
+
+// pruneLocked drops the observations older than the window.
+func (t *clusterHealthTracker) pruneLocked(cluster string) []healthObservation {
+	observations := t.observations[cluster]
+	cutoff := t.clock.Now().Add(-t.window)
+	i := 0
+	for i < len(observations) && observations[i].time.Before(cutoff) {
+		i++
+	}
+	observations = observations[i:]
+	t.observations[cluster] = observations
+	return observations
+}
+
+// health summarizes the observations of the cluster in the window. The
+// LastUpdateTime is left for the caller to set.
+func (t *clusterHealthTracker) health(cluster string) kueue.MultiKueueClusterHealth {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var h kueue.MultiKueueClusterHealth
+	var failedDispatches int32
+	var totalLatency time.Duration
+	for _, o := range t.pruneLocked(cluster) {
+		switch o.kind {
+		case dispatchSucceeded:
+			h.Dispatches++
+		case dispatchFailed:
+			h.Dispatches++
+			failedDispatches++
+		case remoteAdmitted:
+			h.Admissions++
+			totalLatency += o.latency
+		case remoteEvicted:
+			h.Evictions++
+		}
+	}
+	if h.Dispatches > 0 {
+		h.DispatchSuccessPercent = new(percent(h.Dispatches-failedDispatches, h.Dispatches))
+	}
+	if h.Admissions > 0 {
+		h.AverageAdmissionLatency = &metav1.Duration{Duration: (totalLatency / time.Duration(h.Admissions)).Truncate(time.Second)}
+		h.EvictionPercent = new(min(percent(h.Evictions, h.Admissions), 100))
+	}
+	return h
+}
+
+// unhealthyReasons returns why the health crosses the configured thresholds,
+// if it does.
+func (t *clusterHealthTracker) unhealthyReasons(h *kueue.MultiKueueClusterHealth) []string {
+	var reasons []string
+	if t.minDispatchSuccessPercent != nil && h.Dispatches >= t.minSamples &&
+		ptr.Deref(h.DispatchSuccessPercent, 100) < *t.minDispatchSuccessPercent {
+		reasons = append(reasons, fmt.Sprintf("dispatch success %d%% is below %d%%", *h.DispatchSuccessPercent, *t.minDispatchSuccessPercent))
+	}
+	if h.Admissions >= t.minSamples {
+		if t.maxAverageAdmissionLatency != nil && h.AverageAdmissionLatency != nil &&
+			h.AverageAdmissionLatency.Duration > *t.maxAverageAdmissionLatency {
+			reasons = append(reasons, fmt.Sprintf("average admission latency %s is above %s", h.AverageAdmissionLatency.Duration, *t.maxAverageAdmissionLatency))
+		}
+		if t.maxEvictionPercent != nil && ptr.Deref(h.EvictionPercent, 0) > *t.maxEvictionPercent {
+			reasons = append(reasons, fmt.Sprintf("eviction rate %d%% is above %d%%", *h.EvictionPercent, *t.maxEvictionPercent))
+		}
+	}
+	return reasons
+}
+
+// cordonCondition decides whether the cluster is cordoned, records the
+// decision, and returns the matching Cordoned condition.
+func (t *clusterHealthTracker) cordonCondition(cluster *kueue.MultiKueueCluster, h *kueue.MultiKueueClusterHealth) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               kueue.MultiKueueClusterCordoned,
+		Status:             metav1.ConditionFalse,
+		Reason:             kueue.MultiKueueClusterHealthy,
+		Message:            "The cluster accepts new workloads",
+		ObservedGeneration: cluster.Generation,
+	}
+	if ptr.Deref(cluster.Spec.Cordoned, false) {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = kueue.MultiKueueClusterCordonedManually
+		cond.Message = "The cluster is cordoned by spec.cordoned"
+	} else if reasons := t.unhealthyReasons(h); len(reasons) > 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = kueue.MultiKueueClusterUnhealthy
+		cond.Message = fmt.Sprintf("The cluster is cordoned: %s", strings.Join(reasons, ", "))
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if cond.Status == metav1.ConditionTrue {
+		t.cordoned.Insert(cluster.Name)
+	} else {
+		t.cordoned.Delete(cluster.Name)
+	}
+	return cond
+}
+
+// isCordoned returns true if no new workloads should be dispatched to the cluster.
+func (t *clusterHealthTracker) isCordoned(cluster string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.cordoned.Has(cluster)
+}
+
+// forget drops everything recorded for the cluster.
+func (t *clusterHealthTracker) forget(cluster string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.observations, cluster)
+	t.cordoned.Delete(cluster)
+}
+
+func percent(part, total int32) int32 {
+	return int32(int64(part) * 100 / int64(total))
+}
+
+// updateHealthStatus refreshes the health and the Cordoned condition in the
+// status of the cluster.
+func (c *clustersReconciler) updateHealthStatus(ctx context.Context, cluster *kueue.MultiKueueCluster) error {
+	h := c.health.health(cluster.Name)
+	cond := c.health.cordonCondition(cluster, &h)
+
+	healthChanged := true
+	if old := cluster.Status.Health; old != nil {
+		h.LastUpdateTime = old.LastUpdateTime
+		healthChanged = !equality.Semantic.DeepEqual(*old, h)
+	}
+	if !healthChanged && cmpConditionState(apimeta.FindStatusCondition(cluster.Status.Conditions, kueue.MultiKueueClusterCordoned), &cond) {
+		return nil
+	}
+	if healthChanged {
+		h.LastUpdateTime = metav1.NewTime(c.health.clock.Now())
+		cluster.Status.Health = &h
+	}
+	apimeta.SetStatusCondition(&cluster.Status.Conditions, cond)
+	return c.localClient.Status().Update(ctx, cluster)
+}
@@ -0,0 +1,243 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestClusterHealthTracker(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	errFake := errors.New("fake error")
+
+	cases := map[string]struct {
+		config  *configapi.ClusterHealthConfig
+		cluster *kueue.MultiKueueCluster
+		// recordOld is called with the clock two hours in the past.
+		recordOld func(*clusterHealthTracker)
+		record    func(*clusterHealthTracker)
+
+		wantHealth     kueue.MultiKueueClusterHealth
+		wantCondition  metav1.Condition
+		wantIsCordoned bool
+	}{
+		"no observations": {
+			cluster:       utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			wantCondition: metav1.Condition{Status: metav1.ConditionFalse, Reason: kueue.MultiKueueClusterHealthy},
+		},
+		"healthy cluster": {
+			config: &configapi.ClusterHealthConfig{
+				MinSamples:                 new(int32(2)),
+				MinDispatchSuccessPercent:  new(int32(50)),
+				MaxAverageAdmissionLatency: &metav1.Duration{Duration: time.Minute},
+				MaxEvictionPercent:         new(int32(50)),
+			},
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			record: func(t *clusterHealthTracker) {
+				t.recordDispatch("worker1", nil)
+				t.recordDispatch("worker1", nil)
+				t.recordDispatch("worker1", errFake)
+				t.recordAdmission("worker1", 10*time.Second)
+				t.recordAdmission("worker1", 20*time.Second)
+				t.recordEviction("worker1")
+			},
+			wantHealth: kueue.MultiKueueClusterHealth{
+				Dispatches:              3,
+				DispatchSuccessPercent:  new(int32(66)),
+				Admissions:              2,
+				AverageAdmissionLatency: &metav1.Duration{Duration: 15 * time.Second},
+				Evictions:               1,
+				EvictionPercent:         new(int32(50)),
+			},
+			wantCondition: metav1.Condition{Status: metav1.ConditionFalse, Reason: kueue.MultiKueueClusterHealthy},
+		},
+		"manually cordoned": {
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Cordoned(true).Obj(),
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: kueue.MultiKueueClusterCordonedManually,
+			},
+			wantIsCordoned: true,
+		},
+		"cordoned for failed dispatches and evictions": {
+			config: &configapi.ClusterHealthConfig{
+				MinSamples:                new(int32(2)),
+				MinDispatchSuccessPercent: new(int32(90)),
+				MaxEvictionPercent:        new(int32(10)),
+			},
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			record: func(t *clusterHealthTracker) {
+				t.recordDispatch("worker1", nil)
+				t.recordDispatch("worker1", errFake)
+				t.recordAdmission("worker1", time.Second)
+				t.recordAdmission("worker1", time.Second)
+				t.recordEviction("worker1")
+			},
+			wantHealth: kueue.MultiKueueClusterHealth{
+				Dispatches:              2,
+				DispatchSuccessPercent:  new(int32(50)),
+				Admissions:              2,
+				AverageAdmissionLatency: &metav1.Duration{Duration: time.Second},
+				Evictions:               1,
+				EvictionPercent:         new(int32(50)),
+			},
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: kueue.MultiKueueClusterUnhealthy,
+			},
+			wantIsCordoned: true,
+		},
+		"cordoned for slow admissions": {
+			config: &configapi.ClusterHealthConfig{
+				MinSamples:                 new(int32(1)),
+				MaxAverageAdmissionLatency: &metav1.Duration{Duration: time.Minute},
+			},
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			record: func(t *clusterHealthTracker) {
+				t.recordAdmission("worker1", 5*time.Minute)
+			},
+			wantHealth: kueue.MultiKueueClusterHealth{
+				Admissions:              1,
+				AverageAdmissionLatency: &metav1.Duration{Duration: 5 * time.Minute},
+				EvictionPercent:         new(int32(0)),
+			},
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: kueue.MultiKueueClusterUnhealthy,
+			},
+			wantIsCordoned: true,
+		},
+		"not cordoned below the minimum samples": {
+			config: &configapi.ClusterHealthConfig{
+				MinDispatchSuccessPercent: new(int32(90)),
+			},
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			record: func(t *clusterHealthTracker) {
+				t.recordDispatch("worker1", errFake)
+			},
+			wantHealth: kueue.MultiKueueClusterHealth{
+				Dispatches:             1,
+				DispatchSuccessPercent: new(int32(0)),
+			},
+			wantCondition: metav1.Condition{Status: metav1.ConditionFalse, Reason: kueue.MultiKueueClusterHealthy},
+		},
+		"observations older than the window are dropped": {
+			config: &configapi.ClusterHealthConfig{
+				Window:                    &metav1.Duration{Duration: time.Hour},
+				MinSamples:                new(int32(1)),
+				MinDispatchSuccessPercent: new(int32(90)),
+			},
+			cluster: utiltestingapi.MakeMultiKueueCluster("worker1").Obj(),
+			recordOld: func(t *clusterHealthTracker) {
+				t.recordDispatch("worker1", errFake)
+			},
+			record: func(t *clusterHealthTracker) {
+				t.recordDispatch("worker1", nil)
+			},
+			wantHealth: kueue.MultiKueueClusterHealth{
+				Dispatches:             1,
+				DispatchSuccessPercent: new(int32(100)),
+			},
+			wantCondition: metav1.Condition{Status: metav1.ConditionFalse, Reason: kueue.MultiKueueClusterHealthy},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := testingclock.NewFakeClock(now.Add(-2 * time.Hour))
+			tracker := newClusterHealthTracker(tc.config)
+			tracker.clock = fakeClock
+			if tc.recordOld != nil {
+				tc.recordOld(tracker)
+			}
+			fakeClock.SetTime(now)
+			if tc.record != nil {
+				tc.record(tracker)
+			}
+
+			gotHealth := tracker.health(tc.cluster.Name)
+			if diff := cmp.Diff(tc.wantHealth, gotHealth); diff != "" {
+				t.Errorf("Unexpected health (-want/+got):\n%s", diff)
+			}
+			gotCondition := tracker.cordonCondition(tc.cluster, &gotHealth)
+			tc.wantCondition.Type = kueue.MultiKueueClusterCordoned
+			if diff := cmp.Diff(tc.wantCondition, gotCondition, cmpopts.IgnoreFields(metav1.Condition{}, "Message")); diff != "" {
+				t.Errorf("Unexpected condition (-want/+got):\n%s", diff)
+			}
+			if got := tracker.isCordoned(tc.cluster.Name); got != tc.wantIsCordoned {
+				t.Errorf("Unexpected isCordoned, want=%v, got=%v", tc.wantIsCordoned, got)
+			}
+			if tracker.isCordoned("other") {
+				t.Error("Unexpected cordon of an unrelated cluster")
+			}
+		})
+	}
+}
+
+func TestUpdateHealthStatus(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	now := time.Now().Truncate(time.Second)
+	cluster := utiltestingapi.MakeMultiKueueCluster("worker1").Cordoned(true).Generation(2).Obj()
+	c := utiltesting.NewClientBuilder().
+		WithObjects(cluster).
+		WithStatusSubresource(&kueue.MultiKueueCluster{}).
+		Build()
+	reconciler := newClustersReconciler(c, TestNamespace, 0, defaultOrigin, nil, nil, nil, nil, nil)
+	reconciler.health.clock = testingclock.NewFakeClock(now)
+	reconciler.health.recordDispatch("worker1", nil)
+
+	if err := reconciler.updateHealthStatus(ctx, cluster); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var got kueue.MultiKueueCluster
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cluster), &got); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantStatus := kueue.MultiKueueClusterStatus{
+		Conditions: []metav1.Condition{{
+			Type:               kueue.MultiKueueClusterCordoned,
+			Status:             metav1.ConditionTrue,
+			Reason:             kueue.MultiKueueClusterCordonedManually,
+			Message:            "The cluster is cordoned by spec.cordoned",
+			ObservedGeneration: 2,
+		}},
+		Health: &kueue.MultiKueueClusterHealth{
+			Dispatches:             1,
+			DispatchSuccessPercent: new(int32(100)),
+			LastUpdateTime:         metav1.NewTime(now),
+		},
+	}
+	if diff := cmp.Diff(wantStatus, got.Status, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("Unexpected status (-want/+got):\n%s", diff)
+	}
+	if !reconciler.health.isCordoned("worker1") {
+		t.Error("Expected the cluster to be cordoned")
+	}
+}
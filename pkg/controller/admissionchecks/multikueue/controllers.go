@@ -19,6 +19,7 @@ package multikueue
 import (
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/cluster-inventory-api/pkg/access"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -43,6 +44,9 @@ type SetupOptions struct {
 	eventsBatchPeriod    time.Duration
 	adapters             map[string]jobframework.MultiKueueAdapter
 	dispatcherName       string
+	maxNominatedClusters int32
+	migrationCooldown    time.Duration
+	clusterHealth        *configapi.ClusterHealthConfig
 	clusterProfileConfig *configapi.ClusterProfile
 	roleTracker          *roletracker.RoleTracker
 }
@@ -95,6 +99,33 @@ func WithDispatcherName(dispatcherName string) SetupOption {
 	}
 }
 
+// WithCapacityAwareDispatcherConfig sets the configuration of the capacity-aware dispatcher.
+func WithCapacityAwareDispatcherConfig(cfg *configapi.CapacityAwareDispatcherConfig) SetupOption {
+	return func(o *SetupOptions) {
+		if cfg != nil && cfg.MaxNominatedClusters != nil {
+			o.maxNominatedClusters = *cfg.MaxNominatedClusters
+		}
+	}
+}
+
+// WithMigrationCooldown sets the time a worker cluster isn't nominated for a
+// workload after it evicted the workload. If nil, the default is kept.
+func WithMigrationCooldown(d *metav1.Duration) SetupOption {
+	return func(o *SetupOptions) {
+		if d != nil {
+			o.migrationCooldown = d.Duration
+		}
+	}
+}
+
+// WithClusterHealthConfig sets the configuration used to track the health
+// of the worker clusters.
+func WithClusterHealthConfig(cfg *configapi.ClusterHealthConfig) SetupOption {
+	return func(o *SetupOptions) {
+		o.clusterHealth = cfg
+	}
+}
+
 func WithClusterProfiles(clusterProfiles *configapi.ClusterProfile) SetupOption {
 	return func(o *SetupOptions) {
 		o.clusterProfileConfig = clusterProfiles
@@ -110,12 +141,14 @@ func WithRoleTracker(tracker *roletracker.RoleTracker) SetupOption {
 
 func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) error {
 	options := &SetupOptions{
-		gcInterval:        defaultGCInterval,
-		origin:            defaultOrigin,
-		workerLostTimeout: defaultWorkerLostTimeout,
-		eventsBatchPeriod: constants.UpdatesBatchPeriod,
-		adapters:          make(map[string]jobframework.MultiKueueAdapter),
-		dispatcherName:    configapi.MultiKueueDispatcherModeAllAtOnce,
+		gcInterval:           defaultGCInterval,
+		origin:               defaultOrigin,
+		workerLostTimeout:    defaultWorkerLostTimeout,
+		eventsBatchPeriod:    constants.UpdatesBatchPeriod,
+		adapters:             make(map[string]jobframework.MultiKueueAdapter),
+		dispatcherName:       configapi.MultiKueueDispatcherModeAllAtOnce,
+		maxNominatedClusters: defaultMaxNominatedClusters,
+		migrationCooldown:    defaultMigrationCooldown,
 	}
 
 	for _, o := range opts {
@@ -157,6 +190,7 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 		options.adapters, cpAccessProvider, options.roleTracker,
 		mgr.GetEventRecorder("multikueue-cluster"),
 	)
+	cRec.health = newClusterHealthTracker(options.clusterHealth)
 	err = cRec.setupWithManager(mgr)
 	if err != nil {
 		return err
@@ -177,6 +211,8 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 	}
 
 	wlRec := newWlReconciler(mgr.GetClient(), helper, cRec, options.origin, mgr.GetEventRecorder(constants.WorkloadControllerName),
-		options.workerLostTimeout, options.eventsBatchPeriod, options.adapters, options.dispatcherName, options.roleTracker)
+		options.workerLostTimeout, options.eventsBatchPeriod, options.adapters, options.dispatcherName, options.roleTracker,
+		WithMaxNominatedClusters(options.maxNominatedClusters),
+		WithEvictedClusterCooldown(options.migrationCooldown))
 	return wlRec.setupWithManager(mgr)
 }
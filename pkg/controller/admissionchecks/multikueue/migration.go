@@ -0,0 +1,68 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multikueue
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/util/api"
+)
+
+const (
+	defaultMigrationCooldown = 10 * time.Minute
+
+	// maxMigrationHistory is the number of migrations kept in the
+	// workload status.
+	maxMigrationHistory = 10
+)
+
+// recordMigration appends the eviction of the workload by the worker cluster
+// to its migration history, dropping the oldest entries over the limit.
+func recordMigration(wl *kueue.Workload, cluster string, evictedCond *metav1.Condition, now time.Time) {
+	wl.Status.MigrationHistory = append(wl.Status.MigrationHistory, kueue.WorkloadMigration{
+		ClusterName:  cluster,
+		Reason:       evictedCond.Reason,
+		Message:      api.TruncateConditionMessage(evictedCond.Message),
+		EvictionTime: metav1.NewTime(now),
+	})
+	if extra := len(wl.Status.MigrationHistory) - maxMigrationHistory; extra > 0 {
+		wl.Status.MigrationHistory = wl.Status.MigrationHistory[extra:]
+	}
+}
+
+// clustersInMigrationCooldown returns the worker clusters that evicted the
+// workload less than the cooldown ago, and the time until the first of them
+// can be nominated again.
+func clustersInMigrationCooldown(wl *kueue.Workload, cooldown time.Duration, now time.Time) (sets.Set[string], time.Duration) {
+	excluded := sets.New[string]()
+	var nextExpiry time.Duration
+	for _, m := range wl.Status.MigrationHistory {
+		left := m.EvictionTime.Add(cooldown).Sub(now)
+		if left <= 0 {
+			continue
+		}
+		excluded.Insert(m.ClusterName)
+		if nextExpiry == 0 || left < nextExpiry {
+			nextExpiry = left
+		}
+	}
+	return excluded, nextExpiry
+}
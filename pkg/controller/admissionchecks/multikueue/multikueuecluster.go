@@ -778,6 +778,10 @@ type clustersReconciler struct {
 
 	logName     string
 	roleTracker *roletracker.RoleTracker
+
+	// health tracks how the clusters handle the dispatched workloads, and
+	// which of them are cordoned.
+	health *clusterHealthTracker
 }
 
 type clusterProfileAccessProvider interface {
@@ -810,6 +814,7 @@ func (c *clustersReconciler) stopAndRemoveCluster(clusterName string) {
 		rc.StopWatchers()
 		delete(c.remoteClients, clusterName)
 	}
+	c.health.forget(clusterName)
 }
 
 // disconnectCluster marks the remoteClient for clusterName as disconnected
@@ -895,6 +900,14 @@ func (c *clustersReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		return reconcile.Result{}, nil //nolint:nilerr // nil is intentional, as either the cluster is deleted, or not found
 	}
 
+	var requeueAfter time.Duration
+	if features.Enabled(features.MultiKueueClusterHealth) {
+		if err := c.updateHealthStatus(ctx, cluster); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+		requeueAfter = clusterHealthRefreshInterval
+	}
+
 	clientConfig, reason, err := c.loadClientConfig(ctx, cluster)
 	if err != nil {
 		log.Error(err, "loading client config failed")
@@ -922,7 +935,7 @@ func (c *clustersReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		return reconcile.Result{RequeueAfter: ptr.Deref(retryAfter, 0)}, nil
 	}
 
-	return reconcile.Result{}, client.IgnoreNotFound(c.updateStatus(ctx, cluster, true, "Active", "Connected"))
+	return reconcile.Result{RequeueAfter: requeueAfter}, client.IgnoreNotFound(c.updateStatus(ctx, cluster, true, "Active", "Connected"))
 }
 
 func (c *clustersReconciler) loadClientConfig(ctx context.Context, cluster *kueue.MultiKueueCluster) (*clientConfig, string, error) {
@@ -1236,6 +1249,7 @@ func newClustersReconciler(
 		clusterProfileAccessProvider: cpAccessProvider,
 		logName:                      "multikueuecluster-reconciler",
 		roleTracker:                  roleTracker,
+		health:                       newClusterHealthTracker(nil),
 	}
 }
 
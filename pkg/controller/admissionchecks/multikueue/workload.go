@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -100,6 +101,16 @@ type wlReconciler struct {
 	clock             clock.Clock
 	dispatcherName    string
 	roleTracker       *roletracker.RoleTracker
+
+	// maxNominatedClusters is the number of clusters nominated in every
+	// round of the capacity-aware dispatcher.
+	maxNominatedClusters int32
+	// capacityRoundStarts holds the start of the current nomination round
+	// of the capacity-aware dispatcher, per workload.
+	capacityRoundStarts *utilmaps.SyncMap[types.NamespacedName, time.Time]
+	// migrationCooldown is the time a worker cluster isn't nominated for a
+	// workload after it evicted the workload.
+	migrationCooldown time.Duration
 }
 
 var _ reconcile.Reconciler = (*wlReconciler)(nil)
@@ -123,6 +134,22 @@ func WithClock(_ testing.TB, c clock.Clock) Option {
 	}
 }
 
+// WithMaxNominatedClusters sets the number of clusters nominated in every
+// round of the capacity-aware dispatcher.
+func WithMaxNominatedClusters(n int32) Option {
+	return func(r *wlReconciler) {
+		r.maxNominatedClusters = n
+	}
+}
+
+// WithEvictedClusterCooldown sets the time a worker cluster isn't nominated
+// for a workload after it evicted the workload.
+func WithEvictedClusterCooldown(d time.Duration) Option {
+	return func(r *wlReconciler) {
+		r.migrationCooldown = d
+	}
+}
+
 // IsFinished returns true if the local workload is finished.
 func (g *wlGroup) IsFinished() bool {
 	return workloadfinish.IsFinished(g.local)
@@ -194,6 +221,7 @@ func (w *wlReconciler) Reconcile(ctx context.Context, req reconcile.Request) (re
 	case client.IgnoreNotFound(err) != nil:
 		return reconcile.Result{}, err
 	case err != nil:
+		w.capacityRoundStarts.Delete(req.NamespacedName)
 		oldWl, found := w.deletedWlCache.Get(req.String())
 		if !found {
 			return reconcile.Result{}, nil
@@ -476,6 +504,9 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 				acs.Message = fmt.Sprintf("Workload evicted on worker cluster: %q, resetting for re-admission. Previously: %q", *group.local.Status.ClusterName, acs.State)
 				acs.State = kueue.CheckStateRetry
 				acs.LastTransitionTime = metav1.NewTime(w.clock.Now())
+				if features.Enabled(features.MultiKueueWorkloadMigration) {
+					recordMigration(wl, evictedRemote, remoteEvictCond, w.clock.Now())
+				}
 				return workloadpatching.SetAdmissionCheckState(&wl.Status.AdmissionChecks, *acs, w.clock), nil
 			}); err != nil {
 				log.Error(err, "Failed to patch workload status")
@@ -483,6 +514,9 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 			}
 
 			w.recorder.Eventf(group.local, nil, corev1.EventTypeNormal, "MultiKueue", "MultiKueue", acs.Message)
+			if features.Enabled(features.MultiKueueClusterHealth) {
+				w.clusters.health.recordEviction(evictedRemote)
+			}
 			return reconcile.Result{}, nil
 		}
 	}
@@ -623,6 +657,9 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 		}
 		if !wasReady && acs.State == kueue.CheckStateReady {
 			metrics.ReportMultiKueueWorkloadAdmitted(admittedClusterQueue(group.local), admittingRemote, w.roleTracker)
+			if features.Enabled(features.MultiKueueClusterHealth) {
+				w.clusters.health.recordAdmission(admittingRemote, remoteCond.LastTransitionTime.Sub(remoteWl.CreationTimestamp.Time))
+			}
 		}
 		requeueAfter := w.workerLostTimeout
 		if syncDeferred {
@@ -658,7 +695,7 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 	}
 
 	res, err := w.nominateAndSynchronizeWorkers(ctx, group)
-	if err == nil && (res.RequeueAfter == 0 || requeueAfterSynchronize < res.RequeueAfter) {
+	if err == nil && requeueAfterSynchronize > 0 && (res.RequeueAfter == 0 || requeueAfterSynchronize < res.RequeueAfter) {
 		res.RequeueAfter = requeueAfterSynchronize
 	}
 	return res, err
@@ -902,6 +939,27 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 	}
 
 	var nominatedWorkers []string
+	var requeueAfter time.Duration
+
+	// Worker clusters which recently evicted the workload are not nominated
+	// until their cooldown expires, and cordoned ones until uncordoned.
+	excludedWorkers := sets.New[string]()
+	if features.Enabled(features.MultiKueueWorkloadMigration) {
+		var inCooldown sets.Set[string]
+		inCooldown, requeueAfter = clustersInMigrationCooldown(group.local, w.migrationCooldown, w.clock.Now())
+		if inCooldown.Len() > 0 {
+			log.V(3).Info("Excluding worker clusters in migration cooldown", "clusters", sets.List(inCooldown), "cooldownLeft", requeueAfter)
+			excludedWorkers = excludedWorkers.Union(inCooldown)
+		}
+	}
+	if features.Enabled(features.MultiKueueClusterHealth) {
+		for workerName := range group.remotes {
+			if w.clusters.health.isCordoned(workerName) {
+				log.V(3).Info("Excluding cordoned worker cluster", "cluster", workerName)
+				excludedWorkers.Insert(workerName)
+			}
+		}
+	}
 
 	// For elastic workloads, retrieve the remote cluster where the original workload was scheduled.
 	// For now, new workload slices will continue to be assigned to the same cluster.
@@ -911,7 +969,25 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 		nominatedWorkers = []string{clusterName}
 	} else if w.dispatcherName == config.MultiKueueDispatcherModeAllAtOnce {
 		for workerName := range group.remotes {
-			nominatedWorkers = append(nominatedWorkers, workerName)
+			if !excludedWorkers.Has(workerName) {
+				nominatedWorkers = append(nominatedWorkers, workerName)
+			}
+		}
+
+		if !nominatedClusterSetsEqual(group.local.Status.NominatedClusterNames, nominatedWorkers) {
+			if err := workloadpatching.PatchAdmissionStatus(ctx, w.client, group.local, w.clock, func(wl *kueue.Workload) (bool, error) {
+				wl.Status.NominatedClusterNames = nominatedWorkers
+				return true, nil
+			}); err != nil {
+				log.V(2).Error(err, "Failed to patch nominated clusters", "workload", klog.KObj(group.local))
+				return reconcile.Result{}, err
+			}
+		}
+	} else if w.dispatcherName == config.MultiKueueDispatcherModeCapacityAware {
+		var roundLeft time.Duration
+		nominatedWorkers, roundLeft = w.nominateByCapacity(ctx, log, group, excludedWorkers)
+		if requeueAfter == 0 || roundLeft < requeueAfter {
+			requeueAfter = roundLeft
 		}
 
 		if !nominatedClusterSetsEqual(group.local.Status.NominatedClusterNames, nominatedWorkers) {
@@ -925,7 +1001,7 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 		}
 	} else {
 		// Incremental dispatcher and External dispatcher path
-		nominatedWorkers = group.local.Status.NominatedClusterNames
+		nominatedWorkers = slices.DeleteFunc(slices.Clone(group.local.Status.NominatedClusterNames), excludedWorkers.Has)
 	}
 
 	var errs []error
@@ -942,7 +1018,11 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 		if slices.Contains(nominatedWorkers, rem) {
 			if remoteWl == nil {
 				clone := cloneForCreate(group.local, group.remoteClients[rem].origin, true)
-				if err := group.remoteClients[rem].getClient().Create(ctx, clone); err != nil {
+				err := group.remoteClients[rem].getClient().Create(ctx, clone)
+				if features.Enabled(features.MultiKueueClusterHealth) {
+					w.clusters.health.recordDispatch(rem, err)
+				}
+				if err != nil {
 					log.V(2).Error(err, "creating remote object", "remote", rem)
 					errs = append(errs, err)
 				} else {
@@ -957,7 +1037,7 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 			group.remotes[rem] = nil
 		}
 	}
-	return reconcile.Result{}, errors.Join(errs...)
+	return reconcile.Result{RequeueAfter: requeueAfter}, errors.Join(errs...)
 }
 
 func (w *wlReconciler) Create(_ event.CreateEvent) bool {
@@ -997,6 +1077,10 @@ func newWlReconciler(c client.Client, helper *admissioncheck.MultiKueueStoreHelp
 		clock:             realClock,
 		dispatcherName:    dispatcherName,
 		roleTracker:       roleTracker,
+
+		maxNominatedClusters: defaultMaxNominatedClusters,
+		capacityRoundStarts:  utilmaps.NewSyncMap[types.NamespacedName, time.Time](0),
+		migrationCooldown:    defaultMigrationCooldown,
 	}
 	for _, option := range options {
 		option(r)
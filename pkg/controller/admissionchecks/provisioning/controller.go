@@ -332,7 +332,7 @@ func (c *Controller) syncOwnedProvisionRequest(
 				}
 				if err != nil {
 					// it's a not found, so create it
-					_, err := c.createPodTemplate(ctx, wl, ptName, mergedPodSet.PodSet, mergedPodSet.PodSetAssignment)
+					_, err := c.createPodTemplate(ctx, wl, requestName, ptName, mergedPodSet.PodSet, mergedPodSet.PodSetAssignment)
 					if err != nil {
 						msg := fmt.Sprintf("Error creating PodTemplate %q: %v", ptName, err)
 						return c.handleError(ctx, wl, ac, pt, msg, err)
@@ -392,7 +392,7 @@ func (c *Controller) isMissingInCache(ctx context.Context, obj client.Object) bo
 	return apierrors.IsNotFound(c.client.Get(ctx, client.ObjectKeyFromObject(obj), obj))
 }
 
-func (c *Controller) createPodTemplate(ctx context.Context, wl *kueue.Workload, name string, ps *kueue.PodSet, psa *kueue.PodSetAssignment) (*corev1.PodTemplate, error) {
+func (c *Controller) createPodTemplate(ctx context.Context, wl *kueue.Workload, prName, name string, ps *kueue.PodSet, psa *kueue.PodSetAssignment) (*corev1.PodTemplate, error) {
 	newPt := &corev1.PodTemplate{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -425,6 +425,10 @@ func (c *Controller) createPodTemplate(ctx context.Context, wl *kueue.Workload,
 	// copy limits to requests if needed
 	workload.UseLimitsAsMissingRequestsInPod(&newPt.Template.Spec)
 
+	if features.Enabled(features.TASProvisioningRequestTopology) {
+		applyTopologyRequest(newPt, prName, ps)
+	}
+
 	if err := c.client.Create(ctx, newPt); err != nil {
 		return nil, err
 	}
@@ -708,6 +712,7 @@ func podSetUpdates(log logr.Logger, wl *kueue.Workload, pr *autoscaling.Provisio
 	refMap := slices.ToMap(podSets, func(i int) (string, kueue.PodSetReference) {
 		return getProvisioningRequestPodTemplateName(pr.Name, podSets[i].Name), podSets[i].Name
 	})
+	podSetMap := slices.ToRefMap(podSets, func(ps *kueue.PodSet) kueue.PodSetReference { return ps.Name })
 	return slices.Map(pr.Spec.PodSets, func(ps *autoscaling.PodSet) kueue.PodSetUpdate {
 		podSetUpdate := kueue.PodSetUpdate{
 			Name: refMap[ps.PodTemplateRef.Name],
@@ -727,6 +732,18 @@ func podSetUpdates(log logr.Logger, wl *kueue.Workload, pr *autoscaling.Provisio
 				podSetUpdate.NodeSelector[nodeSelector.Key] = string(value)
 			}
 		}
+		if features.Enabled(features.TASProvisioningRequestTopology) {
+			if ps, found := podSetMap[podSetUpdate.Name]; found {
+				if key, value, ok := provisionedTopologyDomain(ps, pr); ok {
+					if podSetUpdate.NodeSelector == nil {
+						podSetUpdate.NodeSelector = make(map[string]string, 1)
+					}
+					if _, set := podSetUpdate.NodeSelector[key]; !set {
+						podSetUpdate.NodeSelector[key] = value
+					}
+				}
+			}
+		}
 		return podSetUpdate
 	})
 }
@@ -982,6 +999,10 @@ func mergePodSets(
 }
 
 func canMergePodSets(ps1, ps2 *kueue.PodSet, mergePolicy *kueue.ProvisioningRequestConfigPodSetMergePolicy) bool {
+	if features.Enabled(features.TASProvisioningRequestTopology) && !equality.Semantic.DeepEqual(ps1.TopologyRequest, ps2.TopologyRequest) {
+		// PodSets with different topology requests can't share the pod affinity of a PodTemplate
+		return false
+	}
 	switch *mergePolicy {
 	case kueue.IdenticalPodTemplates:
 		return equality.Semantic.DeepEqual(ps1.Template, ps2.Template)
@@ -291,6 +291,10 @@ func TestReconcile(t *testing.T) {
 
 	basePodSet := []autoscaling.PodSet{{PodTemplateRef: autoscaling.Reference{Name: "ppt-wl-check1-1-main"}, Count: 1}}
 
+	topologyWorkload := baseWorkload.DeepCopy()
+	topologyWorkload.Spec.PodSets[0].TopologyRequest = &kueue.PodSetTopologyRequest{Required: new("rack")}
+	topologyWorkload.Spec.PodSets[1].TopologyRequest = &kueue.PodSetTopologyRequest{Preferred: new("block")}
+
 	baseWorkloadWithCheck1Ready := baseWorkload.DeepCopy()
 	workloadpatching.SetAdmissionCheckState(&baseWorkloadWithCheck1Ready.Status.AdmissionChecks, kueue.AdmissionCheckState{
 		Name:  "check1",
@@ -529,6 +533,81 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"with topology request and TASProvisioningRequestTopology enabled": {
+			workload: topologyWorkload.DeepCopy(),
+			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
+			flavors:  []kueue.ResourceFlavor{*baseFlavor1.DeepCopy(), *baseFlavor2.DeepCopy()},
+			configs:  []kueue.ProvisioningRequestConfig{*baseConfigWithRetryStrategy.DeepCopy()},
+			featureGates: map[featuregate.Feature]bool{
+				features.TASProvisioningRequestTopology: true,
+			},
+			wantRequests: map[string]*autoscaling.ProvisioningRequest{
+				baseRequest.Name: baseRequest.DeepCopy(),
+			},
+			wantTemplates: map[string]*corev1.PodTemplate{
+				baseTemplate1.Name: baseTemplate1.Clone().
+					TemplateLabel(TopologyGroupLabel, topologyGroup("wl-check1-1", &topologyWorkload.Spec.PodSets[0])).
+					PodAffinity(&corev1.PodAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									TopologyGroupLabel: topologyGroup("wl-check1-1", &topologyWorkload.Spec.PodSets[0]),
+								},
+							},
+							TopologyKey: "rack",
+						}},
+					}).
+					ControllerReference(autoscaling.SchemeGroupVersion.WithKind("ProvisioningRequest"), "wl-check1-1", "").
+					Obj(),
+				baseTemplate2.Name: baseTemplate2.Clone().
+					TemplateLabel(TopologyGroupLabel, topologyGroup("wl-check1-1", &topologyWorkload.Spec.PodSets[1])).
+					PodAffinity(&corev1.PodAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+							Weight: 100,
+							PodAffinityTerm: corev1.PodAffinityTerm{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{
+										TopologyGroupLabel: topologyGroup("wl-check1-1", &topologyWorkload.Spec.PodSets[1]),
+									},
+								},
+								TopologyKey: "block",
+							},
+						}},
+					}).
+					ControllerReference(autoscaling.SchemeGroupVersion.WithKind("ProvisioningRequest"), "wl-check1-1", "").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       client.ObjectKeyFromObject(topologyWorkload),
+					EventType: corev1.EventTypeNormal,
+					Reason:    "ProvisioningRequestCreated",
+					Message:   `Created ProvisioningRequest: "wl-check1-1"`,
+				},
+			},
+		},
+		"with topology request and TASProvisioningRequestTopology disabled": {
+			workload: topologyWorkload.DeepCopy(),
+			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
+			flavors:  []kueue.ResourceFlavor{*baseFlavor1.DeepCopy(), *baseFlavor2.DeepCopy()},
+			configs:  []kueue.ProvisioningRequestConfig{*baseConfigWithRetryStrategy.DeepCopy()},
+			wantTemplates: map[string]*corev1.PodTemplate{
+				baseTemplate1.Name: baseTemplate1.Clone().
+					ControllerReference(autoscaling.SchemeGroupVersion.WithKind("ProvisioningRequest"), "wl-check1-1", "").
+					Obj(),
+				baseTemplate2.Name: baseTemplate2.Clone().
+					ControllerReference(autoscaling.SchemeGroupVersion.WithKind("ProvisioningRequest"), "wl-check1-1", "").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       client.ObjectKeyFromObject(topologyWorkload),
+					EventType: corev1.EventTypeNormal,
+					Reason:    "ProvisioningRequestCreated",
+					Message:   `Created ProvisioningRequest: "wl-check1-1"`,
+				},
+			},
+		},
 		"with only zero-count PodSets": {
 			workload: allZeroCountWorkload.DeepCopy(),
 			requests: []autoscaling.ProvisioningRequest{
@@ -966,6 +1045,64 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"when request is provisioned in the requested topology domain": {
+			workload: topologyWorkload.DeepCopy(),
+			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
+			flavors:  []kueue.ResourceFlavor{*baseFlavor1.DeepCopy(), *baseFlavor2.DeepCopy()},
+			configs:  []kueue.ProvisioningRequestConfig{*baseConfigWithRetryStrategy.DeepCopy()},
+			featureGates: map[featuregate.Feature]bool{
+				features.TASProvisioningRequestTopology: true,
+			},
+			requests: []autoscaling.ProvisioningRequest{
+				func() autoscaling.ProvisioningRequest {
+					pr := *requestWithCondition(baseRequest, autoscaling.Provisioned, metav1.ConditionTrue)
+					pr.Status.ProvisioningClassDetails = map[string]autoscaling.Detail{
+						"rack": "r1",
+					}
+					return pr
+				}(),
+			},
+			templates: []corev1.PodTemplate{*baseTemplate1.DeepCopy(), *baseTemplate2.DeepCopy()},
+			wantWorkloads: map[string]*kueue.Workload{
+				topologyWorkload.GetName(): (&utiltestingapi.WorkloadWrapper{Workload: *topologyWorkload.DeepCopy()}).
+					AdmissionChecks(kueue.AdmissionCheckState{
+						Name:    "check1",
+						Message: "By test",
+						State:   kueue.CheckStateReady,
+						PodSetUpdates: []kueue.PodSetUpdate{
+							{
+								Name: "ps1",
+								Annotations: map[string]string{
+									autoscaling.ProvisioningRequestPodAnnotationKey: "wl-check1-1",
+									autoscaling.ProvisioningClassPodAnnotationKey:   "class1",
+								},
+								NodeSelector: map[string]string{
+									"rack": "r1",
+								},
+							},
+							{
+								Name: "ps2",
+								Annotations: map[string]string{
+									autoscaling.ProvisioningRequestPodAnnotationKey: "wl-check1-1",
+									autoscaling.ProvisioningClassPodAnnotationKey:   "class1",
+								},
+							},
+						},
+					}, kueue.AdmissionCheckState{
+						Name:  "not-provisioning",
+						State: kueue.CheckStatePending,
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       client.ObjectKeyFromObject(topologyWorkload),
+					EventType: corev1.EventTypeNormal,
+					Reason:    "AdmissionCheckUpdated",
+					Message:   `Admission check check1 updated state from Pending to Ready with message: By test`,
+				},
+			},
+		},
 		"when no request is needed": {
 			workload: baseWorkload.DeepCopy(),
 			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
@@ -0,0 +1,117 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	autoscaling "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	// TopologyGroupLabel is set on the PodTemplates of a ProvisioningRequest
+	// to select the pods that should be provisioned in a single topology domain.
+	TopologyGroupLabel = "kueue.x-k8s.io/provisioning-topology-group"
+
+	topologyGroupHashLength = 16
+)
+
+// podSetTopologyLevel returns the topology level requested by the PodSet,
+// and whether the level is required, or an empty string if the PodSet does
+// not request a topology level.
+func podSetTopologyLevel(ps *kueue.PodSet) (string, bool) {
+	if ps.TopologyRequest == nil {
+		return "", false
+	}
+	if ps.TopologyRequest.Required != nil {
+		return *ps.TopologyRequest.Required, true
+	}
+	if ps.TopologyRequest.Preferred != nil {
+		return *ps.TopologyRequest.Preferred, false
+	}
+	return "", false
+}
+
+// topologyGroup returns the value of TopologyGroupLabel for the PodSet. The
+// PodSets of the same PodSetGroup share the value so that they are
+// provisioned in the same topology domain.
+func topologyGroup(prName string, ps *kueue.PodSet) string {
+	group := string(ps.Name)
+	if ps.TopologyRequest.PodSetGroupName != nil {
+		group = *ps.TopologyRequest.PodSetGroupName
+	}
+	h := sha1.New()
+	h.Write([]byte(prName + "/" + group))
+	return hex.EncodeToString(h.Sum(nil))[:topologyGroupHashLength]
+}
+
+// applyTopologyRequest adds a pod affinity on the requested topology level
+// to the PodTemplate, so that the autoscaler provisions the pods of the
+// PodSet in a single topology domain.
+func applyTopologyRequest(pt *corev1.PodTemplate, prName string, ps *kueue.PodSet) {
+	level, required := podSetTopologyLevel(ps)
+	if level == "" {
+		return
+	}
+	group := topologyGroup(prName, ps)
+	if pt.Template.Labels == nil {
+		pt.Template.Labels = make(map[string]string, 1)
+	}
+	pt.Template.Labels[TopologyGroupLabel] = group
+
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{TopologyGroupLabel: group},
+		},
+		TopologyKey: level,
+	}
+	if pt.Template.Spec.Affinity == nil {
+		pt.Template.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pt.Template.Spec.Affinity.PodAffinity == nil {
+		pt.Template.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	podAffinity := pt.Template.Spec.Affinity.PodAffinity
+	if required {
+		podAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+	} else {
+		podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution, corev1.WeightedPodAffinityTerm{
+			Weight:          100,
+			PodAffinityTerm: term,
+		})
+	}
+}
+
+// provisionedTopologyDomain returns the node selector pinning the PodSet to
+// the topology domain reported by the autoscaler in the ProvisioningClassDetails
+// under the key of the requested topology level.
+func provisionedTopologyDomain(ps *kueue.PodSet, pr *autoscaling.ProvisioningRequest) (string, string, bool) {
+	level, _ := podSetTopologyLevel(ps)
+	if level == "" {
+		return "", "", false
+	}
+	value, ok := pr.Status.ProvisioningClassDetails[level]
+	if !ok || value == "" {
+		return "", "", false
+	}
+	return level, string(value), true
+}
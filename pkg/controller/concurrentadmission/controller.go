@@ -428,7 +428,8 @@ func (r *variantReconciler) syncVariantEvictionStatus(ctx context.Context, paren
 func (r *variantReconciler) clearWorkloadAdmission(ctx context.Context, wl *kueue.Workload, evCond *metav1.Condition) error {
 	return workloadpatching.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func(w *kueue.Workload) (bool, error) {
 		setRequeued := (evCond.Reason == kueue.WorkloadEvictedByPreemption) ||
-			(evCond.Reason == kueue.WorkloadEvictedDueToNodeFailures)
+			(evCond.Reason == kueue.WorkloadEvictedDueToNodeFailures) ||
+			(evCond.Reason == kueue.WorkloadEvictedByTASDefragmentation)
 		updated := workload.SetRequeuedCondition(w, evCond.Reason, evCond.Message, setRequeued)
 		reason := workload.UnadmittedWorkloadReasonWithFallback(
 			kueue.WorkloadQuotaReservedReasonPendingEvaluation,
@@ -58,6 +58,25 @@ const (
 	// MaxExecTimeSecondsLabel is the label key in the job that holds the maximum execution time.
 	MaxExecTimeSecondsLabel = `kueue.x-k8s.io/max-exec-time-seconds`
 
+	// PreemptionGracePeriodSecondsLabel is the label key in the job that holds the time
+	// the workload is given to checkpoint before it's evicted by a preemption.
+	PreemptionGracePeriodSecondsLabel = `kueue.x-k8s.io/preemption-grace-period-seconds`
+
+	// DependsOnAnnotation is the annotation key in the job that holds a
+	// comma-separated list of the names of the Workloads, in the namespace of the
+	// job, which must finish successfully before its Workload is admitted.
+	DependsOnAnnotation = "kueue.x-k8s.io/depends-on"
+
+	// CheckpointAcknowledgedAnnotation is the annotation key in the job or the workload
+	// which acknowledges that the workload checkpointed after being notified of its
+	// preemption, so it can be evicted before the end of its grace period.
+	CheckpointAcknowledgedAnnotation = "kueue.x-k8s.io/checkpoint-acknowledged"
+
+	// PreemptionNoticeAnnotation is the annotation key set on the pods of a workload
+	// notified of its preemption. The value is the time, in RFC 3339 format, at which
+	// the workload is evicted unless it acknowledges the checkpoint earlier.
+	PreemptionNoticeAnnotation = "kueue.x-k8s.io/preemption-notice"
+
 	// SafeToForcefullyDeleteAnnotationKey is the annotation key that controls whether a pod opted in to FailureRecoveryPolicy.
 	SafeToForcefullyDeleteAnnotationKey = "kueue.x-k8s.io/safe-to-forcefully-delete"
 	// SafeToForcefullyDeleteAnnotationValue is the value of that annotation that enables FailureRecoveryPolicy for that pod.
@@ -86,6 +105,21 @@ const (
 	// Positive values increase priority; negative values decrease it.
 	PriorityBoostAnnotationKey = "kueue.x-k8s.io/priority-boost"
 
+	// DeadlineAnnotationKey is the annotation key on a Workload, or the job
+	// owning it, that holds the time in RFC 3339 format by which the Workload
+	// should finish. It is used by the EarliestDeadlineFirst queueing strategy.
+	DeadlineAnnotationKey = "kueue.x-k8s.io/deadline"
+
+	// QuotaReservationAnnotationKey is the annotation key on a Workload, or
+	// the job owning it, that holds the name of the QuotaReservation whose
+	// reserved quota the Workload can use.
+	QuotaReservationAnnotationKey = "kueue.x-k8s.io/quota-reservation"
+
+	// ProvisionedByClusterQueueLabel is the label key on a LocalQueue
+	// provisioned from the localQueueTemplate of a ClusterQueue, that holds
+	// the name of the ClusterQueue.
+	ProvisionedByClusterQueueLabel = "kueue.x-k8s.io/provisioned-by-cluster-queue"
+
 	// WorkloadAllowedResourceFlavorAnnotation is an annotation used with ConcurrentAdmission feature
 	// It's set on a Workload level that defines which ResourceFlavors can be assigned to this Workload by Kueue scheduler.
 	// The value is a comma-separated list of resource flavor names (e.g., "reservation,spot").
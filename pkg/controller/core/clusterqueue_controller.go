@@ -21,6 +21,7 @@ import (
 	"iter"
 	"math"
 	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -210,12 +211,78 @@ func (r *ClusterQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	var result ctrl.Result
+	if features.Enabled(features.QuotaSchedules) && cqObj.DeletionTimestamp.IsZero() {
+		result.RequeueAfter = r.applyQuotaSchedules(log, kueue.ClusterQueueReference(cqObj.Name))
+	}
+	if features.Enabled(features.PreemptionBudgets) && cqObj.DeletionTimestamp.IsZero() {
+		if requeueAfter := r.prunePreemptionBudget(log, kueue.ClusterQueueReference(cqObj.Name)); requeueAfter > 0 &&
+			(result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = requeueAfter
+		}
+	}
+
+	if features.Enabled(features.PriorityDecay) && cqObj.DeletionTimestamp.IsZero() {
+		if requeueAfter := r.applyPriorityDecay(log, kueue.ClusterQueueReference(cqObj.Name)); requeueAfter > 0 &&
+			(result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = requeueAfter
+		}
+	}
+
 	newCQObj := cqObj.DeepCopy()
 	cqCondition, reason, msg := r.cache.ClusterQueueReadiness(kueue.ClusterQueueReference(newCQObj.Name))
 	if err := r.updateCqStatusIfChanged(ctx, newCQObj, cqCondition, reason, msg); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// applyQuotaSchedules switches the quotas of the ClusterQueue to the ones in
+// effect at the current time, and returns the time until the next window
+// starts or ends.
+func (r *ClusterQueueReconciler) applyQuotaSchedules(log logr.Logger, cqName kueue.ClusterQueueReference) time.Duration {
+	changed, requeueAfter, err := r.cache.ApplyClusterQueueQuotaSchedules(cqName)
+	if err != nil {
+		log.Error(err, "Failed to apply quota schedules")
+	}
+	if changed {
+		log.V(2).Info("Quotas changed by quota schedules")
+		qcache.NotifyRetryInadmissible(r.qManager, sets.New(cqName))
+		if r.reportResourceMetrics {
+			r.cache.RecordClusterQueueResourceMetrics(log, cqName)
+		}
+	}
+	return requeueAfter
+}
+
+// prunePreemptionBudget releases the preemptions which fell out of the window
+// of the preemption budget of the ClusterQueue, and returns the time until the
+// next one does.
+func (r *ClusterQueueReconciler) prunePreemptionBudget(log logr.Logger, cqName kueue.ClusterQueueReference) time.Duration {
+	pruned, requeueAfter, err := r.cache.PrunePreemptionRecords(cqName)
+	if err != nil {
+		log.Error(err, "Failed to prune the preemption budget")
+	}
+	if pruned {
+		log.V(3).Info("Preemption budget released")
+		qcache.NotifyRetryInadmissible(r.qManager, sets.New(cqName))
+	}
+	return requeueAfter
+}
+
+// applyPriorityDecay retries the inadmissible workloads of the ClusterQueue
+// once the effective priority of its admitted workloads decays, as they may
+// be preempted now, and returns the time until the next decay step.
+func (r *ClusterQueueReconciler) applyPriorityDecay(log logr.Logger, cqName kueue.ClusterQueueReference) time.Duration {
+	decayed, requeueAfter, err := r.cache.ApplyPriorityDecay(cqName)
+	if err != nil {
+		log.Error(err, "Failed to apply the priority decay")
+	}
+	if decayed {
+		log.V(3).Info("Priority of admitted workloads decayed")
+		qcache.NotifyRetryInadmissible(r.qManager, sets.New(cqName))
+	}
+	return requeueAfter
 }
 
 // NotifyTopologyUpdate triggers a topology update event only on creation or deletion,
@@ -557,6 +624,20 @@ func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 	} else {
 		cq.Status.FairSharing = nil
 	}
+	cq.Status.PreemptionBudget = nil
+	if features.Enabled(features.PreemptionBudgets) {
+		budgetStatus, err := r.cache.PreemptionBudgetStatus(kueue.ClusterQueueReference(cq.Name))
+		if err != nil {
+			log.Error(err, "Failed getting preemption budget from cache")
+			return err
+		}
+		cq.Status.PreemptionBudget = budgetStatus
+		if budgetStatus != nil {
+			metrics.ReportPreemptionBudgetPreemptedWorkloads(kueue.ClusterQueueReference(cq.Name), budgetStatus.PreemptedWorkloads, r.customLabels.CQGet(kueue.ClusterQueueReference(cq.Name)), r.roleTracker)
+		} else {
+			metrics.ClearPreemptionBudgetMetrics(cq.Name)
+		}
+	}
 	if !equality.Semantic.DeepEqual(cq.Status, oldStatus) {
 		return r.client.Status().Update(ctx, cq)
 	}
@@ -202,6 +202,19 @@ func (r *CohortReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		// Fail fast to avoid queue/status updates from a stale cache state.
 		return ctrl.Result{}, err
 	}
+	var result ctrl.Result
+	if features.Enabled(features.QuotaSchedules) {
+		changed, requeueAfter, err := r.cache.ApplyCohortQuotaSchedules(kueue.CohortReference(cohort.Name))
+		if err != nil {
+			log.Error(err, "Failed to apply quota schedules")
+		}
+		if changed {
+			log.V(2).Info("Quotas changed by quota schedules")
+		}
+		result.RequeueAfter = requeueAfter
+	}
+	// Requeues the inadmissible workloads of the Cohort tree, which
+	// may fit after quota changes.
 	r.qManager.AddOrUpdateCohort(ctx, &cohort)
 	if labelsUpdated {
 		metrics.ClearCohortMetrics(kueue.CohortReference(req.Name))
@@ -211,7 +224,7 @@ func (r *CohortReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	err := r.updateCohortStatusIfChanged(ctx, &cohort)
-	return ctrl.Result{}, client.IgnoreNotFound(err)
+	return result, client.IgnoreNotFound(err)
 }
 
 func (r *CohortReconciler) updateCohortStatusIfChanged(ctx context.Context, cohort *kueue.Cohort) error {
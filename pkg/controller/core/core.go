@@ -20,6 +20,8 @@ import (
 	"time"
 
 	resourcev1 "k8s.io/api/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -134,6 +136,34 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 		}
 	}
 
+	if features.Enabled(features.QuotaReservations) {
+		qrRec := NewQuotaReservationReconciler(mgr.GetClient(), cc, qManager, opts.RoleTracker)
+		if err := qrRec.SetupWithManager(mgr, cfg); err != nil {
+			return "QuotaReservation", err
+		}
+	}
+
+	if features.Enabled(features.WorkloadRightSizing) {
+		rsRec := NewRightSizingReconciler(mgr.GetClient(), mgr.GetAPIReader(), opts.RoleTracker)
+		if err := rsRec.SetupWithManager(mgr, cfg); err != nil {
+			return "RightSizing", err
+		}
+	}
+
+	if features.Enabled(features.LocalQueueProvisioning) {
+		var nsSelector labels.Selector
+		if cfg.ManagedJobsNamespaceSelector != nil {
+			var err error
+			if nsSelector, err = metav1.LabelSelectorAsSelector(cfg.ManagedJobsNamespaceSelector); err != nil {
+				return "LocalQueueProvisioning", err
+			}
+		}
+		lqpRec := NewLocalQueueProvisioningReconciler(mgr.GetClient(), mgr.GetEventRecorder(constants.LocalQueueProvisioningName), nsSelector, opts.RoleTracker)
+		if err := lqpRec.SetupWithManager(mgr, cfg); err != nil {
+			return "LocalQueueProvisioning", err
+		}
+	}
+
 	qManager.AddTopologyUpdateWatcher(cqRec)
 	qManager.AddWorkloadUpdateWatcher(qRec)
 	qManager.AddWorkloadUpdateWatcher(cqRec)
@@ -46,6 +46,7 @@ const (
 	WorkloadPriorityClassKey             = "spec.priorityClassRef"
 	DeviceClassExtendedResourceNameIndex = "spec.extendedResourceName"
 	WorkloadExtendedResourceKey          = "spec.extendedResources"
+	WorkloadDependsOnKey                 = "spec.dependsOn"
 	// WorkloadSliceNameKey is an index for pods by their workload slice name annotation.
 	// Used to find pods belonging to an elastic workload slice chain.
 	WorkloadSliceNameKey = "metadata.workloadSliceName"
@@ -126,6 +127,14 @@ func IndexWorkloadClusterQueue(obj client.Object) []string {
 	return []string{string(wl.Status.Admission.ClusterQueue)}
 }
 
+func IndexWorkloadDependsOn(obj client.Object) []string {
+	wl, ok := obj.(*kueue.Workload)
+	if !ok || len(wl.Spec.DependsOn) == 0 {
+		return nil
+	}
+	return slices.Map(wl.Spec.DependsOn, func(dep *kueue.WorkloadDependency) string { return dep.Name })
+}
+
 func IndexLimitRangeHasContainerOrPodType(obj client.Object) []string {
 	lr, ok := obj.(*corev1.LimitRange)
 	if !ok {
@@ -283,9 +292,15 @@ func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, OwnerReferenceUID, IndexOwnerUID); err != nil {
 		return fmt.Errorf("setting index on ownerReferences.uid for Workload: %w", err)
 	}
-	// Add pod indexes for elastic-jobs and TAS. Uses workload slice name annotation to support
+	if features.Enabled(features.WorkloadDependencies) {
+		if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadDependsOnKey, IndexWorkloadDependsOn); err != nil {
+			return fmt.Errorf("setting index on dependsOn for Workload: %w", err)
+		}
+	}
+	// Add pod indexes for elastic-jobs, TAS and right-sizing. Uses workload slice name annotation to support
 	// JobSet and other workloads where pods are not immediate children of the job.
-	if features.Enabled(features.ElasticJobsViaWorkloadSlices) || features.Enabled(features.TopologyAwareScheduling) {
+	if features.Enabled(features.ElasticJobsViaWorkloadSlices) || features.Enabled(features.TopologyAwareScheduling) ||
+		features.Enabled(features.WorkloadRightSizing) {
 		if err := indexer.IndexField(ctx, &corev1.Pod{}, WorkloadSliceNameKey, IndexPodWorkloadSliceName); err != nil {
 			return fmt.Errorf("setting index on workloadSliceName for Pod: %w", err)
 		}
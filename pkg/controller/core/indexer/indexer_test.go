@@ -611,6 +611,39 @@ func TestIndexWorkloadPriorityClass(t *testing.T) {
 	}
 }
 
+func TestIndexWorkloadDependsOn(t *testing.T) {
+	cases := map[string]struct {
+		obj  client.Object
+		want []string
+	}{
+		"non-Workload returns nil": {
+			obj:  makeLocalQueue("lq", "ns", "cq"),
+			want: nil,
+		},
+		"workload without dependencies returns nil": {
+			obj:  makeWorkload("wl", "ns"),
+			want: nil,
+		},
+		"workload with dependencies": {
+			obj: func() client.Object {
+				wl := makeWorkload("wl", "ns")
+				wl.Spec.DependsOn = []kueue.WorkloadDependency{{Name: "preprocess"}, {Name: "train"}}
+				return wl
+			}(),
+			want: []string{"preprocess", "train"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IndexWorkloadDependsOn(tc.obj)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIndexDeviceClassExtendedResourceName(t *testing.T) {
 	extName := "example.com/gpu"
 	empty := ""
@@ -0,0 +1,191 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+)
+
+const (
+	// budgetSamplingInterval is the minimum time between two updates of the
+	// consumption of a LocalQueue budget.
+	budgetSamplingInterval = time.Minute
+
+	budgetExhaustedReason = "ResourceHoursExhausted"
+	budgetAvailableReason = "BudgetAvailable"
+)
+
+// budgetPeriodStart returns the start of the period containing now.
+func budgetPeriodStart(period kueue.BudgetPeriod, now time.Time) time.Time {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case kueue.BudgetPeriodDaily:
+		return day
+	case kueue.BudgetPeriodWeekly:
+		// Weeks start on Monday.
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// budgetPeriodEnd returns the end of the period starting at start.
+func budgetPeriodEnd(period kueue.BudgetPeriod, start time.Time) time.Time {
+	switch period {
+	case kueue.BudgetPeriodDaily:
+		return start.AddDate(0, 0, 1)
+	case kueue.BudgetPeriodWeekly:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// accountBudget returns the budget status after the given usage was consumed
+// since the last update. The consumption is reset when a new period starts,
+// accounting the usage from the start of the new period.
+func accountBudget(budget *kueue.LocalQueueBudget, status *kueue.LocalQueueBudgetStatus, usage corev1.ResourceList, now time.Time) *kueue.LocalQueueBudgetStatus {
+	periodStart := budgetPeriodStart(budget.Period, now)
+	var consumed corev1.ResourceList
+	since := periodStart
+	if status != nil && status.PeriodStart.Time.Equal(periodStart) {
+		consumed = status.ConsumedResourceHours
+		since = status.LastUpdate.Time
+	}
+	if elapsed := now.Sub(since); elapsed > 0 {
+		consumed = utilresource.MergeResourceListKeepSum(consumed, utilresource.MulByFloat(usage, elapsed.Hours()))
+	}
+	remaining := make(corev1.ResourceList, len(budget.ResourceHours))
+	for name, limit := range budget.ResourceHours {
+		left := limit.DeepCopy()
+		if used, found := consumed[name]; found {
+			left.Sub(used)
+		}
+		if left.Sign() < 0 {
+			left.Set(0)
+		}
+		remaining[name] = left
+	}
+	return &kueue.LocalQueueBudgetStatus{
+		PeriodStart:            metav1.NewTime(periodStart),
+		ConsumedResourceHours:  consumed,
+		RemainingResourceHours: remaining,
+		LastUpdate:             metav1.NewTime(now),
+	}
+}
+
+// exhaustedBudgetResources returns the sorted names of the resources without
+// remaining budget.
+func exhaustedBudgetResources(status *kueue.LocalQueueBudgetStatus) []string {
+	var exhausted []string
+	for name, left := range status.RemainingResourceHours {
+		if left.Sign() <= 0 {
+			exhausted = append(exhausted, string(name))
+		}
+	}
+	slices.Sort(exhausted)
+	return exhausted
+}
+
+// reconcileBudget updates the consumption of the LocalQueue budget, the
+// BudgetExhausted condition, and the admission of its pending workloads.
+// It returns the time after which the consumption must be updated again.
+func (r *LocalQueueReconciler) reconcileBudget(ctx context.Context, lq *kueue.LocalQueue) (time.Duration, error) {
+	lqKey := utilqueue.Key(lq)
+	budget := lq.Spec.Budget
+	if budget == nil {
+		if lq.Status.Budget != nil || meta.FindStatusCondition(lq.Status.Conditions, kueue.LocalQueueBudgetExhausted) != nil {
+			lq.Status.Budget = nil
+			meta.RemoveStatusCondition(&lq.Status.Conditions, kueue.LocalQueueBudgetExhausted)
+			if err := r.client.Status().Update(ctx, lq); err != nil {
+				return 0, err
+			}
+		}
+		return 0, r.queues.SetLocalQueueBudgetExhausted(lqKey, "")
+	}
+
+	now := r.clock.Now()
+	periodStart := budgetPeriodStart(budget.Period, now)
+	untilPeriodEnd := budgetPeriodEnd(budget.Period, periodStart).Sub(now)
+	if status := lq.Status.Budget; status != nil && status.PeriodStart.Time.Equal(periodStart) {
+		// Enforce the sampling interval, so that self-triggered status
+		// updates don't cause a reconcile loop.
+		if sinceLastUpdate := now.Sub(status.LastUpdate.Time); sinceLastUpdate >= 0 && sinceLastUpdate < budgetSamplingInterval {
+			if err := r.queues.SetLocalQueueBudgetExhausted(lqKey, budgetExhaustedAction(lq)); err != nil {
+				return 0, err
+			}
+			return min(budgetSamplingInterval-sinceLastUpdate, untilPeriodEnd), nil
+		}
+	}
+
+	cacheLq, err := r.cache.GetCacheLocalQueue(lq.Spec.ClusterQueue, lqKey)
+	if err != nil {
+		return 0, err
+	}
+	lq.Status.Budget = accountBudget(budget, lq.Status.Budget, cacheLq.GetAdmittedUsage(), now)
+	if exhausted := exhaustedBudgetResources(lq.Status.Budget); len(exhausted) > 0 {
+		meta.SetStatusCondition(&lq.Status.Conditions, metav1.Condition{
+			Type:   kueue.LocalQueueBudgetExhausted,
+			Status: metav1.ConditionTrue,
+			Reason: budgetExhaustedReason,
+			Message: fmt.Sprintf("The budget of %s is exhausted until %s",
+				strings.Join(exhausted, ", "), budgetPeriodEnd(budget.Period, periodStart).Format(time.RFC3339)),
+			ObservedGeneration: lq.Generation,
+		})
+	} else {
+		meta.SetStatusCondition(&lq.Status.Conditions, metav1.Condition{
+			Type:               kueue.LocalQueueBudgetExhausted,
+			Status:             metav1.ConditionFalse,
+			Reason:             budgetAvailableReason,
+			Message:            "The budget is available",
+			ObservedGeneration: lq.Generation,
+		})
+	}
+	if err := r.client.Status().Update(ctx, lq); err != nil {
+		return 0, err
+	}
+	if err := r.queues.SetLocalQueueBudgetExhausted(lqKey, budgetExhaustedAction(lq)); err != nil {
+		return 0, err
+	}
+	return min(budgetSamplingInterval, untilPeriodEnd), nil
+}
+
+// budgetExhaustedAction returns the action taken on the pending workloads of
+// the LocalQueue according to its BudgetExhausted condition; empty if the
+// budget is available.
+func budgetExhaustedAction(lq *kueue.LocalQueue) kueue.BudgetExhaustedAction {
+	if lq.Spec.Budget == nil || !meta.IsStatusConditionTrue(lq.Status.Conditions, kueue.LocalQueueBudgetExhausted) {
+		return ""
+	}
+	if lq.Spec.Budget.WhenExhausted == "" {
+		return kueue.BudgetExhaustedBlock
+	}
+	return lq.Spec.Budget.WhenExhausted
+}
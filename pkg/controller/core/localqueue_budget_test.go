@@ -0,0 +1,344 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/test/util"
+)
+
+func TestBudgetPeriod(t *testing.T) {
+	// A Tuesday.
+	now := time.Date(2026, time.March, 10, 12, 30, 0, 0, time.UTC)
+	cases := map[string]struct {
+		period    kueue.BudgetPeriod
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		"daily": {
+			period:    kueue.BudgetPeriodDaily,
+			wantStart: time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		"weekly": {
+			period:    kueue.BudgetPeriodWeekly,
+			wantStart: time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.March, 16, 0, 0, 0, 0, time.UTC),
+		},
+		"monthly": {
+			period:    kueue.BudgetPeriodMonthly,
+			wantStart: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"unset defaults to monthly": {
+			wantStart: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotStart := budgetPeriodStart(tc.period, now)
+			if !gotStart.Equal(tc.wantStart) {
+				t.Errorf("Unexpected period start: got %v, want %v", gotStart, tc.wantStart)
+			}
+			if gotEnd := budgetPeriodEnd(tc.period, gotStart); !gotEnd.Equal(tc.wantEnd) {
+				t.Errorf("Unexpected period end: got %v, want %v", gotEnd, tc.wantEnd)
+			}
+		})
+	}
+}
+
+func TestAccountBudget(t *testing.T) {
+	day := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	budget := &kueue.LocalQueueBudget{
+		Period:        kueue.BudgetPeriodDaily,
+		ResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("10")},
+	}
+	usage := corev1.ResourceList{resourceGPU: resource.MustParse("4")}
+	cases := map[string]struct {
+		status     *kueue.LocalQueueBudgetStatus
+		now        time.Time
+		wantStatus *kueue.LocalQueueBudgetStatus
+	}{
+		"first update accounts from the period start": {
+			now: day.Add(2 * time.Hour),
+			wantStatus: &kueue.LocalQueueBudgetStatus{
+				PeriodStart:            metav1.NewTime(day),
+				ConsumedResourceHours:  corev1.ResourceList{resourceGPU: resource.MustParse("8")},
+				RemainingResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("2")},
+				LastUpdate:             metav1.NewTime(day.Add(2 * time.Hour)),
+			},
+		},
+		"accounts the usage since the last update": {
+			status: &kueue.LocalQueueBudgetStatus{
+				PeriodStart:           metav1.NewTime(day),
+				ConsumedResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("8")},
+				LastUpdate:            metav1.NewTime(day.Add(2 * time.Hour)),
+			},
+			now: day.Add(3 * time.Hour),
+			wantStatus: &kueue.LocalQueueBudgetStatus{
+				PeriodStart:            metav1.NewTime(day),
+				ConsumedResourceHours:  corev1.ResourceList{resourceGPU: resource.MustParse("12")},
+				RemainingResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("0")},
+				LastUpdate:             metav1.NewTime(day.Add(3 * time.Hour)),
+			},
+		},
+		"resets the consumption in a new period": {
+			status: &kueue.LocalQueueBudgetStatus{
+				PeriodStart:           metav1.NewTime(day.AddDate(0, 0, -1)),
+				ConsumedResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("20")},
+				LastUpdate:            metav1.NewTime(day.Add(-time.Hour)),
+			},
+			now: day.Add(30 * time.Minute),
+			wantStatus: &kueue.LocalQueueBudgetStatus{
+				PeriodStart:            metav1.NewTime(day),
+				ConsumedResourceHours:  corev1.ResourceList{resourceGPU: resource.MustParse("2")},
+				RemainingResourceHours: corev1.ResourceList{resourceGPU: resource.MustParse("8")},
+				LastUpdate:             metav1.NewTime(day.Add(30 * time.Minute)),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := accountBudget(budget, tc.status, usage, tc.now)
+			if diff := cmp.Diff(tc.wantStatus, got); diff != "" {
+				t.Errorf("Unexpected budget status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalQueueReconcileBudget(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	budget := func(action kueue.BudgetExhaustedAction) *kueue.LocalQueueBudget {
+		return &kueue.LocalQueueBudget{
+			Period:        kueue.BudgetPeriodMonthly,
+			ResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+			WhenExhausted: action,
+		}
+	}
+	exhaustedMsg := "The budget of cpu is exhausted until 2026-04-01T00:00:00Z"
+	cases := map[string]struct {
+		localQueue       *kueue.LocalQueue
+		wantLocalQueue   *kueue.LocalQueue
+		wantRequeueAfter time.Duration
+		wantBlocked      bool
+	}{
+		"budget available": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:           metav1.NewTime(monthStart),
+					ConsumedResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+					LastUpdate:            metav1.NewTime(now.Add(-30 * time.Minute)),
+				}).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Active(metav1.ConditionTrue).
+				ReservingWorkloads(1).
+				AdmittedWorkloads(1).
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("6")},
+					LastUpdate:             metav1.NewTime(now),
+				}).
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionFalse, budgetAvailableReason, "The budget is available", 0).
+				Obj(),
+			wantRequeueAfter: budgetSamplingInterval,
+		},
+		"budget exhausted blocks the workloads": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:           metav1.NewTime(monthStart),
+					ConsumedResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("9")},
+					LastUpdate:            metav1.NewTime(now.Add(-30 * time.Minute)),
+				}).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Active(metav1.ConditionTrue).
+				ReservingWorkloads(1).
+				AdmittedWorkloads(1).
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("11")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					LastUpdate:             metav1.NewTime(now),
+				}).
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionTrue, budgetExhaustedReason, exhaustedMsg, 0).
+				Obj(),
+			wantRequeueAfter: budgetSamplingInterval,
+			wantBlocked:      true,
+		},
+		"budget exhausted deprioritizes the workloads": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Budget(budget(kueue.BudgetExhaustedDeprioritize)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:           metav1.NewTime(monthStart),
+					ConsumedResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("9")},
+					LastUpdate:            metav1.NewTime(now.Add(-30 * time.Minute)),
+				}).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Active(metav1.ConditionTrue).
+				ReservingWorkloads(1).
+				AdmittedWorkloads(1).
+				Budget(budget(kueue.BudgetExhaustedDeprioritize)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("11")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					LastUpdate:             metav1.NewTime(now),
+				}).
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionTrue, budgetExhaustedReason, exhaustedMsg, 0).
+				Obj(),
+			wantRequeueAfter: budgetSamplingInterval,
+		},
+		"within the sampling interval": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("11")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					LastUpdate:             metav1.NewTime(now.Add(-20 * time.Second)),
+				}).
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionTrue, budgetExhaustedReason, exhaustedMsg, 0).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionTrue, budgetExhaustedReason, exhaustedMsg, 0).
+				Active(metav1.ConditionTrue).
+				ReservingWorkloads(1).
+				AdmittedWorkloads(1).
+				Budget(budget(kueue.BudgetExhaustedBlock)).
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("11")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					LastUpdate:             metav1.NewTime(now.Add(-20 * time.Second)),
+				}).
+				Obj(),
+			wantRequeueAfter: 40 * time.Second,
+			wantBlocked:      true,
+		},
+		"budget removed": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				BudgetStatus(&kueue.LocalQueueBudgetStatus{
+					PeriodStart:            metav1.NewTime(monthStart),
+					ConsumedResourceHours:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("11")},
+					RemainingResourceHours: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					LastUpdate:             metav1.NewTime(now.Add(-time.Hour)),
+				}).
+				Condition(kueue.LocalQueueBudgetExhausted, metav1.ConditionTrue, budgetExhaustedReason, exhaustedMsg, 0).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				Active(metav1.ConditionTrue).
+				ReservingWorkloads(1).
+				AdmittedWorkloads(1).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.LocalQueueBudgets, true)
+			clusterQueue := utiltestingapi.MakeClusterQueue("cq").
+				Active(metav1.ConditionTrue).
+				Obj()
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(clusterQueue, tc.localQueue).
+				WithStatusSubresource(clusterQueue, tc.localQueue).
+				Build()
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			cqCache := schdcache.New(cl)
+			if err := cqCache.AddClusterQueue(ctx, clusterQueue); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			_ = cqCache.AddLocalQueue(tc.localQueue)
+			cqCache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("wl", "default").
+				Queue("lq").
+				Request(corev1.ResourceCPU, "4").
+				SimpleReserveQuota("cq", "rf", now).
+				AdmittedAt(true, now).
+				Obj())
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+			if err := qManager.AddClusterQueue(ctx, clusterQueue); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := qManager.AddLocalQueue(ctx, tc.localQueue); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			reconciler := NewLocalQueueReconciler(cl, qManager, cqCache, WithClock(testingclock.NewFakeClock(now)))
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.localQueue)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", result.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			gotLocalQueue := &kueue.LocalQueue{}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.localQueue), gotLocalQueue); err != nil {
+				t.Fatalf("Could not get LocalQueue after reconcile: %v", err)
+			}
+			cmpOpts := cmp.Options{
+				cmpopts.EquateEmpty(),
+				util.IgnoreConditionTimestamps,
+				util.IgnoreObjectMetaResourceVersion,
+			}
+			if diff := cmp.Diff(tc.wantLocalQueue, gotLocalQueue, cmpOpts...); diff != "" {
+				t.Errorf("Unexpected LocalQueue after reconcile (-want,+got):\n%s", diff)
+			}
+			if gotBlocked := qManager.LocalQueueBudgetBlocked(utilqueue.Key(tc.localQueue)); gotBlocked != tc.wantBlocked {
+				t.Errorf("Unexpected blocked budget: got %v, want %v", gotBlocked, tc.wantBlocked)
+			}
+		})
+	}
+}
@@ -212,6 +212,14 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	var budgetRequeueAfter time.Duration
+	if features.Enabled(features.LocalQueueBudgets) {
+		var err error
+		if budgetRequeueAfter, err = r.reconcileBudget(ctx, &queueObj); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
 	if afs.Enabled(r.admissionFSConfig) {
 		lqKey := utilqueue.Key(&queueObj)
 		hadCache, entry := r.initializeAfsIfNeeded(&queueObj)
@@ -221,7 +229,7 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		// updates cause sub-millisecond reconciles where the decay math
 		// truncates CPU consumed resources to zero.
 		if interval := r.admissionFSConfig.UsageSamplingInterval.Duration; hadCache && sinceLastUpdate < interval && !r.queues.AfsUsageLedger.HasPendingPenalty(lqKey) {
-			return ctrl.Result{RequeueAfter: interval - sinceLastUpdate}, nil
+			return ctrl.Result{RequeueAfter: minRequeueAfter(interval-sinceLastUpdate, budgetRequeueAfter)}, nil
 		}
 		if err := r.reconcileConsumedUsage(ctx, &queueObj); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -229,9 +237,18 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if err := r.queues.RebuildClusterQueue(log, &cq, queueObj.Name); err != nil {
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: r.admissionFSConfig.UsageSamplingInterval.Duration}, nil
+		return ctrl.Result{RequeueAfter: minRequeueAfter(r.admissionFSConfig.UsageSamplingInterval.Duration, budgetRequeueAfter)}, nil
+	}
+	return ctrl.Result{RequeueAfter: budgetRequeueAfter}, nil
+}
+
+// minRequeueAfter returns the shortest of two requeue delays, where zero
+// means no requeue.
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 || b == 0 {
+		return max(a, b)
 	}
-	return ctrl.Result{}, nil
+	return min(a, b)
 }
 
 func (r *LocalQueueReconciler) Create(e event.TypedCreateEvent[*kueue.LocalQueue]) bool {
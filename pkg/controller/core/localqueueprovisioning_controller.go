@@ -0,0 +1,345 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload/finish"
+)
+
+// provisionedLocalQueueCleanupRetryPeriod is the period after which the
+// deletion of a provisioned LocalQueue that still has unfinished Workloads is
+// retried.
+const provisionedLocalQueueCleanupRetryPeriod = time.Minute
+
+// LocalQueueProvisioningReconciler provisions the LocalQueues of the
+// localQueueTemplate of the ClusterQueues, in the namespaces matching their
+// namespaceSelector, and deletes them once they are no longer needed.
+type LocalQueueProvisioningReconciler struct {
+	client                       client.Client
+	recorder                     events.EventRecorder
+	managedJobsNamespaceSelector labels.Selector
+	roleTracker                  *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*LocalQueueProvisioningReconciler)(nil)
+var _ predicate.TypedPredicate[*kueue.ClusterQueue] = (*LocalQueueProvisioningReconciler)(nil)
+
+func NewLocalQueueProvisioningReconciler(
+	client client.Client,
+	recorder events.EventRecorder,
+	managedJobsNamespaceSelector labels.Selector,
+	roleTracker *roletracker.RoleTracker,
+) *LocalQueueProvisioningReconciler {
+	return &LocalQueueProvisioningReconciler{
+		client:                       client,
+		recorder:                     recorder,
+		managedJobsNamespaceSelector: managedJobsNamespaceSelector,
+		roleTracker:                  roleTracker,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=clusterqueues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=localqueues,verbs=get;list;watch;create;update;patch;delete
+
+func (r *LocalQueueProvisioningReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var cq *kueue.ClusterQueue
+	var cqObj kueue.ClusterQueue
+	if err := r.client.Get(ctx, req.NamespacedName, &cqObj); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		cq = &cqObj
+	}
+
+	log.V(2).Info("Reconcile LocalQueue provisioning")
+	wanted, err := r.wantedLocalQueues(ctx, cq)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var provisioned kueue.LocalQueueList
+	if err := r.client.List(ctx, &provisioned, client.MatchingLabels{controllerconstants.ProvisionedByClusterQueueLabel: req.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var errs []error
+	var requeueAfter time.Duration
+	for i := range provisioned.Items {
+		lq := &provisioned.Items[i]
+		if _, found := wanted[types.NamespacedName{Namespace: lq.Namespace, Name: lq.Name}]; found {
+			delete(wanted, types.NamespacedName{Namespace: lq.Namespace, Name: lq.Name})
+			if err := r.updateLocalQueue(ctx, cq, lq); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		deleted, err := r.cleanupLocalQueue(ctx, cq, lq)
+		if err != nil {
+			errs = append(errs, err)
+		} else if !deleted {
+			requeueAfter = provisionedLocalQueueCleanupRetryPeriod
+		}
+	}
+	for key := range wanted {
+		if err := r.createLocalQueue(ctx, cq, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, errors.Join(errs...)
+}
+
+// wantedLocalQueues returns the LocalQueues the ClusterQueue should have
+// provisioned, from its localQueueTemplate.
+func (r *LocalQueueProvisioningReconciler) wantedLocalQueues(ctx context.Context, cq *kueue.ClusterQueue) (map[types.NamespacedName]struct{}, error) {
+	wanted := make(map[types.NamespacedName]struct{})
+	if cq == nil || !cq.DeletionTimestamp.IsZero() || cq.Spec.LocalQueueTemplate == nil || cq.Spec.NamespaceSelector == nil {
+		return wanted, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(cq.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+	var namespaces corev1.NamespaceList
+	if err := r.client.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	for _, ns := range namespaces.Items {
+		if !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if r.managedJobsNamespaceSelector != nil && !r.managedJobsNamespaceSelector.Matches(labels.Set(ns.Labels)) {
+			continue
+		}
+		wanted[types.NamespacedName{Namespace: ns.Name, Name: string(utilqueue.ProvisionedLocalQueueName(cq, ns.Name))}] = struct{}{}
+	}
+	return wanted, nil
+}
+
+func (r *LocalQueueProvisioningReconciler) createLocalQueue(ctx context.Context, cq *kueue.ClusterQueue, key types.NamespacedName) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("localQueue", key)
+	var existing kueue.LocalQueue
+	if err := r.client.Get(ctx, key, &existing); err == nil {
+		r.recorder.Eventf(cq, nil, corev1.EventTypeWarning, "LocalQueueExists", "ProvisionLocalQueue",
+			"Skipped provisioning LocalQueue %s, a LocalQueue not provisioned for the ClusterQueue already exists", klog.KRef(key.Namespace, key.Name))
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	lq := &kueue.LocalQueue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				controllerconstants.ProvisionedByClusterQueueLabel: cq.Name,
+			},
+		},
+		Spec: kueue.LocalQueueSpec{
+			ClusterQueue: kueue.ClusterQueueReference(cq.Name),
+		},
+	}
+	setLocalQueueSpecFromTemplate(&lq.Spec, cq.Spec.LocalQueueTemplate)
+	if err := r.client.Create(ctx, lq); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		r.recorder.Eventf(cq, nil, corev1.EventTypeWarning, "LocalQueueProvisioningFailed", "ProvisionLocalQueue",
+			"Failed to provision LocalQueue %s: %v", klog.KObj(lq), err)
+		return err
+	}
+	log.V(2).Info("Provisioned LocalQueue")
+	r.recorder.Eventf(cq, nil, corev1.EventTypeNormal, "LocalQueueProvisioned", "ProvisionLocalQueue",
+		"Provisioned LocalQueue %s", klog.KObj(lq))
+	return nil
+}
+
+// updateLocalQueue keeps the provisioned LocalQueue in sync with the
+// localQueueTemplate.
+func (r *LocalQueueProvisioningReconciler) updateLocalQueue(ctx context.Context, cq *kueue.ClusterQueue, lq *kueue.LocalQueue) error {
+	spec := lq.Spec.DeepCopy()
+	setLocalQueueSpecFromTemplate(spec, cq.Spec.LocalQueueTemplate)
+	if equality.Semantic.DeepEqual(*spec, lq.Spec) {
+		return nil
+	}
+	lq.Spec = *spec
+	if err := r.client.Update(ctx, lq); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Updated provisioned LocalQueue", "localQueue", klog.KObj(lq))
+	return nil
+}
+
+// cleanupLocalQueue deletes the provisioned LocalQueue which is no longer
+// wanted, once it has no unfinished Workloads. It returns whether the
+// LocalQueue is deleted.
+func (r *LocalQueueProvisioningReconciler) cleanupLocalQueue(ctx context.Context, cq *kueue.ClusterQueue, lq *kueue.LocalQueue) (bool, error) {
+	if !lq.DeletionTimestamp.IsZero() {
+		return true, nil
+	}
+	var workloads kueue.WorkloadList
+	if err := r.client.List(ctx, &workloads, client.InNamespace(lq.Namespace), client.MatchingFields{indexer.WorkloadQueueKey: lq.Name}); err != nil {
+		return false, err
+	}
+	for i := range workloads.Items {
+		if !finish.IsFinished(&workloads.Items[i]) {
+			ctrl.LoggerFrom(ctx).V(3).Info("Provisioned LocalQueue still has unfinished Workloads", "localQueue", klog.KObj(lq), "workload", klog.KObj(&workloads.Items[i]))
+			return false, nil
+		}
+	}
+	if err := r.client.Delete(ctx, lq); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Deleted provisioned LocalQueue", "localQueue", klog.KObj(lq))
+	if cq != nil {
+		r.recorder.Eventf(cq, nil, corev1.EventTypeNormal, "LocalQueueDeleted", "ProvisionLocalQueue",
+			"Deleted provisioned LocalQueue %s", klog.KObj(lq))
+	}
+	return true, nil
+}
+
+func setLocalQueueSpecFromTemplate(spec *kueue.LocalQueueSpec, template *kueue.LocalQueueTemplate) {
+	spec.StopPolicy = template.StopPolicy
+	if spec.StopPolicy == nil {
+		spec.StopPolicy = new(kueue.None)
+	}
+	spec.FairSharing = template.FairSharing
+}
+
+func (r *LocalQueueProvisioningReconciler) Create(event.TypedCreateEvent[*kueue.ClusterQueue]) bool {
+	return true
+}
+
+func (r *LocalQueueProvisioningReconciler) Delete(event.TypedDeleteEvent[*kueue.ClusterQueue]) bool {
+	return true
+}
+
+func (r *LocalQueueProvisioningReconciler) Update(e event.TypedUpdateEvent[*kueue.ClusterQueue]) bool {
+	// The status updates don't change the provisioned LocalQueues.
+	return e.ObjectOld.Generation != e.ObjectNew.Generation ||
+		!e.ObjectOld.DeletionTimestamp.Equal(e.ObjectNew.DeletionTimestamp)
+}
+
+func (r *LocalQueueProvisioningReconciler) Generic(event.TypedGenericEvent[*kueue.ClusterQueue]) bool {
+	return false
+}
+
+// provisioningNamespaceHandler enqueues the ClusterQueues having a
+// localQueueTemplate when a namespace is created, relabeled or deleted.
+type provisioningNamespaceHandler struct {
+	client client.Client
+}
+
+var _ handler.TypedEventHandler[*corev1.Namespace, reconcile.Request] = (*provisioningNamespaceHandler)(nil)
+
+func (h *provisioningNamespaceHandler) Create(ctx context.Context, _ event.TypedCreateEvent[*corev1.Namespace], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueClusterQueues(ctx, q)
+}
+
+func (h *provisioningNamespaceHandler) Update(ctx context.Context, e event.TypedUpdateEvent[*corev1.Namespace], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if equality.Semantic.DeepEqual(e.ObjectOld.Labels, e.ObjectNew.Labels) &&
+		e.ObjectOld.DeletionTimestamp.Equal(e.ObjectNew.DeletionTimestamp) {
+		return
+	}
+	h.enqueueClusterQueues(ctx, q)
+}
+
+func (h *provisioningNamespaceHandler) Delete(ctx context.Context, _ event.TypedDeleteEvent[*corev1.Namespace], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueClusterQueues(ctx, q)
+}
+
+func (h *provisioningNamespaceHandler) Generic(context.Context, event.TypedGenericEvent[*corev1.Namespace], workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+func (h *provisioningNamespaceHandler) enqueueClusterQueues(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	var cqs kueue.ClusterQueueList
+	if err := h.client.List(ctx, &cqs); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list ClusterQueues")
+		return
+	}
+	for _, cq := range cqs.Items {
+		if cq.Spec.LocalQueueTemplate != nil {
+			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{Name: cq.Name}}, constants.UpdatesBatchPeriod)
+		}
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LocalQueueProvisioningReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("localqueueprovisioning_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.ClusterQueue{},
+			&handler.TypedEnqueueRequestForObject[*kueue.ClusterQueue]{},
+			r,
+		)).
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&corev1.Namespace{},
+			&provisioningNamespaceHandler{client: r.client},
+		)).
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.LocalQueue{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, lq *kueue.LocalQueue) []reconcile.Request {
+				cqName, found := lq.Labels[controllerconstants.ProvisionedByClusterQueueLabel]
+				if !found {
+					return nil
+				}
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: cqName}}}
+			}),
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      new(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.SchemeGroupVersion.WithKind("ClusterQueue").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "localqueueprovisioning-reconciler"),
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.ClusterQueue{}, cfg))
+}
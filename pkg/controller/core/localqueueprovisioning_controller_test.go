@@ -0,0 +1,217 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestLocalQueueProvisioningReconcile(t *testing.T) {
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	fairSharing := &kueue.FairSharing{Weight: new(resource.MustParse("2"))}
+	template := kueue.LocalQueueTemplate{
+		NamePattern: "{clusterQueue}-queue",
+		FairSharing: fairSharing,
+	}
+	namespaces := []corev1.Namespace{
+		*utiltesting.MakeNamespaceWrapper("ns-a").Label("team", "a").Obj(),
+		*utiltesting.MakeNamespaceWrapper("ns-b").Label("team", "a").Label("kueue-managed", "false").Obj(),
+		*utiltesting.MakeNamespaceWrapper("ns-c").Label("team", "c").Obj(),
+	}
+	provisioned := func(ns string) *utiltestingapi.LocalQueueWrapper {
+		return utiltestingapi.MakeLocalQueue("cq-queue", ns).
+			ClusterQueue("cq").
+			Label(controllerconstants.ProvisionedByClusterQueueLabel, "cq").
+			StopPolicy(kueue.None)
+	}
+	unfinished := utiltestingapi.MakeWorkload("wl", "ns-c").Queue("cq-queue").Obj()
+	finished := utiltestingapi.MakeWorkload("wl", "ns-c").Queue("cq-queue").
+		Condition(metav1.Condition{
+			Type:   kueue.WorkloadFinished,
+			Status: metav1.ConditionTrue,
+			Reason: kueue.WorkloadFinishedReasonSucceeded,
+		}).
+		Obj()
+
+	cases := map[string]struct {
+		clusterQueue     *kueue.ClusterQueue
+		localQueues      []kueue.LocalQueue
+		workloads        []kueue.Workload
+		managedJobsNs    labels.Selector
+		wantLocalQueues  []kueue.LocalQueue
+		wantRequeueAfter time.Duration
+		wantEvents       []utiltesting.EventRecord
+	}{
+		"provision in the matching namespaces": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(teamSelector).
+				LocalQueueTemplate(template).
+				Obj(),
+			wantLocalQueues: []kueue.LocalQueue{
+				*provisioned("ns-a").FairSharing(fairSharing).Obj(),
+				*provisioned("ns-b").FairSharing(fairSharing).Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cq"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    "LocalQueueProvisioned",
+					Message:   "Provisioned LocalQueue ns-a/cq-queue",
+				},
+				{
+					Key:       types.NamespacedName{Name: "cq"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    "LocalQueueProvisioned",
+					Message:   "Provisioned LocalQueue ns-b/cq-queue",
+				},
+			},
+		},
+		"skip the namespaces not managed by kueue": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(teamSelector).
+				LocalQueueTemplate(template).
+				Obj(),
+			managedJobsNs: labels.SelectorFromSet(labels.Set{"kueue-managed": "false"}),
+			wantLocalQueues: []kueue.LocalQueue{
+				*provisioned("ns-b").FairSharing(fairSharing).Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cq"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    "LocalQueueProvisioned",
+					Message:   "Provisioned LocalQueue ns-b/cq-queue",
+				},
+			},
+		},
+		"sync the provisioned LocalQueues with the template": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(teamSelector).
+				LocalQueueTemplate(kueue.LocalQueueTemplate{
+					NamePattern: "{clusterQueue}-queue",
+					StopPolicy:  new(kueue.Hold),
+				}).
+				Obj(),
+			localQueues: []kueue.LocalQueue{
+				*provisioned("ns-a").FairSharing(fairSharing).Obj(),
+				*provisioned("ns-b").Obj(),
+			},
+			wantLocalQueues: []kueue.LocalQueue{
+				*provisioned("ns-a").StopPolicy(kueue.Hold).Obj(),
+				*provisioned("ns-b").StopPolicy(kueue.Hold).Obj(),
+			},
+		},
+		"don't take over an existing LocalQueue": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"team": "c"}}).
+				LocalQueueTemplate(template).
+				Obj(),
+			localQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("cq-queue", "ns-c").ClusterQueue("other").Obj(),
+			},
+			wantLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("cq-queue", "ns-c").ClusterQueue("other").Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cq"},
+					EventType: corev1.EventTypeWarning,
+					Reason:    "LocalQueueExists",
+					Message:   "Skipped provisioning LocalQueue ns-c/cq-queue, a LocalQueue not provisioned for the ClusterQueue already exists",
+				},
+			},
+		},
+		"delete the LocalQueue once the namespace no longer matches": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"team": "none"}}).
+				LocalQueueTemplate(template).
+				Obj(),
+			localQueues: []kueue.LocalQueue{*provisioned("ns-c").Obj()},
+			workloads:   []kueue.Workload{*finished},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cq"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    "LocalQueueDeleted",
+					Message:   "Deleted provisioned LocalQueue ns-c/cq-queue",
+				},
+			},
+		},
+		"keep the LocalQueue while it has unfinished workloads": {
+			clusterQueue: utiltestingapi.MakeClusterQueue("cq").
+				NamespaceSelector(teamSelector).
+				Obj(),
+			localQueues:      []kueue.LocalQueue{*provisioned("ns-c").Obj()},
+			workloads:        []kueue.Workload{*unfinished},
+			wantLocalQueues:  []kueue.LocalQueue{*provisioned("ns-c").Obj()},
+			wantRequeueAfter: provisionedLocalQueueCleanupRetryPeriod,
+		},
+		"delete the LocalQueues of a deleted ClusterQueue": {
+			localQueues: []kueue.LocalQueue{*provisioned("ns-a").Obj()},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder().
+				WithLists(&corev1.NamespaceList{Items: namespaces}, &kueue.LocalQueueList{Items: tc.localQueues}, &kueue.WorkloadList{Items: tc.workloads})
+			if tc.clusterQueue != nil {
+				builder = builder.WithObjects(tc.clusterQueue)
+			}
+			cl := builder.Build()
+			recorder := &utiltesting.EventRecorder{}
+			r := NewLocalQueueProvisioningReconciler(cl, recorder, tc.managedJobsNs, nil)
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "cq"}})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", result.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			var gotLocalQueues kueue.LocalQueueList
+			if err := cl.List(ctx, &gotLocalQueues, client.InNamespace("")); err != nil {
+				t.Fatalf("Unexpected error listing LocalQueues: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantLocalQueues, gotLocalQueues.Items, cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.SortSlices(func(a, b kueue.LocalQueue) bool { return a.Namespace < b.Namespace })); diff != "" {
+				t.Errorf("Unexpected LocalQueues (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEvents, recorder.RecordedEvents, cmpopts.EquateEmpty(), cmpopts.SortSlices(utiltesting.SortEvents)); diff != "" {
+				t.Errorf("Unexpected events (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
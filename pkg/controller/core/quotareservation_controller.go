@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/quotareservation"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+// QuotaReservationReconciler synchronizes the QuotaReservations with
+// cache.Cache, and requeues the inadmissible workloads whenever the
+// reserved quota is withheld or released.
+type QuotaReservationReconciler struct {
+	client      client.Client
+	cache       *schdcache.Cache
+	qManager    *qcache.Manager
+	clock       clock.PassiveClock
+	roleTracker *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*QuotaReservationReconciler)(nil)
+var _ predicate.TypedPredicate[*kueue.QuotaReservation] = (*QuotaReservationReconciler)(nil)
+
+func NewQuotaReservationReconciler(
+	client client.Client,
+	cache *schdcache.Cache,
+	qManager *qcache.Manager,
+	roleTracker *roletracker.RoleTracker,
+) *QuotaReservationReconciler {
+	return &QuotaReservationReconciler{
+		client:      client,
+		cache:       cache,
+		qManager:    qManager,
+		clock:       clock.RealClock{},
+		roleTracker: roleTracker,
+	}
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=quotareservations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=quotareservations/status,verbs=get;update;patch
+
+func (r *QuotaReservationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var qr kueue.QuotaReservation
+	if err := r.client.Get(ctx, req.NamespacedName, &qr); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(2).Info("QuotaReservation is being deleted")
+		cqs, err := r.cache.DeleteQuotaReservation(req.Name)
+		if err != nil {
+			log.Error(err, "Failed to release the reserved quota")
+		}
+		qcache.NotifyRetryInadmissible(r.qManager, cqs)
+		return ctrl.Result{}, nil
+	}
+
+	log.V(2).Info("Reconcile QuotaReservation")
+	cqs, requeueAfter, err := r.cache.AddOrUpdateQuotaReservation(&qr)
+	if err != nil {
+		log.Error(err, "Failed to withhold the reserved quota")
+		return ctrl.Result{}, err
+	}
+	qcache.NotifyRetryInadmissible(r.qManager, cqs)
+
+	window := quotareservation.WindowOf(&qr)
+	phase := window.Phase(r.clock.Now())
+	condition := metav1.Condition{
+		Type:               kueue.QuotaReservationActive,
+		Status:             metav1.ConditionFalse,
+		Reason:             phase,
+		Message:            activeConditionMessage(phase, window),
+		ObservedGeneration: qr.Generation,
+	}
+	if phase == kueue.QuotaReservationStarted {
+		condition.Status = metav1.ConditionTrue
+	}
+	if apimeta.SetStatusCondition(&qr.Status.Conditions, condition) {
+		if err := r.client.Status().Update(ctx, &qr); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		log.V(2).Info("Updated the QuotaReservation status", "phase", phase)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func activeConditionMessage(phase string, window quotareservation.Window) string {
+	switch phase {
+	case kueue.QuotaReservationPending:
+		return fmt.Sprintf("The reserved quota is withheld from lending starting at %s", window.RampUpStart.UTC().Format(time.RFC3339))
+	case kueue.QuotaReservationRampingUp:
+		return fmt.Sprintf("The reserved quota is progressively withheld from lending until %s", window.Start.UTC().Format(time.RFC3339))
+	case kueue.QuotaReservationStarted:
+		return fmt.Sprintf("The reserved quota can only be used by the Workloads holding the reservation until %s", window.End.UTC().Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("The reservation window ended at %s", window.End.UTC().Format(time.RFC3339))
+	}
+}
+
+func (r *QuotaReservationReconciler) Create(event.TypedCreateEvent[*kueue.QuotaReservation]) bool {
+	return true
+}
+
+func (r *QuotaReservationReconciler) Delete(event.TypedDeleteEvent[*kueue.QuotaReservation]) bool {
+	return true
+}
+
+func (r *QuotaReservationReconciler) Update(e event.TypedUpdateEvent[*kueue.QuotaReservation]) bool {
+	// The status updates don't change the reservation.
+	return e.ObjectOld.Generation != e.ObjectNew.Generation
+}
+
+func (r *QuotaReservationReconciler) Generic(event.TypedGenericEvent[*kueue.QuotaReservation]) bool {
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuotaReservationReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("quotareservation_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.QuotaReservation{},
+			&handler.TypedEnqueueRequestForObject[*kueue.QuotaReservation]{},
+			r,
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      new(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.SchemeGroupVersion.WithKind("QuotaReservation").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "quotareservation-reconciler"),
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.QuotaReservation{}, cfg))
+}
@@ -0,0 +1,327 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workload/finish"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+const (
+	// rightSizingSamplingInterval is the minimum time between two samples of
+	// the usage of the workloads in a LocalQueue.
+	rightSizingSamplingInterval = time.Minute
+
+	// rightSizingHeadroomPercent is the headroom added on top of the peak
+	// usage when recommending requests.
+	rightSizingHeadroomPercent = 20
+)
+
+// RightSizingReconciler samples the usage of the pods of the admitted
+// workloads in a LocalQueue through the metrics API, and records the
+// resulting recommendations in the status of the workloads and of the
+// LocalQueue.
+type RightSizingReconciler struct {
+	logName       string
+	client        client.Client
+	metricsReader client.Reader
+	clock         clock.Clock
+	roleTracker   *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*RightSizingReconciler)(nil)
+var _ predicate.TypedPredicate[*kueue.LocalQueue] = (*RightSizingReconciler)(nil)
+
+// NewRightSizingReconciler returns a RightSizingReconciler. The
+// metricsReader must not be backed by an informer, as the metrics API
+// doesn't support watches.
+func NewRightSizingReconciler(
+	client client.Client,
+	metricsReader client.Reader,
+	roleTracker *roletracker.RoleTracker,
+) *RightSizingReconciler {
+	return &RightSizingReconciler{
+		logName:       "rightsizing-reconciler",
+		client:        client,
+		metricsReader: metricsReader,
+		clock:         realClock,
+		roleTracker:   roleTracker,
+	}
+}
+
+func (r *RightSizingReconciler) logger() logr.Logger {
+	return roletracker.WithReplicaRole(ctrl.Log.WithName(r.logName), r.roleTracker)
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=localqueues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=localqueues/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get
+
+func (r *RightSizingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var lq kueue.LocalQueue
+	if err := r.client.Get(ctx, req.NamespacedName, &lq); err != nil {
+		// we'll ignore not-found errors, since there is nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile LocalQueue resource recommendations")
+
+	now := r.clock.Now()
+	if status := lq.Status.ResourceRecommendations; status != nil {
+		// Enforce the sampling interval, so that self-triggered status
+		// updates don't cause a reconcile loop.
+		if sinceLastUpdate := now.Sub(status.LastUpdate.Time); sinceLastUpdate >= 0 && sinceLastUpdate < rightSizingSamplingInterval {
+			return ctrl.Result{RequeueAfter: rightSizingSamplingInterval - sinceLastUpdate}, nil
+		}
+	}
+
+	var workloads kueue.WorkloadList
+	if err := r.client.List(ctx, &workloads, client.InNamespace(lq.Namespace), client.MatchingFields{indexer.WorkloadQueueKey: lq.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	aggregated := &kueue.LocalQueueResourceRecommendations{LastUpdate: metav1.NewTime(now)}
+	var errs []error
+	for i := range workloads.Items {
+		wl := &workloads.Items[i]
+		if !workload.IsAdmitted(wl) || finish.IsFinished(wl) {
+			continue
+		}
+		wlLog := log.WithValues("workload", klog.KObj(wl))
+		peaks, err := r.sampleWorkload(ctx, wl)
+		if err != nil {
+			wlLog.Error(err, "Failed to sample the usage of the workload")
+			errs = append(errs, err)
+			continue
+		}
+		recommendations := updateResourceRecommendations(wl, peaks, now)
+		if !equality.Semantic.DeepEqual(recommendations, wl.Status.ResourceRecommendations) {
+			if err := clientutil.PatchStatus(ctx, r.client, wl, func() (bool, error) {
+				wl.Status.ResourceRecommendations = recommendations
+				return true, nil
+			}, clientutil.WithLoose()); err != nil {
+				if !apierrors.IsNotFound(err) {
+					wlLog.Error(err, "Failed to update the resource recommendations of the workload")
+					errs = append(errs, err)
+				}
+				continue
+			}
+			wlLog.V(3).Info("Updated the resource recommendations of the workload")
+		}
+		aggregateResourceRecommendations(aggregated, wl)
+	}
+
+	if err := clientutil.PatchStatus(ctx, r.client, &lq, func() (bool, error) {
+		lq.Status.ResourceRecommendations = aggregated
+		return true, nil
+	}, clientutil.WithLoose()); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	return ctrl.Result{RequeueAfter: rightSizingSamplingInterval}, errors.Join(errs...)
+}
+
+// sampleWorkload returns, per podSet, the highest usage of a single running
+// pod of the workload. Pods without metrics yet are skipped.
+func (r *RightSizingReconciler) sampleWorkload(ctx context.Context, wl *kueue.Workload) (map[kueue.PodSetReference]corev1.ResourceList, error) {
+	var pods corev1.PodList
+	if err := r.client.List(ctx, &pods, client.InNamespace(wl.Namespace),
+		client.MatchingFields{indexer.WorkloadSliceNameKey: workloadslicing.SliceName(wl)}); err != nil {
+		return nil, err
+	}
+	peaks := make(map[kueue.PodSetReference]corev1.ResourceList)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		psName, found := pod.Labels[constants.PodSetLabel]
+		if !found || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		var podMetrics metricsv1beta1.PodMetrics
+		if err := r.metricsReader.Get(ctx, client.ObjectKeyFromObject(pod), &podMetrics); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		ps := kueue.PodSetReference(psName)
+		peaks[ps] = utilresource.MergeResourceListKeepMax(peaks[ps], podUsage(&podMetrics))
+	}
+	return peaks, nil
+}
+
+// podUsage returns the cpu and memory usage of all the containers of a pod.
+func podUsage(podMetrics *metricsv1beta1.PodMetrics) corev1.ResourceList {
+	var usage corev1.ResourceList
+	for _, c := range podMetrics.Containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if q, found := c.Usage[name]; found {
+				usage = utilresource.MergeResourceListKeepSum(usage, corev1.ResourceList{name: q})
+			}
+		}
+	}
+	return usage
+}
+
+// updateResourceRecommendations returns the recommendations of the workload
+// after accounting the peaks sampled for its podSets. The recommendations
+// only grow during the lifetime of the workload, so that short-lived drops
+// of the usage don't lead to undersized requests.
+func updateResourceRecommendations(wl *kueue.Workload, peaks map[kueue.PodSetReference]corev1.ResourceList, now time.Time) []kueue.PodSetResourceRecommendation {
+	var result []kueue.PodSetResourceRecommendation
+	for _, ps := range wl.Spec.PodSets {
+		var previous *kueue.PodSetResourceRecommendation
+		for i := range wl.Status.ResourceRecommendations {
+			if wl.Status.ResourceRecommendations[i].Name == ps.Name {
+				previous = &wl.Status.ResourceRecommendations[i]
+			}
+		}
+		peak, sampled := peaks[ps.Name]
+		switch {
+		case previous == nil && !sampled:
+			continue
+		case previous != nil:
+			merged := utilresource.MergeResourceListKeepMax(previous.PeakUsage, peak)
+			if equality.Semantic.DeepEqual(merged, previous.PeakUsage) {
+				result = append(result, *previous)
+				continue
+			}
+			peak = merged
+		}
+		result = append(result, kueue.PodSetResourceRecommendation{
+			Name:       ps.Name,
+			PeakUsage:  peak,
+			Resources:  recommendedRequests(peak),
+			LastUpdate: metav1.NewTime(now),
+		})
+	}
+	return result
+}
+
+// recommendedRequests returns the requests recommended for a pod with the
+// given peak usage: the peak plus headroom, rounded up to millicores for cpu
+// and to mebibytes for memory.
+func recommendedRequests(peak corev1.ResourceList) corev1.ResourceList {
+	recommended := make(corev1.ResourceList, len(peak))
+	for name, q := range peak {
+		switch name {
+		case corev1.ResourceCPU:
+			recommended[name] = *resource.NewMilliQuantity(withHeadroom(q.MilliValue(), 1), resource.DecimalSI)
+		case corev1.ResourceMemory:
+			recommended[name] = *resource.NewQuantity(withHeadroom(q.Value(), 1<<20), resource.BinarySI)
+		}
+	}
+	return recommended
+}
+
+// withHeadroom adds the headroom to v and rounds the result up to a multiple
+// of unit.
+func withHeadroom(v, unit int64) int64 {
+	v = (v*(100+rightSizingHeadroomPercent) + 99) / 100
+	return (v + unit - 1) / unit * unit
+}
+
+// aggregateResourceRecommendations adds the requests and recommendations of
+// the admitted workload to the aggregated recommendations of its LocalQueue.
+func aggregateResourceRecommendations(aggregated *kueue.LocalQueueResourceRecommendations, wl *kueue.Workload) {
+	if len(wl.Status.ResourceRecommendations) == 0 {
+		return
+	}
+	aggregated.Workloads++
+	for _, rec := range wl.Status.ResourceRecommendations {
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			if psa.Name != rec.Name || psa.Count == nil {
+				continue
+			}
+			for name := range rec.Resources {
+				if requested, found := psa.ResourceUsage[name]; found {
+					aggregated.Requested = utilresource.MergeResourceListKeepSum(aggregated.Requested, corev1.ResourceList{name: requested})
+				}
+			}
+			aggregated.Recommended = utilresource.MergeResourceListKeepSum(aggregated.Recommended, utilresource.MulByFloat(rec.Resources, float64(*psa.Count)))
+		}
+	}
+}
+
+func (r *RightSizingReconciler) Create(e event.TypedCreateEvent[*kueue.LocalQueue]) bool {
+	return true
+}
+
+func (r *RightSizingReconciler) Delete(e event.TypedDeleteEvent[*kueue.LocalQueue]) bool {
+	return false
+}
+
+func (r *RightSizingReconciler) Update(e event.TypedUpdateEvent[*kueue.LocalQueue]) bool {
+	// The recommendations are refreshed periodically, so that the updates of
+	// the LocalQueue status don't trigger additional samples.
+	return false
+}
+
+func (r *RightSizingReconciler) Generic(e event.TypedGenericEvent[*kueue.LocalQueue]) bool {
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RightSizingReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("rightsizing_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.LocalQueue{},
+			&handler.TypedEnqueueRequestForObject[*kueue.LocalQueue]{},
+			r,
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      new(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.SchemeGroupVersion.WithKind("LocalQueue").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "rightsizing-reconciler"),
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.LocalQueue{}, cfg))
+}
@@ -0,0 +1,311 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+	"sigs.k8s.io/kueue/test/util"
+)
+
+func TestRecommendedRequests(t *testing.T) {
+	cases := map[string]struct {
+		peak corev1.ResourceList
+		want corev1.ResourceList
+	}{
+		"headroom is added": {
+			peak: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("1000Mi"),
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("600m"),
+				corev1.ResourceMemory: resource.MustParse("1200Mi"),
+			},
+		},
+		"rounded up": {
+			peak: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("333m"),
+				corev1.ResourceMemory: resource.MustParse("100M"),
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("400m"),
+				corev1.ResourceMemory: resource.MustParse("115Mi"),
+			},
+		},
+		"other resources are not recommended": {
+			peak: corev1.ResourceList{
+				"example.com/gpu": resource.MustParse("1"),
+			},
+			want: corev1.ResourceList{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := recommendedRequests(tc.peak)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected recommended requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRightSizingReconcile(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	lastUpdate := metav1.NewTime(now.Add(-time.Hour))
+	admittedWorkload := func() *utiltestingapi.WorkloadWrapper {
+		return utiltestingapi.MakeWorkload("wl", "default").
+			Queue("lq").
+			PodSets(*utiltestingapi.MakePodSet("main", 2).
+				Request(corev1.ResourceCPU, "2").
+				Request(corev1.ResourceMemory, "4Gi").
+				Obj()).
+			SimpleReserveQuota("cq", "rf", now).
+			AdmittedAt(true, now)
+	}
+	pod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return testingpod.MakePod(name, "default").
+			Annotation(kueue.WorkloadAnnotation, "wl").
+			Label(constants.PodSetLabel, "main").
+			StatusPhase(phase).
+			Obj()
+	}
+	podMetrics := func(name, cpu, memory string) *metricsv1beta1.PodMetrics {
+		return &metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name: "c",
+				Usage: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			}},
+		}
+	}
+	cases := map[string]struct {
+		localQueue       *kueue.LocalQueue
+		workload         *kueue.Workload
+		objs             []client.Object
+		wantWorkload     *kueue.Workload
+		wantLocalQueue   *kueue.LocalQueue
+		wantRequeueAfter time.Duration
+	}{
+		"records the recommendations": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj(),
+			workload:   admittedWorkload().Obj(),
+			objs: []client.Object{
+				pod("pod-1", corev1.PodRunning),
+				pod("pod-2", corev1.PodRunning),
+				pod("pod-3", corev1.PodSucceeded),
+				podMetrics("pod-1", "500m", "1Gi"),
+				podMetrics("pod-2", "1", "512Mi"),
+				podMetrics("pod-3", "2", "4Gi"),
+			},
+			wantWorkload: admittedWorkload().
+				ResourceRecommendations(kueue.PodSetResourceRecommendation{
+					Name: "main",
+					PeakUsage: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Resources: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1200m"),
+						corev1.ResourceMemory: resource.MustParse("1229Mi"),
+					},
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					Workloads: 1,
+					Requested: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+					Recommended: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2400m"),
+						corev1.ResourceMemory: resource.MustParse("2458Mi"),
+					},
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantRequeueAfter: rightSizingSamplingInterval,
+		},
+		"keeps the previous peak": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj(),
+			workload: admittedWorkload().
+				ResourceRecommendations(kueue.PodSetResourceRecommendation{
+					Name: "main",
+					PeakUsage: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1500m"),
+						corev1.ResourceMemory: resource.MustParse("512Mi"),
+					},
+					Resources: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1800m"),
+						corev1.ResourceMemory: resource.MustParse("615Mi"),
+					},
+					LastUpdate: lastUpdate,
+				}).
+				Obj(),
+			objs: []client.Object{
+				pod("pod-1", corev1.PodRunning),
+				podMetrics("pod-1", "500m", "1Gi"),
+			},
+			wantWorkload: admittedWorkload().
+				ResourceRecommendations(kueue.PodSetResourceRecommendation{
+					Name: "main",
+					PeakUsage: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1500m"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Resources: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1800m"),
+						corev1.ResourceMemory: resource.MustParse("1229Mi"),
+					},
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					Workloads: 1,
+					Requested: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+					Recommended: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("3600m"),
+						corev1.ResourceMemory: resource.MustParse("2458Mi"),
+					},
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantRequeueAfter: rightSizingSamplingInterval,
+		},
+		"pods without metrics are skipped": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj(),
+			workload:   admittedWorkload().Obj(),
+			objs: []client.Object{
+				pod("pod-1", corev1.PodRunning),
+			},
+			wantWorkload: admittedWorkload().Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantRequeueAfter: rightSizingSamplingInterval,
+		},
+		"pending workloads are skipped": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj(),
+			workload:   utiltestingapi.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			objs: []client.Object{
+				pod("pod-1", corev1.PodRunning),
+				podMetrics("pod-1", "500m", "1Gi"),
+			},
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					LastUpdate: metav1.NewTime(now),
+				}).
+				Obj(),
+			wantRequeueAfter: rightSizingSamplingInterval,
+		},
+		"within the sampling interval": {
+			localQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					LastUpdate: metav1.NewTime(now.Add(-20 * time.Second)),
+				}).
+				Obj(),
+			workload: admittedWorkload().Obj(),
+			objs: []client.Object{
+				pod("pod-1", corev1.PodRunning),
+				podMetrics("pod-1", "500m", "1Gi"),
+			},
+			wantWorkload: admittedWorkload().Obj(),
+			wantLocalQueue: utiltestingapi.MakeLocalQueue("lq", "default").
+				ClusterQueue("cq").
+				ResourceRecommendations(&kueue.LocalQueueResourceRecommendations{
+					LastUpdate: metav1.NewTime(now.Add(-20 * time.Second)),
+				}).
+				Obj(),
+			wantRequeueAfter: 40 * time.Second,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cl := utiltesting.NewClientBuilder(metricsv1beta1.AddToScheme).
+				WithObjects(append(tc.objs, tc.localQueue, tc.workload)...).
+				WithStatusSubresource(tc.localQueue, tc.workload).
+				WithIndex(&corev1.Pod{}, indexer.WorkloadSliceNameKey, indexer.IndexPodWorkloadSliceName).
+				Build()
+
+			ctx, _ := utiltesting.ContextWithLog(t)
+			reconciler := NewRightSizingReconciler(cl, cl, nil)
+			reconciler.clock = testingclock.NewFakeClock(now)
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.localQueue)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Unexpected requeueAfter: got %v, want %v", result.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			cmpOpts := cmp.Options{
+				cmpopts.EquateEmpty(),
+				util.IgnoreConditionTimestamps,
+				util.IgnoreObjectMetaResourceVersion,
+			}
+			gotWorkload := &kueue.Workload{}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), gotWorkload); err != nil {
+				t.Fatalf("Could not get Workload after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkload, gotWorkload, cmpOpts...); diff != "" {
+				t.Errorf("Unexpected Workload after reconcile (-want,+got):\n%s", diff)
+			}
+			gotLocalQueue := &kueue.LocalQueue{}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.localQueue), gotLocalQueue); err != nil {
+				t.Fatalf("Could not get LocalQueue after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantLocalQueue, gotLocalQueue, cmpOpts...); diff != "" {
+				t.Errorf("Unexpected LocalQueue after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
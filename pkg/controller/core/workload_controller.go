@@ -102,6 +102,7 @@ var (
 		kueue.WorkloadQuotaReservedReasonWaitingForPreemptedWorkloads,
 		kueue.WorkloadQuotaReservedReasonWaitingForPodsReady,
 		kueue.WorkloadQuotaReservedReasonPendingEvaluation,
+		kueue.WorkloadQuotaReservedReasonBudgetExhausted,
 	)
 )
 
@@ -683,6 +684,12 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		}
 	}
 
+	if workload.IsWaitingForDependencies(&wl) && workload.IsActive(&wl) && !workload.HasQuotaReservation(&wl) {
+		if updated, err := r.reconcileDependencies(ctx, &wl); updated || err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
 	cqName, cqOk := r.queues.ClusterQueueForWorkload(&wl)
 	var cq *kueue.ClusterQueue
 	if cqOk {
@@ -704,6 +711,7 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 
 	// If the workload is admitted, updating the status here would set the Admitted condition to
 	// false before the workloads eviction.
+	var deadlineRecheckAfter time.Duration
 	if !workload.IsAdmitted(&wl) {
 		var updated bool
 		err := workloadpatching.PatchAdmissionStatus(ctx, r.client, &wl, r.clock, func(wl *kueue.Workload) (bool, error) {
@@ -713,6 +721,13 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 				} else if changed {
 					updated = true
 				}
+				if features.Enabled(features.DeadlineAwareQueueing) {
+					var changed bool
+					changed, deadlineRecheckAfter = workload.SyncDeadlineUnattainableCondition(wl, r.clock.Now())
+					if changed {
+						updated = true
+					}
+				}
 			}
 			if workload.SyncAdmittedCondition(wl, r.clock.Now()) {
 				updated = true
@@ -787,16 +802,22 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (r
 		if err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
+		preemptionNoticeRecheckAfter, err := r.reconcilePreemptionNotice(ctx, &wl)
+		if err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
 
 		// get the minimun non-zero value
-		recheckAfter := min(podsReadyRecheckAfter, maxExecRecheckAfter)
-		if recheckAfter == 0 {
-			recheckAfter = max(podsReadyRecheckAfter, maxExecRecheckAfter)
+		var recheckAfter time.Duration
+		for _, d := range []time.Duration{podsReadyRecheckAfter, maxExecRecheckAfter, preemptionNoticeRecheckAfter} {
+			if d > 0 && (recheckAfter == 0 || d < recheckAfter) {
+				recheckAfter = d
+			}
 		}
 		return ctrl.Result{RequeueAfter: recheckAfter}, nil
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: deadlineRecheckAfter}, nil
 }
 
 // isOrphanedWorkload determines if a workload is orphaned and should be finalized.
@@ -879,6 +900,42 @@ func (r *WorkloadReconciler) reconcileMaxExecutionTime(ctx context.Context, wl *
 	return 0, nil
 }
 
+// reconcilePreemptionNotice withdraws the preemption notice of the workload
+// once it expired, as no pending workload evicted it, or returns a retry after
+// value. The checkpoint acknowledgement is dropped along with the notice, so
+// that it doesn't apply to the next one.
+func (r *WorkloadReconciler) reconcilePreemptionNotice(ctx context.Context, wl *kueue.Workload) (time.Duration, error) {
+	if workload.PreemptionNoticeWithdrawn(wl) && workload.CheckpointAcknowledged(wl) {
+		wlCopy := wl.DeepCopy()
+		delete(wlCopy.Annotations, controllerconsts.CheckpointAcknowledgedAnnotation)
+		return 0, r.client.Patch(ctx, wlCopy, client.MergeFrom(wl))
+	}
+	expiry, notified := workload.PreemptionNoticeExpiry(wl)
+	if !notified || workloadevict.IsEvicted(wl) {
+		return 0, nil
+	}
+	if remainingTime := expiry.Sub(r.clock.Now()); remainingTime > 0 {
+		return remainingTime, nil
+	}
+
+	err := workloadpatching.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func(wl *kueue.Workload) (bool, error) {
+		return workload.WithdrawPreemptionNotice(wl, r.clock.Now()), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	ctrl.LoggerFrom(ctx).V(3).Info("Withdrew the expired preemption notice")
+	r.recorder.Eventf(
+		wl,
+		nil,
+		corev1.EventTypeNormal,
+		kueue.WorkloadPreemptionNoticeExpired,
+		"PreemptionNoticeWithdrawn",
+		"The preemption notice expired without the workload being evicted",
+	)
+	return 0, nil
+}
+
 // buildAdmissionChecksMessage formats a human-readable message
 // describing the list of admission checks in the given state.
 func buildAdmissionChecksMessage(checks []kueue.AdmissionCheckState, state kueue.CheckState) string {
@@ -1289,6 +1346,10 @@ func (r *WorkloadReconciler) Delete(e event.TypedDeleteEvent[*kueue.Workload]) b
 	// workload was in the queues and should be cleared from them.
 	r.queues.DeleteAndForgetWorkload(log, wlKey)
 
+	if status == workload.StatusAdmitted {
+		r.reportWorkloadCost(log, e.Object, r.clock.Now())
+	}
+
 	if afs.Enabled(r.admissionFSConfig) {
 		// A Workload deleted before settling (e.g. a Job deleted while waiting
 		// for an AdmissionCheck) would leave its penalty pending forever,
@@ -1363,6 +1424,9 @@ func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) b
 		case onHold:
 			log.V(2).Info("Removing workload from queue because it is on-hold")
 			r.queues.DeleteWorkload(log, wlKey)
+		case workload.IsWaitingForDependencies(wlCopy):
+			log.V(2).Info("Removing workload from queue because it is waiting for dependencies")
+			r.queues.DeleteWorkload(log, wlKey)
 		case dra.NeedsDRAReconcile(e.ObjectNew, r.draBackedResources):
 			log.V(2).Info("Skipping queue update for DRA workload - handled in Reconcile")
 		default:
@@ -1419,7 +1483,8 @@ func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) b
 		})
 	case prevStatus == workload.StatusAdmitted && status == workload.StatusAdmitted && !equality.Semantic.DeepEqual(e.ObjectOld.Status.ReclaimablePods, e.ObjectNew.Status.ReclaimablePods),
 		features.Enabled(features.ElasticJobsViaWorkloadSlices) && workloadslicing.ScaledDown(workload.ExtractPodSetCountsFromWorkload(e.ObjectOld), workload.ExtractPodSetCountsFromWorkload(e.ObjectNew)),
-		workload.PriorityChanged(log, e.ObjectOld, e.ObjectNew):
+		workload.PriorityChanged(log, e.ObjectOld, e.ObjectNew),
+		!workload.PreemptionNoticeWithdrawn(e.ObjectOld) && workload.PreemptionNoticeWithdrawn(e.ObjectNew):
 		// trigger the move of associated inadmissibleWorkloads, if there are any.
 		r.queues.QueueAssociatedInadmissibleWorkloadsAfter(ctx, wlKey, func() {
 			// Update the workload from cache while holding the queues lock
@@ -1433,6 +1498,9 @@ func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) b
 		// and are not supposed to actually change anything.
 		r.cache.AddOrUpdateWorkload(log, wlCopy)
 	}
+	if prevStatus == workload.StatusAdmitted && status != workload.StatusAdmitted {
+		r.reportWorkloadCost(log, e.ObjectOld, workload.AdmissionPeriodEnd(e.ObjectNew, r.clock.Now()))
+	}
 	r.reconcileAfsPenaltiesOnUpdate(log, e, wlCopy, active, status, prevStatus, prevQueue)
 	r.queues.QueueSecondPassIfNeeded(ctx, wlCopy, 0)
 	return true
@@ -1559,6 +1627,20 @@ func (r *WorkloadReconciler) reportFinishedWorkload(log logr.Logger, wl *kueue.W
 	}
 }
 
+// reportWorkloadCost accounts the cost of the admission period of the
+// workload that ended at the given time.
+func (r *WorkloadReconciler) reportWorkloadCost(log logr.Logger, wl *kueue.Workload, end time.Time) {
+	if !features.Enabled(features.WorkloadCostAccounting) || wl.Status.Admission == nil {
+		return
+	}
+	cost := workload.AdmissionPeriodCost(wl, r.cache.ResourceFlavorPrices, end)
+	if cost == 0 {
+		return
+	}
+	log.V(3).Info("Accounted workload cost", "cost", cost)
+	metrics.ReportWorkloadCost(wl.Status.Admission.ClusterQueue, metrics.LQRefFromWorkload(wl), cost, r.roleTracker)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
 	ruh := &resourceUpdatesHandler{r: r}
@@ -1583,6 +1665,9 @@ func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Conf
 		Watches(&nodev1.RuntimeClass{}, ruh).
 		Watches(&kueue.ClusterQueue{}, wqh).
 		Watches(&kueue.LocalQueue{}, wqh)
+	if features.Enabled(features.WorkloadDependencies) {
+		bld = bld.Watches(&kueue.Workload{}, &workloadDependentsHandler{r: r})
+	}
 	if features.Enabled(features.KueueDRAIntegrationExtendedResource) {
 		if _, err := mgr.GetRESTMapper().RESTMapping(resourcev1.SchemeGroupVersion.WithKind("DeviceClass").GroupKind()); err != nil && apimeta.IsNoMatchError(err) {
 			r.logger().V(2).Info("DeviceClass API not available, skipping DeviceClass watcher")
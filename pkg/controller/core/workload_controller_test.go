@@ -21,6 +21,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"maps"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	resourcev1 "k8s.io/api/resource/v1"
@@ -50,12 +52,15 @@ import (
 	queueafs "sigs.k8s.io/kueue/pkg/cache/queue/afs"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	utilindexer "sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/dra"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/metrics"
 	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
 	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -545,6 +550,153 @@ func TestUpdateRemovesStaleQueueEntryForOnHoldWorkload(t *testing.T) {
 	}
 }
 
+func TestUpdateRemovesQueueEntryForWorkloadWaitingForDependencies(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, true)
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+
+	oldWl := utiltestingapi.MakeWorkload("wl", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "1").
+		Obj()
+	newWl := utiltestingapi.MakeWorkload("wl", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "1").
+		DependsOn("preprocess").
+		Obj()
+
+	cl := utiltesting.NewClientBuilder().Build()
+	recorder := &utiltesting.EventRecorder{}
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache,
+		qcache.WithClock(fakeClock),
+		qcache.WithPreemptionExpectations(preemptexpectations.New()))
+	reconciler := NewWorkloadReconciler(cl, qManager, cqCache, recorder)
+
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	setupClusterQueue(ctx, t, cl, qManager, cqCache, utiltestingapi.MakeClusterQueue("cq").Obj(), false)
+	setupLocalQueue(ctx, t, cl, qManager, utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(), false)
+
+	if err := qManager.AddOrUpdateWorkload(log, oldWl); err != nil {
+		t.Fatalf("AddOrUpdateWorkload() error = %v", err)
+	}
+	if pending := qManager.PendingWorkloadsInfo("cq"); len(pending) != 1 {
+		t.Fatalf("expected one pending workload before update, got %d", len(pending))
+	}
+
+	if got := reconciler.Update(event.TypedUpdateEvent[*kueue.Workload]{
+		ObjectOld: oldWl,
+		ObjectNew: newWl,
+	}); !got {
+		t.Fatalf("Update() = %v, want true", got)
+	}
+
+	if pending := qManager.PendingWorkloadsInfo("cq"); len(pending) != 0 {
+		t.Fatalf("expected no workloads in pending queue, got %d", len(pending))
+	}
+}
+
+func TestWorkloadCostAccounting(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admittedAt := now.Add(-2 * time.Hour)
+
+	rf := utiltestingapi.MakeResourceFlavor("rf").Price("nvidia.com/gpu", "2.5").Obj()
+	makeWl := func() *utiltestingapi.WorkloadWrapper {
+		return utiltestingapi.MakeWorkload("wl", "ns").
+			Queue("lq").
+			Request("nvidia.com/gpu", "4")
+	}
+	admission := utiltestingapi.MakeAdmission("cq").
+		PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment("nvidia.com/gpu", "rf", "4").Obj()).
+		Obj()
+	admitted := makeWl().
+		ReserveQuotaAt(admission, admittedAt).
+		AdmittedAt(true, admittedAt).
+		Obj()
+
+	cases := map[string]struct {
+		disableFeature bool
+		oldWl          *kueue.Workload
+		newWl          *kueue.Workload
+		deleted        bool
+		wantCost       float64
+	}{
+		"admitted workload finishes": {
+			oldWl: admitted,
+			newWl: makeWl().
+				ReserveQuotaAt(admission, admittedAt).
+				AdmittedAt(true, admittedAt).
+				FinishedAt(now.Add(-time.Hour)).
+				Obj(),
+			wantCost: 4 * 2.5,
+		},
+		"admitted workload is evicted": {
+			oldWl: admitted,
+			newWl: makeWl().
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadAdmitted,
+					Status:             metav1.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+					Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+				}).
+				Obj(),
+			wantCost: 1.5 * 4 * 2.5,
+		},
+		"admitted workload is deleted": {
+			oldWl:    admitted,
+			deleted:  true,
+			wantCost: 2 * 4 * 2.5,
+		},
+		"admitted workload is updated": {
+			oldWl: admitted,
+			newWl: makeWl().
+				ReserveQuotaAt(admission, admittedAt).
+				AdmittedAt(true, admittedAt).
+				Label("foo", "bar").
+				Obj(),
+		},
+		"feature disabled": {
+			disableFeature: true,
+			oldWl:          admitted,
+			newWl: makeWl().
+				ReserveQuotaAt(admission, admittedAt).
+				AdmittedAt(true, admittedAt).
+				FinishedAt(now.Add(-time.Hour)).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadCostAccounting, !tc.disableFeature)
+			metrics.WorkloadCostTotal.Reset()
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().Build()
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithPreemptionExpectations(preemptexpectations.New()))
+			reconciler := NewWorkloadReconciler(cl, qManager, cqCache, &utiltesting.EventRecorder{},
+				WithPreemptionExpectations(preemptexpectations.New()))
+			reconciler.clock = testingclock.NewFakeClock(now)
+
+			cqCache.AddOrUpdateResourceFlavor(log, rf)
+			setupClusterQueue(ctx, t, cl, qManager, cqCache, utiltestingapi.MakeClusterQueue("cq").Obj(), false)
+			setupLocalQueue(ctx, t, cl, qManager, utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(), false)
+
+			if tc.deleted {
+				reconciler.Delete(event.TypedDeleteEvent[*kueue.Workload]{Object: tc.oldWl})
+			} else {
+				reconciler.Update(event.TypedUpdateEvent[*kueue.Workload]{ObjectOld: tc.oldWl, ObjectNew: tc.newWl})
+			}
+
+			got := promtestutil.ToFloat64(metrics.WorkloadCostTotal.WithLabelValues("cq", "lq", "ns", roletracker.RoleStandalone))
+			if math.Abs(got-tc.wantCost) > 1e-9 {
+				t.Errorf("Unexpected workload cost: got %v, want %v", got, tc.wantCost)
+			}
+		})
+	}
+}
+
 func TestUpdateSettlesAfsEntryPenalty(t *testing.T) {
 	now := time.Now().Truncate(time.Second)
 	lqKey := utilqueue.NewLocalQueueReference("ns", "lq")
@@ -896,6 +1048,186 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"admitted workload with a preemption notice": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.InClusterQueueReason,
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadPreemptionPending,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.InClusterQueueReason,
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+			wantResult: reconcile.Result{RequeueAfter: 4*time.Minute + workload.PreemptionNoticeTimeout},
+		},
+
+		"admitted workload with an expired preemption notice": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.InClusterQueueReason,
+					LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadPreemptionPending,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadPreemptionNoticeExpired,
+					Message: "No pending workload evicted the workload within the grace period",
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Normal",
+					Reason:    kueue.WorkloadPreemptionNoticeExpired,
+					Message:   "The preemption notice expired without the workload being evicted",
+				},
+			},
+		},
+
+		"admitted workload acknowledging a withdrawn preemption notice": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				Annotation(controllerconsts.CheckpointAcknowledgedAnnotation, "true").
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadPreemptionPending,
+					Status: metav1.ConditionFalse,
+					Reason: kueue.WorkloadPreemptionNoticeExpired,
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				PreemptionGracePeriodSeconds(300).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("q1").Obj(), now).
+				AdmittedAt(true, now.Add(-time.Hour)).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadPreemptionPending,
+					Status: metav1.ConditionFalse,
+					Reason: kueue.WorkloadPreemptionNoticeExpired,
+				}).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				Obj(),
+		},
+
+		"pending workload waiting for dependencies": {
+			featureGates: map[featuregate.Feature]bool{features.WorkloadDependencies: true},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess", "train").
+				Obj(),
+			additionalObjects: []client.Object{
+				utiltestingapi.MakeWorkload("preprocess", "ns").
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadFinished,
+						Status: metav1.ConditionTrue,
+						Reason: kueue.WorkloadFinishedReasonSucceeded,
+					}).
+					Obj(),
+			},
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess", "train").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies train to finish",
+				}).
+				Obj(),
+		},
+		"pending workload with finished dependencies": {
+			featureGates: map[featuregate.Feature]bool{features.WorkloadDependencies: true},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies preprocess to finish",
+				}).
+				Obj(),
+			additionalObjects: []client.Object{
+				utiltestingapi.MakeWorkload("preprocess", "ns").
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadFinished,
+						Status: metav1.ConditionTrue,
+						Reason: kueue.WorkloadFinishedReasonSucceeded,
+					}).
+					Obj(),
+			},
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadDependenciesFinished,
+					Message: "All the dependencies finished successfully",
+				}).
+				Obj(),
+		},
+		"pending workload with a failed dependency": {
+			featureGates: map[featuregate.Feature]bool{features.WorkloadDependencies: true},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Obj(),
+			additionalObjects: []client.Object{
+				utiltestingapi.MakeWorkload("preprocess", "ns").
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadFinished,
+						Status: metav1.ConditionTrue,
+						Reason: kueue.WorkloadFinishedReasonFailed,
+					}).
+					Obj(),
+			},
+			wantWorkload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadDependencyFailed,
+					Message: "The dependencies preprocess finished with a failure",
+				}).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadDeactivationTarget,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependencyFailed,
+					Message: "The dependencies preprocess finished with a failure",
+				}).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    kueue.WorkloadDependencyFailed,
+					Message:   "The dependencies preprocess finished with a failure",
+				},
+			},
+		},
 		"should handle finished workload logic for orphaned workloads when FinishOrphanedWorkloads enabled": {
 			featureGates: map[featuregate.Feature]bool{features.FinishOrphanedWorkloads: true},
 			workload: utiltestingapi.MakeWorkload("wl", "ns").
@@ -0,0 +1,122 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadfinish "sigs.k8s.io/kueue/pkg/workload/finish"
+	workloadpatching "sigs.k8s.io/kueue/pkg/workload/patching"
+)
+
+// reconcileDependencies syncs the WaitingForDependencies condition of a
+// pending workload with the state of its dependencies, and marks the workload
+// for deactivation if any of them failed.
+// It returns true if the workload was updated.
+func (r *WorkloadReconciler) reconcileDependencies(ctx context.Context, wl *kueue.Workload) (bool, error) {
+	status, err := workload.GetDependenciesStatus(ctx, r.client, wl)
+	if err != nil {
+		return false, err
+	}
+	var updated bool
+	err = workloadpatching.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func(wl *kueue.Workload) (bool, error) {
+		updated = workload.SyncWaitingForDependenciesCondition(wl, status)
+		return updated, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	if updated && len(status.Failed) > 0 {
+		r.recorder.Eventf(wl, nil, corev1.EventTypeWarning, kueue.WorkloadDependencyFailed, "DependencyFailed",
+			"The dependencies %s finished with a failure", strings.Join(status.Failed, ", "))
+	}
+	return updated, nil
+}
+
+// isDependencyResolved returns true if the workloads depending on wl can
+// determine whether their dependency succeeded or failed.
+func isDependencyResolved(wl *kueue.Workload) bool {
+	return workloadfinish.IsFinished(wl) || (!workload.IsActive(wl) && workload.HasFailedDependency(wl))
+}
+
+// workloadDependentsHandler queues the reconciliation of the workloads
+// depending on a workload once it's resolved.
+type workloadDependentsHandler struct {
+	r *WorkloadReconciler
+}
+
+var _ handler.EventHandler = (*workloadDependentsHandler)(nil)
+
+// Create is called in response to a create event.
+func (h *workloadDependentsHandler) Create(ctx context.Context, ev event.CreateEvent, wq workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if wl, isWl := ev.Object.(*kueue.Workload); isWl && isDependencyResolved(wl) {
+		h.queueReconcileForDependents(ctx, wl, wq)
+	}
+}
+
+// Update is called in response to an update event.
+func (h *workloadDependentsHandler) Update(ctx context.Context, ev event.UpdateEvent, wq workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	oldWl, oldIsWl := ev.ObjectOld.(*kueue.Workload)
+	newWl, newIsWl := ev.ObjectNew.(*kueue.Workload)
+	if oldIsWl && newIsWl && !isDependencyResolved(oldWl) && isDependencyResolved(newWl) {
+		h.queueReconcileForDependents(ctx, newWl, wq)
+	}
+}
+
+// Delete is called in response to a delete event.
+func (h *workloadDependentsHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// nothing to do here, the dependents keep waiting for a missing dependency
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request.
+func (h *workloadDependentsHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// nothing to do here
+}
+
+func (h *workloadDependentsHandler) queueReconcileForDependents(ctx context.Context, wl *kueue.Workload, wq workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log := ctrl.LoggerFrom(ctx).WithValues("dependency", klog.KObj(wl))
+	lst := kueue.WorkloadList{}
+	err := h.r.client.List(ctx, &lst, &client.ListOptions{Namespace: wl.Namespace}, client.MatchingFields{indexer.WorkloadDependsOnKey: wl.Name})
+	if err != nil {
+		log.Error(err, "Could not list the dependent workloads")
+		return
+	}
+	for _, dependent := range lst.Items {
+		log.V(5).Info("Queueing reconcile for the dependent workload", "workload", klog.KObj(&dependent))
+		wq.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      dependent.Name,
+				Namespace: dependent.Namespace,
+			},
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestWorkloadDependentsHandlerUpdate(t *testing.T) {
+	succeeded := metav1.Condition{
+		Type:   kueue.WorkloadFinished,
+		Status: metav1.ConditionTrue,
+		Reason: kueue.WorkloadFinishedReasonSucceeded,
+	}
+
+	cases := map[string]struct {
+		oldWorkload *kueue.Workload
+		newWorkload *kueue.Workload
+		want        []reconcile.Request
+	}{
+		"dependency still running": {
+			oldWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").Obj(),
+			newWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), time.Now()).
+				Obj(),
+		},
+		"dependency finished": {
+			oldWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").Obj(),
+			newWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").Condition(succeeded).Obj(),
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "evaluate"}},
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "train"}},
+			},
+		},
+		"dependency already finished": {
+			oldWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").Condition(succeeded).Obj(),
+			newWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").Condition(succeeded).Obj(),
+		},
+		"dependency deactivated because of a failed dependency": {
+			oldWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadWaitingForDependencies,
+					Status: metav1.ConditionFalse,
+					Reason: kueue.WorkloadDependencyFailed,
+				}).
+				Obj(),
+			newWorkload: utiltestingapi.MakeWorkload("preprocess", "ns").
+				Active(false).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadWaitingForDependencies,
+					Status: metav1.ConditionFalse,
+					Reason: kueue.WorkloadDependencyFailed,
+				}).
+				Obj(),
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "evaluate"}},
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "train"}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithIndex(&kueue.Workload{}, indexer.WorkloadDependsOnKey, indexer.IndexWorkloadDependsOn).
+				WithObjects(
+					tc.newWorkload,
+					utiltestingapi.MakeWorkload("train", "ns").DependsOn("preprocess").Obj(),
+					utiltestingapi.MakeWorkload("evaluate", "ns").DependsOn("preprocess", "train").Obj(),
+					utiltestingapi.MakeWorkload("report", "ns").DependsOn("evaluate").Obj(),
+					utiltestingapi.MakeWorkload("train", "other").DependsOn("preprocess").Obj(),
+				).
+				Build()
+			h := &workloadDependentsHandler{r: &WorkloadReconciler{client: cl}}
+			wq := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer wq.ShutDown()
+
+			h.Update(ctx, event.UpdateEvent{ObjectOld: tc.oldWorkload, ObjectNew: tc.newWorkload}, wq)
+
+			var got []reconcile.Request
+			for wq.Len() > 0 {
+				req, _ := wq.Get()
+				got = append(got, req)
+				wq.Done(req)
+			}
+			sortRequests := cmpopts.SortSlices(func(a, b reconcile.Request) bool { return a.Name < b.Name })
+			if diff := cmp.Diff(tc.want, got, sortRequests, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
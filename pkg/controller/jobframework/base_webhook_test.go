@@ -239,6 +239,8 @@ func TestValidateOnCreate(t *testing.T) {
 		customValidationFailure field.ErrorList
 		customValidationError   error
 
+		enableWorkloadDependencies bool
+
 		wantError   error
 		wantWarning admission.Warnings // Note: ValidateCreate always returns nil for admission.Warning.
 	}{
@@ -257,6 +259,39 @@ func TestValidateOnCreate(t *testing.T) {
 				field.Invalid(field.NewPath("metadata.annotations"), field.OmitValueType{}, "custom validation test error"),
 			}.ToAggregate(),
 		},
+		{
+			name: "invalid preemption grace period",
+			job:  utiljob.MakeJob("job", metav1.NamespaceDefault).Label(constants.PreemptionGracePeriodSecondsLabel, "0").Obj(),
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("metadata.labels["+constants.PreemptionGracePeriodSecondsLabel+"]"), 0, "should be greater than 0"),
+			}.ToAggregate(),
+		},
+		{
+			name:                       "valid dependencies",
+			job:                        utiljob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.DependsOnAnnotation, "preprocess, train").Obj(),
+			enableWorkloadDependencies: true,
+		},
+		{
+			name:                       "duplicated dependency",
+			job:                        utiljob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.DependsOnAnnotation, "preprocess,preprocess").Obj(),
+			enableWorkloadDependencies: true,
+			wantError: field.ErrorList{
+				field.Duplicate(field.NewPath("metadata.annotations["+constants.DependsOnAnnotation+"]"), "preprocess"),
+			}.ToAggregate(),
+		},
+		{
+			name:                       "invalid dependency name",
+			job:                        utiljob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.DependsOnAnnotation, "Preprocess").Obj(),
+			enableWorkloadDependencies: true,
+			wantError: field.ErrorList{
+				field.Invalid(field.NewPath("metadata.annotations["+constants.DependsOnAnnotation+"]"), "Preprocess",
+					"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			}.ToAggregate(),
+		},
+		{
+			name: "dependencies ignored when feature disabled",
+			job:  utiljob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.DependsOnAnnotation, "Preprocess").Obj(),
+		},
 		{
 			name:                  "invalid request custom validation error",
 			job:                   utiljob.MakeJob("job", metav1.NamespaceDefault).Label(constants.MaxExecTimeSecondsLabel, "0").Obj(),
@@ -273,6 +308,7 @@ func TestValidateOnCreate(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, tc.enableWorkloadDependencies)
 			mockctrl := gomock.NewController(t)
 
 			type mockJob struct {
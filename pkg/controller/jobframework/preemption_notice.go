@@ -0,0 +1,94 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// handlePreemptionNotice signals the preemption notice of the workload to the
+// pods of the running job, and forwards the checkpoint acknowledgement of the
+// job to the workload. Once the notice is withdrawn, it's removed from the
+// pods.
+func (r *JobReconciler) handlePreemptionNotice(ctx context.Context, job GenericJob, wl *kueue.Workload) error {
+	deadline, notified := workload.PreemptionNoticeDeadline(wl)
+	if !notified && !workload.PreemptionNoticeWithdrawn(wl) {
+		return nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	object := job.Object()
+
+	if notified && object.GetAnnotations()[constants.CheckpointAcknowledgedAnnotation] == "true" && !workload.CheckpointAcknowledged(wl) {
+		wlCopy := wl.DeepCopy()
+		metav1.SetMetaDataAnnotation(&wlCopy.ObjectMeta, constants.CheckpointAcknowledgedAnnotation, "true")
+		if err := r.client.Patch(ctx, wlCopy, client.MergeFrom(wl)); err != nil {
+			return fmt.Errorf("acknowledging the checkpoint: %w", err)
+		}
+		log.V(3).Info("Forwarded the checkpoint acknowledgement to the workload")
+	}
+
+	jobWithPodLabelSelector, ok := job.(JobWithPodLabelSelector)
+	if !ok {
+		return nil
+	}
+	selector, err := labels.Parse(jobWithPodLabelSelector.PodLabelSelector())
+	if err != nil {
+		return fmt.Errorf("parsing the pod label selector: %w", err)
+	}
+	var pods corev1.PodList
+	if err := r.client.List(ctx, &pods, client.InNamespace(object.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("listing the pods: %w", err)
+	}
+	var notice string
+	if notified {
+		notice = deadline.UTC().Format(time.RFC3339)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !pod.DeletionTimestamp.IsZero() || pod.Annotations[constants.PreemptionNoticeAnnotation] == notice {
+			continue
+		}
+		podCopy := pod.DeepCopy()
+		if notified {
+			metav1.SetMetaDataAnnotation(&podCopy.ObjectMeta, constants.PreemptionNoticeAnnotation, notice)
+		} else {
+			delete(podCopy.Annotations, constants.PreemptionNoticeAnnotation)
+		}
+		if err := r.client.Patch(ctx, podCopy, client.MergeFrom(pod)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("updating the preemption notice of pod %s: %w", pod.Name, err)
+		}
+		if notified {
+			log.V(3).Info("Signaled the preemption notice to the pod", "pod", klog.KObj(pod), "deadline", notice)
+		} else {
+			log.V(3).Info("Withdrew the preemption notice of the pod", "pod", klog.KObj(pod))
+		}
+	}
+	return nil
+}
@@ -65,6 +65,7 @@ import (
 	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
 	"sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
 	testingmpijob "sigs.k8s.io/kueue/pkg/util/testingjobs/mpijob"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
 
 	. "sigs.k8s.io/kueue/pkg/controller/jobframework"
@@ -1164,6 +1165,156 @@ func TestReconcileGenericJobWithWaitForPodsReady(t *testing.T) {
 	}
 }
 
+func TestReconcileGenericJobWithPreemptionNotice(t *testing.T) {
+	var (
+		testLocalQueueName = kueue.LocalQueueName("default")
+		testGVK            = batchv1.SchemeGroupVersion.WithKind("Job")
+		noticedAt          = time.Now().Truncate(time.Second)
+		wantNotice         = noticedAt.Add(5 * time.Minute).UTC().Format(time.RFC3339)
+	)
+	baseWorkload := utiltestingapi.MakeWorkload("job-test-job", metav1.NamespaceDefault).
+		Finalizers(kueue.ResourceInUseFinalizerName).
+		Label(constants.JobUIDLabel, "test-job").
+		ControllerReference(testGVK, "test-job", "test-job").
+		Queue(testLocalQueueName).
+		PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+		PreemptionGracePeriodSeconds(300).
+		Conditions(metav1.Condition{
+			Type:               kueue.WorkloadAdmitted,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Admitted",
+			Message:            "The workload is admitted",
+			LastTransitionTime: metav1.NewTime(noticedAt),
+		}).
+		Admission(&kueue.Admission{
+			ClusterQueue: "default-cq",
+		})
+	baseJob := testingjob.MakeJob("test-job", metav1.NamespaceDefault).
+		UID("test-job").
+		Label(constants.QueueLabel, string(testLocalQueueName)).
+		Parallelism(1).
+		Suspend(false).
+		Containers(corev1.Container{
+			Name: "c",
+			Resources: corev1.ResourceRequirements{
+				Requests: make(corev1.ResourceList),
+			},
+		})
+	basePod := testingpod.MakePod("test-job-pod", metav1.NamespaceDefault).
+		Label(batchv1.JobNameLabel, "test-job")
+
+	testCases := map[string]struct {
+		enableCheckpointAwarePreemption bool
+		workload                        *kueue.Workload
+		job                             *batchv1.Job
+		podNotice                       string
+		wantPodNotice                   string
+		wantAcknowledged                bool
+	}{
+		"not notified": {
+			enableCheckpointAwarePreemption: true,
+			workload:                        baseWorkload.Clone().Obj(),
+			job:                             baseJob.Clone().Obj(),
+		},
+		"notified": {
+			enableCheckpointAwarePreemption: true,
+			workload: baseWorkload.Clone().
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.InClusterQueueReason,
+					LastTransitionTime: metav1.NewTime(noticedAt),
+				}).
+				Obj(),
+			job:           baseJob.Clone().Obj(),
+			wantPodNotice: wantNotice,
+		},
+		"notified and acknowledged": {
+			enableCheckpointAwarePreemption: true,
+			workload: baseWorkload.Clone().
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.InClusterQueueReason,
+					LastTransitionTime: metav1.NewTime(noticedAt),
+				}).
+				Obj(),
+			job:              baseJob.Clone().SetAnnotation(constants.CheckpointAcknowledgedAnnotation, "true").Obj(),
+			wantPodNotice:    wantNotice,
+			wantAcknowledged: true,
+		},
+		"notice withdrawn": {
+			enableCheckpointAwarePreemption: true,
+			workload: baseWorkload.Clone().
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionFalse,
+					Reason:             kueue.WorkloadPreemptionNoticeExpired,
+					LastTransitionTime: metav1.NewTime(noticedAt),
+				}).
+				Obj(),
+			job:       baseJob.Clone().SetAnnotation(constants.CheckpointAcknowledgedAnnotation, "true").Obj(),
+			podNotice: wantNotice,
+		},
+		"feature disabled": {
+			workload: baseWorkload.Clone().
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadPreemptionPending,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.InClusterQueueReason,
+					LastTransitionTime: metav1.NewTime(noticedAt),
+				}).
+				Obj(),
+			job: baseJob.Clone().SetAnnotation(constants.CheckpointAcknowledgedAnnotation, "true").Obj(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CheckpointAwarePreemption, tc.enableCheckpointAwarePreemption)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			managedNamespace := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).
+				Label("managed-by-kueue", "true").
+				Obj()
+			pod := basePod.Clone().Obj()
+			if tc.podNotice != "" {
+				metav1.SetMetaDataAnnotation(&pod.ObjectMeta, constants.PreemptionNoticeAnnotation, tc.podNotice)
+			}
+			cl := utiltesting.NewClientBuilder(batchv1.AddToScheme, kueue.AddToScheme).
+				WithObjects(tc.workload, tc.job, pod, managedNamespace).
+				WithStatusSubresource(tc.workload, tc.job).
+				WithIndex(&kueue.Workload{}, indexer.OwnerReferenceIndexKey(testGVK), indexer.WorkloadOwnerIndexFunc(testGVK)).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+
+			fakeClock := testingclock.NewFakeClock(noticedAt.Add(time.Minute))
+			r := NewReconciler(cl, &utiltesting.EventRecorder{}, WithClock(fakeClock), WithCache(schdcache.New(cl)))
+			genericJob := (*job.Job)(tc.job)
+			if _, err := r.ReconcileGenericJob(ctx, controllerruntime.Request{
+				NamespacedName: client.ObjectKeyFromObject(tc.job),
+			}, genericJob); err != nil {
+				t.Fatalf("Unexpected reconcile error: %v", err)
+			}
+
+			var gotPod corev1.Pod
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(pod), &gotPod); err != nil {
+				t.Fatalf("Failed to get the pod: %v", err)
+			}
+			if got := gotPod.Annotations[constants.PreemptionNoticeAnnotation]; got != tc.wantPodNotice {
+				t.Errorf("Unexpected preemption notice of the pod, want=%q, got=%q", tc.wantPodNotice, got)
+			}
+
+			var gotWorkload kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), &gotWorkload); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if got := gotWorkload.Annotations[constants.CheckpointAcknowledgedAnnotation] == "true"; got != tc.wantAcknowledged {
+				t.Errorf("Unexpected checkpoint acknowledgement of the workload, want=%v, got=%v", tc.wantAcknowledged, got)
+			}
+		})
+	}
+}
+
 func TestReconcileGenericJob_EvictionClearsQuotaReservation(t *testing.T) {
 	scenarios := []map[featuregate.Feature]bool{
 		{
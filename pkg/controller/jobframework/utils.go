@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -203,6 +204,39 @@ func MaximumExecutionTimeSecondsForObject(object client.Object) *int32 {
 	return new(int32(v))
 }
 
+// PreemptionGracePeriodSecondsForObject extracts and parses the preemption grace
+// period in seconds from the given object's labels.
+func PreemptionGracePeriodSecondsForObject(object client.Object) *int32 {
+	strVal, found := object.GetLabels()[controllerconstants.PreemptionGracePeriodSecondsLabel]
+	if !found {
+		return nil
+	}
+
+	v, err := strconv.ParseInt(strVal, 10, 32)
+	if err != nil || v <= 0 {
+		return nil
+	}
+
+	return new(int32(v))
+}
+
+// DependsOnForObject extracts the dependencies of the workload from the
+// given object's depends-on annotation.
+func DependsOnForObject(object client.Object) []kueue.WorkloadDependency {
+	strVal, found := object.GetAnnotations()[controllerconstants.DependsOnAnnotation]
+	if !found || !features.Enabled(features.WorkloadDependencies) {
+		return nil
+	}
+
+	var dependencies []kueue.WorkloadDependency
+	for name := range strings.SplitSeq(strVal, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			dependencies = append(dependencies, kueue.WorkloadDependency{Name: name})
+		}
+	}
+	return dependencies
+}
+
 // WorkloadPriorityClassName retrieves the value of the "kueue.x-k8s.io/priority-class" label
 // from the given object. If the label is not present, it returns an empty string.
 func WorkloadPriorityClassName(object client.Object) string {
@@ -265,6 +299,12 @@ func NewWorkload(name string, obj client.Object, podSets []kueue.PodSet, labelKe
 	if features.Enabled(features.CustomMetricLabels) {
 		maps.Copy(&annotations, maps.FilterKeys(obj.GetAnnotations(), annotationsToCopy.UnsortedList()))
 	}
+	if deadline, found := obj.GetAnnotations()[controllerconstants.DeadlineAnnotationKey]; found && features.Enabled(features.DeadlineAwareQueueing) {
+		annotations[controllerconstants.DeadlineAnnotationKey] = deadline
+	}
+	if reservation, found := obj.GetAnnotations()[controllerconstants.QuotaReservationAnnotationKey]; found && features.Enabled(features.QuotaReservations) {
+		annotations[controllerconstants.QuotaReservationAnnotationKey] = reservation
+	}
 	return &kueue.Workload{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
@@ -274,9 +314,11 @@ func NewWorkload(name string, obj client.Object, podSets []kueue.PodSet, labelKe
 			Annotations: annotations,
 		},
 		Spec: kueue.WorkloadSpec{
-			QueueName:                   QueueNameForObject(obj),
-			PodSets:                     podSets,
-			MaximumExecutionTimeSeconds: MaximumExecutionTimeSecondsForObject(obj),
+			QueueName:                    QueueNameForObject(obj),
+			PodSets:                      podSets,
+			MaximumExecutionTimeSeconds:  MaximumExecutionTimeSecondsForObject(obj),
+			PreemptionGracePeriodSeconds: PreemptionGracePeriodSecondsForObject(obj),
+			DependsOn:                    DependsOnForObject(obj),
 		},
 	}
 }
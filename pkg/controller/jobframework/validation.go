@@ -45,15 +45,20 @@ import (
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
+// maxDependencies is the maximum number of items of the dependsOn of a Workload.
+const maxDependencies = 8
+
 var (
 	metaPath                       = field.NewPath("metadata")
 	labelsPath                     = metaPath.Child("labels")
 	annotationsPath                = metaPath.Child("annotations")
 	queueNameLabelPath             = labelsPath.Key(constants.QueueLabel)
 	maxExecTimeLabelPath           = labelsPath.Key(constants.MaxExecTimeSecondsLabel)
+	preemptionGracePeriodLabelPath = labelsPath.Key(constants.PreemptionGracePeriodSecondsLabel)
 	workloadPriorityClassNamePath  = labelsPath.Key(constants.WorkloadPriorityClassLabel)
 	prebuiltWorkloadLabelPath      = labelsPath.Key(constants.PrebuiltWorkloadLabel)
 	prebuiltWorkloadAnnotationPath = annotationsPath.Key(constants.PrebuiltWorkloadAnnotation)
+	dependsOnAnnotationPath        = annotationsPath.Key(constants.DependsOnAnnotation)
 	elasticJobAnnotationPath       = annotationsPath.Key(workloadslicing.EnabledAnnotationKey)
 	supportedElasticJobGVKs        = sets.New(
 		batchv1.SchemeGroupVersion.WithKind("Job").String(),
@@ -83,6 +88,8 @@ func ValidateJobOnCreate(job GenericJob) field.ErrorList {
 	allErrs := ValidateQueueName(job.Object())
 	allErrs = append(allErrs, validateCreateForPrebuiltWorkload(job)...)
 	allErrs = append(allErrs, validateCreateForMaxExecTime(job)...)
+	allErrs = append(allErrs, validateCreateForPreemptionGracePeriod(job)...)
+	allErrs = append(allErrs, validateCreateForDependsOn(job)...)
 	allErrs = append(allErrs, ValidateElasticJobAnnotation(job.Object(), job.GVK())...)
 
 	if features.Enabled(features.AdmissionGatedBy) {
@@ -235,6 +242,42 @@ func validateCreateForMaxExecTime(job GenericJob) field.ErrorList {
 	return nil
 }
 
+func validateCreateForPreemptionGracePeriod(job GenericJob) field.ErrorList {
+	if strVal, found := job.Object().GetLabels()[constants.PreemptionGracePeriodSecondsLabel]; found {
+		v, err := strconv.Atoi(strVal)
+		if err != nil {
+			return field.ErrorList{field.Invalid(preemptionGracePeriodLabelPath, strVal, err.Error())}
+		}
+
+		if v <= 0 {
+			return field.ErrorList{field.Invalid(preemptionGracePeriodLabelPath, v, "should be greater than 0")}
+		}
+	}
+	return nil
+}
+
+func validateCreateForDependsOn(job GenericJob) field.ErrorList {
+	strVal, found := job.Object().GetAnnotations()[constants.DependsOnAnnotation]
+	if !found || !features.Enabled(features.WorkloadDependencies) {
+		return nil
+	}
+	dependencies := DependsOnForObject(job.Object())
+	if len(dependencies) > maxDependencies {
+		return field.ErrorList{field.TooMany(dependsOnAnnotationPath, len(dependencies), maxDependencies)}
+	}
+	names := sets.New[string]()
+	for _, dep := range dependencies {
+		if errs := validation.IsDNS1123Subdomain(dep.Name); len(errs) > 0 {
+			return field.ErrorList{field.Invalid(dependsOnAnnotationPath, strVal, strings.Join(errs, ","))}
+		}
+		if names.Has(dep.Name) {
+			return field.ErrorList{field.Duplicate(dependsOnAnnotationPath, dep.Name)}
+		}
+		names.Insert(dep.Name)
+	}
+	return nil
+}
+
 func validateUpdateForMaxExecTime(oldJob, newJob GenericJob) field.ErrorList {
 	if !newJob.IsSuspended() || !oldJob.IsSuspended() {
 		return apivalidation.ValidateImmutableField(
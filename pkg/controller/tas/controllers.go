@@ -25,6 +25,7 @@ import (
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
@@ -32,7 +33,8 @@ import (
 type SetupControllersOption func(*setupControllersOptions)
 
 type setupControllersOptions struct {
-	podUsageOpts []podUsageOption
+	podUsageOpts              []podUsageOption
+	resourceSliceAPIAvailable bool
 }
 
 // WithRequeueBatchInterval overrides the interval at which freed non-TAS
@@ -43,6 +45,15 @@ func WithRequeueBatchInterval(d time.Duration) SetupControllersOption {
 	}
 }
 
+// WithResourceSliceAPIAvailable indicates whether the ResourceSlice API is
+// served, which is required to read the Topology levels backed by a device
+// attribute.
+func WithResourceSliceAPIAvailable(available bool) SetupControllersOption {
+	return func(o *setupControllersOptions) {
+		o.resourceSliceAPIAvailable = available
+	}
+}
+
 func SetupControllers(
 	mgr ctrl.Manager,
 	queues *qcache.Manager,
@@ -83,5 +94,20 @@ func SetupControllers(
 			err,
 		)
 	}
+	if features.Enabled(features.TASDeviceTopologyLevels) && options.resourceSliceAPIAvailable {
+		resourceSliceRec := newResourceSliceReconciler(mgr.GetClient(), queues, cache, roleTracker)
+		if ctrlName, err := resourceSliceRec.setupWithManager(mgr); err != nil {
+			return ctrlName, err
+		}
+	}
+	if features.Enabled(features.TASDefragmentation) {
+		defragmenter := newDefragmenter(mgr.GetClient(), mgr.GetEventRecorder(TASDefragmentationController), queues, cache, cfg.TASDefragmentation, roleTracker)
+		if err := mgr.Add(defragmenter); err != nil {
+			return TASDefragmentationController, fmt.Errorf(
+				"unable to add defragmenter: %w",
+				err,
+			)
+		}
+	}
 	return "", nil
 }
@@ -0,0 +1,172 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
+)
+
+const (
+	defaultDefragmentationInterval             = 5 * time.Minute
+	defaultDefragmentationMaxEvictionsPerRound = 5
+)
+
+// defragmenter periodically looks for a pending workload requiring a topology
+// level which would fit after evicting a bounded set of lower priority
+// workloads from a single topology domain, and evicts them.
+type defragmenter struct {
+	client      client.Client
+	recorder    events.EventRecorder
+	queues      *qcache.Manager
+	cache       *schdcache.Cache
+	clock       clock.Clock
+	roleTracker *roletracker.RoleTracker
+
+	interval             time.Duration
+	maxEvictionsPerRound int32
+	maxVictimPriority    *int32
+}
+
+var _ manager.Runnable = (*defragmenter)(nil)
+
+func newDefragmenter(client client.Client, recorder events.EventRecorder, queues *qcache.Manager, cache *schdcache.Cache, cfg *configapi.TASDefragmentation, roleTracker *roletracker.RoleTracker) *defragmenter {
+	d := &defragmenter{
+		client:               client,
+		recorder:             recorder,
+		queues:               queues,
+		cache:                cache,
+		clock:                clock.RealClock{},
+		roleTracker:          roleTracker,
+		interval:             defaultDefragmentationInterval,
+		maxEvictionsPerRound: defaultDefragmentationMaxEvictionsPerRound,
+	}
+	if cfg != nil {
+		if cfg.Interval != nil {
+			d.interval = cfg.Interval.Duration
+		}
+		if cfg.MaxEvictionsPerRound != nil {
+			d.maxEvictionsPerRound = *cfg.MaxEvictionsPerRound
+		}
+		d.maxVictimPriority = cfg.MaxVictimPriority
+	}
+	return d
+}
+
+// Start implements the Runnable interface. It runs a defragmentation round
+// every interval, until the context is done.
+func (d *defragmenter) Start(ctx context.Context) error {
+	log := roletracker.WithReplicaRole(ctrl.LoggerFrom(ctx).WithName(TASDefragmentationController), d.roleTracker)
+	ctx = ctrl.LoggerInto(ctx, log)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := d.defragment(ctx); err != nil {
+				log.Error(err, "Failed to defragment the topology domains")
+			}
+		}
+	}
+}
+
+// defragment evicts the victims of the first defragmentation plan found for
+// the pending workloads, visited in the queue order of their ClusterQueues.
+// At most one plan is executed per round, so that the scheduler can admit the
+// pending workload before the next round.
+func (d *defragmenter) defragment(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	snapshot, err := d.cache.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	cqNames := d.queues.GetClusterQueueNames()
+	slices.Sort(cqNames)
+	for _, cqName := range cqNames {
+		cq := snapshot.ClusterQueue(cqName)
+		if cq == nil || len(cq.TASFlavors) == 0 {
+			continue
+		}
+		for _, pending := range d.queues.PendingWorkloadsInfo(cqName) {
+			plan := snapshot.FindDefragmentationPlan(ctx, cqName, pending, d.isCandidateFor(pending), int(d.maxEvictionsPerRound))
+			if plan == nil {
+				continue
+			}
+			log.V(2).Info("Defragmenting topology domain", "workload", klog.KObj(pending.Obj), "flavor", plan.Flavor, "domain", plan.Domain, "victims", len(plan.Victims))
+			return d.evict(ctx, log, pending, plan)
+		}
+	}
+	return nil
+}
+
+// isCandidateFor returns whether an admitted workload can be evicted to free a
+// topology domain for the pending workload.
+func (d *defragmenter) isCandidateFor(pending *workload.Info) func(*workload.Info) bool {
+	pendingPriority := priority.Priority(pending.Obj)
+	return func(wl *workload.Info) bool {
+		if wl.Obj.UID == pending.Obj.UID || workloadevict.IsEvicted(wl.Obj) {
+			return false
+		}
+		wlPriority := priority.Priority(wl.Obj)
+		if wlPriority >= pendingPriority {
+			return false
+		}
+		return d.maxVictimPriority == nil || wlPriority <= *d.maxVictimPriority
+	}
+}
+
+func (d *defragmenter) evict(ctx context.Context, log logr.Logger, pending *workload.Info, plan *schdcache.DefragmentationPlan) error {
+	message := fmt.Sprintf("Evicted to free the topology domain %q of the flavor %q for the workload %s", plan.Domain, plan.Flavor, klog.KObj(pending.Obj))
+	for _, victim := range plan.Victims {
+		wlCopy := victim.Obj.DeepCopy()
+		exposeLqMetrics := d.cache.ShouldExposeLocalQueueMetricsForWorkload(log, wlCopy)
+		if err := workloadevict.Evict(
+			ctx, d.client, d.recorder, wlCopy,
+			kueue.WorkloadEvictedByTASDefragmentation, message, "",
+			d.clock, exposeLqMetrics, d.roleTracker, nil,
+			workloadevict.WithLooseOnApply(), workloadevict.WithRetryOnConflict(),
+		); err != nil {
+			return err
+		}
+		log.V(3).Info("Evicted workload for defragmentation", "workload", klog.KObj(victim.Obj), "pendingWorkload", klog.KObj(pending.Obj))
+	}
+	d.recorder.Eventf(pending.Obj, nil, corev1.EventTypeNormal, "TASDefragmentation", "TASDefragmentation",
+		"Evicted %d workloads to free the topology domain %q of the flavor %q", len(plan.Victims), plan.Domain, plan.Flavor)
+	return nil
+}
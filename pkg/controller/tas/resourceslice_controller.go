@@ -0,0 +1,186 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/dra"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+)
+
+// resourceSliceReconciler reads the values of the Topology levels backed by
+// a device attribute from the ResourceSlices published for a node, and
+// merges them into the node held by the TAS cache. Requests are keyed by
+// the node name.
+type resourceSliceReconciler struct {
+	client      client.Client
+	queues      *qcache.Manager
+	cache       *schdcache.Cache
+	roleTracker *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*resourceSliceReconciler)(nil)
+var _ predicate.TypedPredicate[*resourcev1.ResourceSlice] = (*resourceSliceReconciler)(nil)
+
+func newResourceSliceReconciler(c client.Client, queues *qcache.Manager, cache *schdcache.Cache, roleTracker *roletracker.RoleTracker) *resourceSliceReconciler {
+	return &resourceSliceReconciler{
+		client:      c,
+		queues:      queues,
+		cache:       cache,
+		roleTracker: roleTracker,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=topologies,verbs=get;list;watch
+
+func (r *resourceSliceReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Reconcile device topology levels of the Node")
+
+	levels := r.cache.TASCache().DeviceTopologyLevels()
+	values, err := dra.NewResourceSliceCache(r.client).TopologyLevelValues(ctx, req.Name, levels)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if r.cache.TASCache().SyncNodeDeviceLabels(req.Name, values) {
+		log.V(2).Info("Device topology levels of the Node changed", "values", values)
+		// requeue inadmissible workloads as the node may now belong to
+		// a topology domain which can accommodate them.
+		if cqNames := r.cache.ActiveClusterQueues(); len(cqNames) > 0 {
+			qcache.NotifyRetryInadmissible(r.queues, cqNames)
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *resourceSliceReconciler) Create(e event.TypedCreateEvent[*resourcev1.ResourceSlice]) bool {
+	return r.publishesDeviceLevels(e.Object)
+}
+
+func (r *resourceSliceReconciler) Update(e event.TypedUpdateEvent[*resourcev1.ResourceSlice]) bool {
+	return r.publishesDeviceLevels(e.ObjectOld) || r.publishesDeviceLevels(e.ObjectNew)
+}
+
+func (r *resourceSliceReconciler) Delete(e event.TypedDeleteEvent[*resourcev1.ResourceSlice]) bool {
+	return r.publishesDeviceLevels(e.Object)
+}
+
+func (r *resourceSliceReconciler) Generic(event.TypedGenericEvent[*resourcev1.ResourceSlice]) bool {
+	return false
+}
+
+// publishesDeviceLevels returns true if the slice is local to a node, and
+// published by a driver referenced by a Topology level.
+func (r *resourceSliceReconciler) publishesDeviceLevels(slice *resourcev1.ResourceSlice) bool {
+	if slice.Spec.NodeName == nil {
+		return false
+	}
+	for _, level := range r.cache.TASCache().DeviceTopologyLevels() {
+		if level.DeviceAttribute.Driver == slice.Spec.Driver {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *resourceSliceReconciler) setupWithManager(mgr ctrl.Manager) (string, error) {
+	return TASResourceSliceController, builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("tas_resourceslice_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&resourcev1.ResourceSlice{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, slice *resourcev1.ResourceSlice) []reconcile.Request {
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: *slice.Spec.NodeName}}}
+			}),
+			r,
+		)).
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.Topology{},
+			&deviceTopologyHandler{client: r.client},
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection: new(false),
+			LogConstructor:     roletracker.NewLogConstructor(r.roleTracker, TASResourceSliceController),
+		}).
+		Complete(r)
+}
+
+var _ handler.TypedEventHandler[*kueue.Topology, reconcile.Request] = (*deviceTopologyHandler)(nil)
+
+// deviceTopologyHandler triggers reconcile for all the nodes when a Topology
+// gains or loses levels backed by a device attribute, as the values of the
+// levels need to be read again from the ResourceSlices.
+type deviceTopologyHandler struct {
+	client client.Client
+}
+
+func (h *deviceTopologyHandler) Create(ctx context.Context, e event.TypedCreateEvent[*kueue.Topology], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if len(utiltas.DeviceLevels(e.Object)) > 0 {
+		h.queueAllNodes(ctx, q)
+	}
+}
+
+func (h *deviceTopologyHandler) Update(ctx context.Context, e event.TypedUpdateEvent[*kueue.Topology], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if len(utiltas.DeviceLevels(e.ObjectOld)) > 0 || len(utiltas.DeviceLevels(e.ObjectNew)) > 0 {
+		h.queueAllNodes(ctx, q)
+	}
+}
+
+func (h *deviceTopologyHandler) Delete(ctx context.Context, e event.TypedDeleteEvent[*kueue.Topology], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if len(utiltas.DeviceLevels(e.Object)) > 0 {
+		h.queueAllNodes(ctx, q)
+	}
+}
+
+func (h *deviceTopologyHandler) Generic(context.Context, event.TypedGenericEvent[*kueue.Topology], workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+func (h *deviceTopologyHandler) queueAllNodes(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	var nodes corev1.NodeList
+	if err := h.client.List(ctx, &nodes); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list nodes for the device topology levels")
+		return
+	}
+	// the requests are delayed to let the TAS cache observe the Topology
+	// update first.
+	for _, node := range nodes.Items {
+		q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{Name: node.Name}}, constants.UpdatesBatchPeriod)
+	}
+}
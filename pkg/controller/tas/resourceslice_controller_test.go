@@ -0,0 +1,133 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestResourceSliceReconciler(t *testing.T) {
+	const (
+		driver      = "gpu.nvidia.com"
+		cliqueLevel = "example.com/nvlink-clique"
+	)
+	topology := utiltestingapi.MakeTopology("default").
+		Levels(cliqueLevel, corev1.LabelHostname).
+		DeviceAttribute(cliqueLevel, driver, "cliqueID").
+		Obj()
+
+	cases := map[string]struct {
+		slices     []*resourcev1.ResourceSlice
+		wantLabels map[string]string
+	}{
+		"no slices": {},
+		"devices in a single clique": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("node1-gpus", driver).NodeName("node1").
+					Device("gpu-0").Attribute("cliqueID", "c1").
+					Device("gpu-1").Attribute("cliqueID", "c1").
+					Obj(),
+			},
+			wantLabels: map[string]string{cliqueLevel: "c1"},
+		},
+		"devices of another node": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("node2-gpus", driver).NodeName("node2").
+					Device("gpu-0").Attribute("cliqueID", "c1").
+					Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASDeviceTopologyLevels, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder().
+				WithIndex(&resourcev1.ResourceSlice{}, "spec.driver", func(obj client.Object) []string {
+					return []string{obj.(*resourcev1.ResourceSlice).Spec.Driver}
+				})
+			for _, slice := range tc.slices {
+				builder = builder.WithObjects(slice)
+			}
+			cl := builder.Build()
+			cache := schdcache.New(cl)
+			cache.AddOrUpdateTopology(log, topology)
+			cache.TASCache().SyncNode(testingnode.MakeNode("node1").Label(corev1.LabelHostname, "node1").Ready().Obj())
+
+			r := newResourceSliceReconciler(cl, nil, cache, nil)
+			if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "node1"}}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			// Syncing the expected labels again is a no-op only if the
+			// reconciler already stored them.
+			if cache.TASCache().SyncNodeDeviceLabels("node1", tc.wantLabels) {
+				t.Errorf("Unexpected device labels of the node, want %v", tc.wantLabels)
+			}
+		})
+	}
+}
+
+func TestResourceSliceReconcilerPredicate(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TASDeviceTopologyLevels, true)
+	_, log := utiltesting.ContextWithLog(t)
+	cache := schdcache.New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateTopology(log, utiltestingapi.MakeTopology("default").
+		Levels("example.com/nvlink-clique", corev1.LabelHostname).
+		DeviceAttribute("example.com/nvlink-clique", "gpu.nvidia.com", "cliqueID").
+		Obj())
+	r := newResourceSliceReconciler(nil, nil, cache, nil)
+
+	cases := map[string]struct {
+		slice *resourcev1.ResourceSlice
+		want  bool
+	}{
+		"slice of a driver backing a level": {
+			slice: utiltesting.MakeResourceSlice("gpus", "gpu.nvidia.com").Obj(),
+			want:  true,
+		},
+		"slice of another driver": {
+			slice: utiltesting.MakeResourceSlice("nics", "nic.example.com").Obj(),
+		},
+		"slice not local to a node": {
+			slice: func() *resourcev1.ResourceSlice {
+				slice := utiltesting.MakeResourceSlice("gpus", "gpu.nvidia.com").Obj()
+				slice.Spec.NodeName = nil
+				return slice
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := r.Create(event.TypedCreateEvent[*resourcev1.ResourceSlice]{Object: tc.slice}); got != tc.want {
+				t.Errorf("Unexpected result of the predicate: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,9 +18,15 @@ package dra
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
 // DriverReference is the name of a DRA driver as it appears in
@@ -33,6 +39,8 @@ const allDriversKey DriverReference = ""
 // It is created once per workload reconciliation and passed to both the CEL
 // validation and counter processing paths to avoid duplicate API calls and
 // ensure a consistent snapshot of ResourceSlices within a single reconciliation.
+// TAS creates one per node reconciliation to read the values of the Topology
+// levels backed by device attributes.
 type ResourceSliceCache struct {
 	client client.Client
 	// cachedSlices maps a DriverReference to its cached ResourceSlice listing.
@@ -89,3 +97,82 @@ func (c *ResourceSliceCache) ListAll(ctx context.Context) ([]resourcev1.Resource
 	c.cachedSlices[allDriversKey] = sliceList.Items
 	return sliceList.Items, nil
 }
+
+// TopologyLevelValues returns the values of the Topology levels backed by a
+// device attribute for the node, keyed by the node label naming the level.
+// A level is omitted when the driver publishes no devices with the attribute
+// for the node, when the devices disagree on its value, or when the value is
+// not a valid label value.
+func (c *ResourceSliceCache) TopologyLevelValues(ctx context.Context, nodeName string, levels []kueue.TopologyLevel) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, level := range levels {
+		if level.DeviceAttribute == nil {
+			continue
+		}
+		slices, err := c.ListByDriver(ctx, DriverReference(level.DeviceAttribute.Driver))
+		if err != nil {
+			return nil, err
+		}
+		value, found := nodeDeviceAttribute(slices, nodeName, level.DeviceAttribute)
+		if found && len(validation.IsValidLabelValue(value)) == 0 {
+			values[level.NodeLabel] = value
+		}
+	}
+	return values, nil
+}
+
+// nodeDeviceAttribute returns the value of the attribute shared by the devices
+// the driver publishes for the node. Slices of outdated pool generations are
+// ignored, as they are about to be replaced by the driver.
+func nodeDeviceAttribute(slices []resourcev1.ResourceSlice, nodeName string, attribute *kueue.TopologyDeviceAttribute) (string, bool) {
+	name := qualifiedAttributeName(attribute.Driver, attribute.Name)
+	poolGenerations := make(map[string]int64)
+	for i := range slices {
+		pool := slices[i].Spec.Pool
+		poolGenerations[pool.Name] = max(poolGenerations[pool.Name], pool.Generation)
+	}
+	var value string
+	var found bool
+	for i := range slices {
+		slice := &slices[i]
+		if ptr.Deref(slice.Spec.NodeName, "") != nodeName || slice.Spec.Pool.Generation < poolGenerations[slice.Spec.Pool.Name] {
+			continue
+		}
+		for _, device := range slice.Spec.Devices {
+			for key, deviceAttribute := range device.Attributes {
+				if qualifiedAttributeName(slice.Spec.Driver, string(key)) != name {
+					continue
+				}
+				v, ok := attributeValue(deviceAttribute)
+				if !ok || (found && v != value) {
+					return "", false
+				}
+				value, found = v, true
+			}
+		}
+	}
+	return value, found
+}
+
+// qualifiedAttributeName qualifies an attribute name without a domain with
+// the name of the driver, as done by the DRA scheduler.
+func qualifiedAttributeName(driver, name string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	return driver + "/" + name
+}
+
+func attributeValue(attribute resourcev1.DeviceAttribute) (string, bool) {
+	switch {
+	case attribute.StringValue != nil:
+		return *attribute.StringValue, true
+	case attribute.VersionValue != nil:
+		return *attribute.VersionValue, true
+	case attribute.IntValue != nil:
+		return strconv.FormatInt(*attribute.IntValue, 10), true
+	case attribute.BoolValue != nil:
+		return strconv.FormatBool(*attribute.BoolValue), true
+	}
+	return "", false
+}
@@ -22,12 +22,14 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	resourcev1 "k8s.io/api/resource/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 )
 
 func newSlice(name, driver, pool string) *resourcev1.ResourceSlice {
@@ -220,3 +222,98 @@ func TestResourceSliceCache_ListByDriverThenListByDriverDifferent(t *testing.T)
 		t.Errorf("expected still 2 API calls (gpu cached), got %d", listCallCount)
 	}
 }
+
+func TestResourceSliceCache_TopologyLevelValues(t *testing.T) {
+	const (
+		driver      = "gpu.nvidia.com"
+		blockLevel  = "cloud.provider.com/topology-block"
+		cliqueLevel = "example.com/nvlink-clique"
+	)
+	levels := utiltestingapi.MakeTopology("default").
+		Levels(blockLevel, cliqueLevel, corev1.LabelHostname).
+		DeviceAttribute(cliqueLevel, driver, "cliqueID").
+		Obj().Spec.Levels
+
+	cases := map[string]struct {
+		slices []*resourcev1.ResourceSlice
+		want   map[string]string
+	}{
+		"no slices for the node": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("other", driver).NodeName("node2").
+					Device("gpu-0").Attribute("cliqueID", "a").Obj(),
+			},
+			want: map[string]string{},
+		},
+		"devices agree on the value": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("slice", driver).NodeName("node1").
+					Device("gpu-0").Attribute("cliqueID", "a").
+					Device("gpu-1").Attribute(driver+"/cliqueID", "a").
+					Obj(),
+			},
+			want: map[string]string{cliqueLevel: "a"},
+		},
+		"devices without the attribute are ignored": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("slice", driver).NodeName("node1").
+					Device("gpu-0").Attribute("cliqueID", "a").
+					Device("nic-0").Attribute("pcieRoot", "pci0000:00").
+					Obj(),
+			},
+			want: map[string]string{cliqueLevel: "a"},
+		},
+		"devices disagree on the value": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("slice", driver).NodeName("node1").
+					Device("gpu-0").Attribute("cliqueID", "a").
+					Device("gpu-1").Attribute("cliqueID", "b").
+					Obj(),
+			},
+			want: map[string]string{},
+		},
+		"attribute of another domain": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("slice", driver).NodeName("node1").
+					Device("gpu-0").Attribute("example.com/cliqueID", "a").Obj(),
+			},
+			want: map[string]string{},
+		},
+		"slices of an outdated pool generation are ignored": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("old", driver).NodeName("node1").Pool("node1", 1, 1).
+					Device("gpu-0").Attribute("cliqueID", "a").Obj(),
+				utiltesting.MakeResourceSlice("new", driver).NodeName("node1").Pool("node1", 2, 1).
+					Device("gpu-0").Attribute("cliqueID", "b").Obj(),
+			},
+			want: map[string]string{cliqueLevel: "b"},
+		},
+		"value which is not a valid label value": {
+			slices: []*resourcev1.ResourceSlice{
+				utiltesting.MakeResourceSlice("slice", driver).NodeName("node1").
+					Device("gpu-0").Attribute("cliqueID", "a b").Obj(),
+			},
+			want: map[string]string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			builder := utiltesting.NewClientBuilder().
+				WithIndex(&resourcev1.ResourceSlice{}, "spec.driver", func(obj client.Object) []string {
+					return []string{obj.(*resourcev1.ResourceSlice).Spec.Driver}
+				})
+			for _, slice := range tc.slices {
+				builder = builder.WithObjects(slice)
+			}
+			cache := NewResourceSliceCache(builder.Build())
+
+			got, err := cache.TopologyLevelValues(t.Context(), "node1", levels)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected level values (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -554,6 +554,86 @@ const (
 	// Refuse to adopt an existing Workload by pod-group name when it was not created
 	// by the pod-group framework (missing is-group-workload annotation).
 	PodIntegrationValidateGroupOwner featuregate.Feature = "PodIntegrationValidateGroupOwner"
+
+	// Enables recurring time windows that override the quotas of ClusterQueues
+	// and Cohorts.
+	QuotaSchedules featuregate.Feature = "QuotaSchedules"
+
+	// Enables the EarliestDeadlineFirst queueing strategy and the
+	// DeadlineUnattainable condition of pending Workloads.
+	DeadlineAwareQueueing featuregate.Feature = "DeadlineAwareQueueing"
+
+	// Enables resource-time budgets on LocalQueues.
+	LocalQueueBudgets featuregate.Feature = "LocalQueueBudgets"
+
+	// Enables the preemption explanation subresource of workloads in the visibility API.
+	VisibilityPreemptionExplanation featuregate.Feature = "VisibilityPreemptionExplanation"
+
+	// Enables accounting of the cost of admitted workloads based on
+	// ResourceFlavor prices.
+	WorkloadCostAccounting featuregate.Feature = "WorkloadCostAccounting"
+
+	// Enables Workloads whose PodSets are charged to different ClusterQueues
+	// of the same cohort and admitted atomically.
+	MultiQueueWorkloads featuregate.Feature = "MultiQueueWorkloads"
+
+	// Enables recording resource recommendations for admitted Workloads and
+	// LocalQueues, based on the pod usage reported by the metrics API.
+	WorkloadRightSizing featuregate.Feature = "WorkloadRightSizing"
+
+	// Enables the MultiKueue dispatcher which nominates the worker clusters
+	// by the predicted fit of the Workload in their ClusterQueues.
+	MultiKueueCapacityAwareDispatcher featuregate.Feature = "MultiKueueCapacityAwareDispatcher"
+
+	// Enables MultiKueue to dispatch a Workload evicted by its worker cluster
+	// to other worker clusters, excluding that cluster for a cooldown period.
+	MultiKueueWorkloadMigration featuregate.Feature = "MultiKueueWorkloadMigration"
+
+	// Enables tracking the health of the MultiKueue worker clusters, and
+	// cordoning them, manually or when they cross the configured thresholds.
+	MultiKueueClusterHealth featuregate.Feature = "MultiKueueClusterHealth"
+
+	// Enables propagating the topology request of a PodSet into the
+	// ProvisioningRequest, and placing the Workload with TAS on the
+	// topology domain that was provisioned.
+	TASProvisioningRequestTopology featuregate.Feature = "TASProvisioningRequestTopology"
+
+	// Enables evicting low-priority workloads which fragment the topology
+	// domains, to free a whole domain for a pending workload requiring a
+	// topology level.
+	TASDefragmentation featuregate.Feature = "TASDefragmentation"
+
+	// Enables limiting the preemptions issued by the workloads of a
+	// ClusterQueue within a sliding time window.
+	PreemptionBudgets featuregate.Feature = "PreemptionBudgets"
+
+	// Enables notifying the preempted workloads which declare a preemption
+	// grace period, and delaying their eviction until they checkpoint.
+	CheckpointAwarePreemption featuregate.Feature = "CheckpointAwarePreemption"
+
+	// Enables protecting the workloads admitted to a ClusterQueue from
+	// preemption until they've run for the minimum runtime of the ClusterQueue.
+	PreemptionMinimumRuntime featuregate.Feature = "PreemptionMinimumRuntime"
+
+	// Enables holding the workloads declaring dependencies out of the queues
+	// until the workloads they depend on finish successfully.
+	WorkloadDependencies featuregate.Feature = "WorkloadDependencies"
+
+	// Enables booking the quota of a ClusterQueue or Cohort for a time window
+	// with QuotaReservations.
+	QuotaReservations featuregate.Feature = "QuotaReservations"
+
+	// Enables provisioning LocalQueues from the localQueueTemplate of the
+	// ClusterQueues in the namespaces matching their namespaceSelector.
+	LocalQueueProvisioning featuregate.Feature = "LocalQueueProvisioning"
+
+	// Enables lowering the effective priority of the workloads admitted to a
+	// ClusterQueue as they run, following its priorityDecay policy.
+	PriorityDecay featuregate.Feature = "PriorityDecay"
+
+	// Enables Topology levels whose values are read from an attribute of the
+	// DRA devices published in ResourceSlices, rather than from node labels.
+	TASDeviceTopologyLevels featuregate.Feature = "TASDeviceTopologyLevels"
 )
 
 func init() {
@@ -574,6 +654,7 @@ var defaultFeatureGateDependencies = map[featuregate.Feature][]featuregate.Featu
 	TASHandleOverlappingFlavors:                 {TopologyAwareScheduling},
 	TASProfileMixed:                             {TopologyAwareScheduling},
 	TASRecomputeAssignmentWithinSchedulingCycle: {TopologyAwareScheduling},
+	TASDeviceTopologyLevels:                     {TopologyAwareScheduling},
 	ElasticJobsViaWorkloadSlicesWithTAS:         {ElasticJobsViaWorkloadSlices, TopologyAwareScheduling},
 	KueueDRAIntegrationExtendedResource:         {KueueDRAIntegration},
 	KueueDRAIntegrationPartitionableDevices:     {KueueDRAIntegration},
@@ -863,6 +944,86 @@ var defaultVersionedFeatureGates = map[featuregate.Feature]featuregate.Versioned
 	PodIntegrationValidateGroupOwner: {
 		{Version: version.MustParse("0.20"), Default: true, PreRelease: featuregate.Beta},
 	},
+
+	QuotaSchedules: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	DeadlineAwareQueueing: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	LocalQueueBudgets: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	VisibilityPreemptionExplanation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	WorkloadCostAccounting: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiQueueWorkloads: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	WorkloadRightSizing: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueCapacityAwareDispatcher: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueWorkloadMigration: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueClusterHealth: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASProvisioningRequestTopology: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASDefragmentation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	PreemptionBudgets: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	CheckpointAwarePreemption: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	PreemptionMinimumRuntime: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	WorkloadDependencies: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	QuotaReservations: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	LocalQueueProvisioning: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	PriorityDecay: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASDeviceTopologyLevels: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) {
@@ -100,10 +100,18 @@ var (
 	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",replica_role="one of `leader`, `follower`, or `standalone`"
 	AdmissionCyclePreemptionSkips *prometheus.GaugeVec
 
+	// +metricsdoc:group=clusterqueue
+	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",replica_role="one of `leader`, `follower`, or `standalone`"
+	PreemptionBudgetPreemptedWorkloads *prometheus.GaugeVec
+
 	// +metricsdoc:group=clusterqueue
 	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",result="one of `new_targets`, `deferred_fit`, or `skipped`",replica_role="one of `leader`, `follower`, or `standalone`"
 	PreemptionTargetRecomputationsTotal *prometheus.CounterVec
 
+	// +metricsdoc:group=clusterqueue
+	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",replica_role="one of `leader`, `follower`, or `standalone`"
+	PreemptionsBlockedByMinimumRuntimeTotal *prometheus.CounterVec
+
 	// Metrics tied to the queue system.
 
 	// +metricsdoc:group=clusterqueue
@@ -154,6 +162,10 @@ var (
 	// +metricsdoc:labels=name="the name of the LocalQueue",namespace="the namespace of the LocalQueue",priority_class="the priority class name",replica_role="one of `leader`, `follower`, or `standalone`"
 	LocalQueueExecutionTimeSeconds *prometheus.HistogramVec
 
+	// +metricsdoc:group=clusterqueue
+	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",local_queue="the name of the LocalQueue",namespace="the namespace of the LocalQueue",replica_role="one of `leader`, `follower`, or `standalone`"
+	WorkloadCostTotal *prometheus.CounterVec
+
 	// +metricsdoc:group=clusterqueue
 	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",priority_class="the priority class name",replica_role="one of `leader`, `follower`, or `standalone`"
 	QuotaReservedWaitTime *prometheus.HistogramVec
@@ -425,6 +437,16 @@ The label 'result' can have the following values:
 	)
 	trackGaugeVec(AdmissionCyclePreemptionSkips, gaugeCleanupScopeClusterQueue)
 
+	PreemptionBudgetPreemptedWorkloads = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "preemption_budget_preempted_workloads",
+			Help: "The number of Workloads preempted by the Workloads of the ClusterQueue " +
+				"within the current window of its preemption budget",
+		}, append([]string{"cluster_queue", "replica_role"}, clusterQueueMetricsLabels...),
+	)
+	trackGaugeVec(PreemptionBudgetPreemptedWorkloads, gaugeCleanupScopeClusterQueue)
+
 	PreemptionTargetRecomputationsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: constants.KueueName,
@@ -439,6 +461,15 @@ Globally configured custom ClusterQueue labels are also appended to the base lab
 		}, append([]string{"cluster_queue", "result", "replica_role"}, clusterQueueMetricsLabels...),
 	)
 
+	PreemptionsBlockedByMinimumRuntimeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "preemptions_blocked_by_minimum_runtime_total",
+			Help: `The total number of preemption attempts of the pending workloads of the 'cluster_queue' which found
+no targets while some candidates were protected by the minimum runtime of their ClusterQueue.`,
+		}, append([]string{"cluster_queue", "replica_role"}, clusterQueueMetricsLabels...),
+	)
+
 	buildInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: constants.KueueName,
@@ -536,6 +567,15 @@ Globally configured custom ClusterQueue labels are also appended to the base lab
 		}, append([]string{"name", "namespace", "priority_class", "replica_role"}, localQueueMetricsLabels...),
 	)
 
+	WorkloadCostTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "workload_cost_total",
+			Help: `The accumulated cost of admitted workloads, computed from the prices of their assigned ResourceFlavors, per 'cluster_queue' and 'local_queue'.
+The cost of an admission is accounted when the workload finishes, is evicted or is deleted. This metric is only emitted when WorkloadCostAccounting feature gate is enabled.`,
+		}, []string{"cluster_queue", "local_queue", "namespace", "replica_role"},
+	)
+
 	ExecutionTimeSeconds = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Subsystem: constants.KueueName,
@@ -575,6 +615,7 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "TASDefragmentation" means that the workload was evicted to free a topology domain for a pending workload when using TopologyAwareScheduling.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -711,6 +752,7 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "TASDefragmentation" means that the workload was evicted to free a topology domain for a pending workload when using TopologyAwareScheduling.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -740,6 +782,7 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "TASDefragmentation" means that the workload was evicted to free a topology domain for a pending workload when using TopologyAwareScheduling.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -761,6 +804,7 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "TASDefragmentation" means that the workload was evicted to free a topology domain for a pending workload when using TopologyAwareScheduling.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -800,6 +844,7 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "TASDefragmentation" means that the workload was evicted to free a topology domain for a pending workload when using TopologyAwareScheduling.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.`,
 			Buckets: generateExponentialBuckets(14),
 		}, append([]string{"cluster_queue", "reason", "replica_role"}, clusterQueueMetricsLabels...),
@@ -1114,6 +1159,12 @@ func ReportLocalQueueExecutionTime(lq LocalQueueReference, priorityClass string,
 	LocalQueueExecutionTimeSeconds.WithLabelValues(labels...).Observe(seconds)
 }
 
+// ReportWorkloadCost adds the cost of an admission period of a workload
+// in the given ClusterQueue and LocalQueue.
+func ReportWorkloadCost(cqName kueue.ClusterQueueReference, lq LocalQueueReference, cost float64, tracker *roletracker.RoleTracker) {
+	WorkloadCostTotal.WithLabelValues(string(cqName), string(lq.Name), lq.Namespace, roletracker.GetRole(tracker)).Add(max(0, cost))
+}
+
 // ReportFinishedWorkloads sets the current total number of finished workloads
 // for the given ClusterQueue and workload role (gauge).
 func ReportFinishedWorkloads(cqName kueue.ClusterQueueReference, count int, customLabelValues []string, tracker *roletracker.RoleTracker) {
@@ -1251,6 +1302,7 @@ func ClearClusterQueueMetrics(cq kueue.ClusterQueueReference) {
 	QuotaReservedWaitTime.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	FinishedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	ExecutionTimeSeconds.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	WorkloadCostTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	PodsReadyToEvictedTimeSeconds.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	AdmittedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	AdmissionWaitTime.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
@@ -1261,6 +1313,7 @@ func ClearClusterQueueMetrics(cq kueue.ClusterQueueReference) {
 	EvictedWorkloadsOnceTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	PreemptedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"preempting_cluster_queue": cqName})
 	PreemptionTargetRecomputationsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	PreemptionsBlockedByMinimumRuntimeTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	// Histogram vec, not cleared by gauge cleanup above.
 	WorkloadEvictionLatencySeconds.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	PodSchedulingGateRemovalSeconds.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
@@ -1285,6 +1338,7 @@ func ClearLocalQueueMetrics(lq LocalQueueReference) {
 	LocalQueueQueuedUntilReadyWaitTime.DeletePartialMatch(lbls)
 	LocalQueueAdmittedUntilReadyWaitTime.DeletePartialMatch(lbls)
 	LocalQueueEvictedWorkloadsTotal.DeletePartialMatch(lbls)
+	WorkloadCostTotal.DeletePartialMatch(prometheus.Labels{"local_queue": string(lq.Name), "namespace": lq.Namespace})
 }
 
 func ClearCohortMetrics(cohortName kueue.CohortReference) {
@@ -1490,6 +1544,15 @@ func ReportAdmissionCyclePreemptionSkips(cqName kueue.ClusterQueueReference, cou
 	AdmissionCyclePreemptionSkips.WithLabelValues(labels...).Set(float64(count))
 }
 
+func ReportPreemptionBudgetPreemptedWorkloads(cqName kueue.ClusterQueueReference, count int32, customLabelValues []string, tracker *roletracker.RoleTracker) {
+	labels := append([]string{string(cqName), roletracker.GetRole(tracker)}, customLabelValues...)
+	PreemptionBudgetPreemptedWorkloads.WithLabelValues(labels...).Set(float64(count))
+}
+
+func ClearPreemptionBudgetMetrics(cqName string) {
+	PreemptionBudgetPreemptedWorkloads.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+}
+
 // ReportPreemptionTargetRecomputation increments the counter for a preemption
 // target recomputation result. The result must be one of
 // PreemptionTargetRecomputationResultNewTargets, PreemptionTargetRecomputationResultDeferredFit,
@@ -1499,6 +1562,13 @@ func ReportPreemptionTargetRecomputation(cqName kueue.ClusterQueueReference, res
 	PreemptionTargetRecomputationsTotal.WithLabelValues(labels...).Inc()
 }
 
+// ReportPreemptionBlockedByMinimumRuntime increments the counter for a
+// preemption attempt blocked by the minimum runtime of the candidates.
+func ReportPreemptionBlockedByMinimumRuntime(cqName kueue.ClusterQueueReference, customLabelValues []string, tracker *roletracker.RoleTracker) {
+	labels := append([]string{string(cqName), roletracker.GetRole(tracker)}, customLabelValues...)
+	PreemptionsBlockedByMinimumRuntimeTotal.WithLabelValues(labels...).Inc()
+}
+
 func clearScopedGaugeMetrics(scope gaugeCleanupScope, lbls prometheus.Labels) {
 	for _, g := range gaugeVecsByScope[scope] {
 		g.DeletePartialMatch(lbls)
@@ -1572,7 +1642,9 @@ func Register() {
 		MultiKueueWorkloadsDispatchedTotal,
 		MultiKueueWorkloadsAdmittedTotal,
 		AdmissionCyclePreemptionSkips,
+		PreemptionBudgetPreemptedWorkloads,
 		PreemptionTargetRecomputationsTotal,
+		PreemptionsBlockedByMinimumRuntimeTotal,
 		PendingWorkloads,
 		PendingSchedulingHashes,
 		FinishedWorkloads,
@@ -1613,6 +1685,9 @@ func Register() {
 	if features.Enabled(features.MetricForWorkloadCreationLatency) {
 		metrics.Registry.MustRegister(WorkloadCreationLatency)
 	}
+	if features.Enabled(features.WorkloadCostAccounting) {
+		metrics.Registry.MustRegister(WorkloadCostTotal)
+	}
 	if features.Enabled(features.LocalQueueMetrics) {
 		RegisterLQMetrics()
 	}
@@ -351,6 +351,18 @@ func TestReportAndCleanupPreemptionTargetRecomputations(t *testing.T) {
 	expectFilteredMetricsCount(t, PreemptionTargetRecomputationsTotal, 0, "cluster_queue", "cluster_queue1")
 }
 
+func TestReportAndCleanupPreemptionsBlockedByMinimumRuntime(t *testing.T) {
+	ReportPreemptionBlockedByMinimumRuntime("cluster_queue1", nil, nil)
+	ReportPreemptionBlockedByMinimumRuntime("cluster_queue2", nil, nil)
+
+	expectFilteredMetricsCount(t, PreemptionsBlockedByMinimumRuntimeTotal, 1, "cluster_queue", "cluster_queue1")
+	expectFilteredMetricsCount(t, PreemptionsBlockedByMinimumRuntimeTotal, 1, "cluster_queue", "cluster_queue2")
+
+	ClearClusterQueueMetrics("cluster_queue1")
+	expectFilteredMetricsCount(t, PreemptionsBlockedByMinimumRuntimeTotal, 0, "cluster_queue", "cluster_queue1")
+	expectFilteredMetricsCount(t, PreemptionsBlockedByMinimumRuntimeTotal, 1, "cluster_queue", "cluster_queue2")
+}
+
 func TestReportAndCleanupLocalQueueEvictedNumber(t *testing.T) {
 	lq := LocalQueueReference{Name: kueue.LocalQueueName("lq1"), Namespace: "ns1"}
 	ReportLocalQueueEvictedWorkloads(lq, "Preempted", "", "", nil, nil)
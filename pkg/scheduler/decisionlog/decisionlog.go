@@ -0,0 +1,114 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisionlog provides structured records of the admission
+// attempts made by the scheduler, for post-hoc analysis of why workloads
+// waited.
+package decisionlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// Record describes a single evaluation of a workload in a scheduling cycle.
+type Record struct {
+	Time            time.Time                   `json:"time"`
+	SchedulingCycle int64                       `json:"schedulingCycle"`
+	Workload        klog.ObjectRef              `json:"workload"`
+	ClusterQueue    kueue.ClusterQueueReference `json:"clusterQueue"`
+	Cohort          kueue.CohortReference       `json:"cohort,omitempty"`
+	Priority        int32                       `json:"priority"`
+	Status          string                      `json:"status"`
+	Reason          string                      `json:"reason,omitempty"`
+	// Borrowing is the height of the smallest cohort tree that provides the
+	// quota of the nominated assignment. It is 0 when no borrowing is needed.
+	Borrowing         int                `json:"borrowing"`
+	PodSets           []PodSet           `json:"podSets,omitempty"`
+	PreemptionTargets []PreemptionTarget `json:"preemptionTargets,omitempty"`
+}
+
+// PodSet describes the flavor assignment of a single PodSet.
+type PodSet struct {
+	Name     kueue.PodSetReference                                 `json:"name"`
+	Count    int32                                                 `json:"count"`
+	Flavors  map[corev1.ResourceName]kueue.ResourceFlavorReference `json:"flavors,omitempty"`
+	Attempts []FlavorAttempt                                       `json:"attempts,omitempty"`
+}
+
+// FlavorAttempt describes one flavor considered for a PodSet and its
+// worst-case outcome across the requested resources.
+type FlavorAttempt struct {
+	Flavor                kueue.ResourceFlavorReference `json:"flavor"`
+	Mode                  string                        `json:"mode"`
+	Borrow                int                           `json:"borrow"`
+	PreemptionPossibility string                        `json:"preemptionPossibility,omitempty"`
+	Reasons               []string                      `json:"reasons,omitempty"`
+	NoFitReason           string                        `json:"noFitReason,omitempty"`
+}
+
+// PreemptionTarget describes a workload selected for preemption to make
+// room for the evaluated workload.
+type PreemptionTarget struct {
+	Workload     klog.ObjectRef              `json:"workload"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	Reason       string                      `json:"reason"`
+}
+
+// Sink receives admission decision records.
+type Sink interface {
+	Write(Record) error
+}
+
+// FileSink appends records to a local file, one JSON object per line.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink opens the file at path for appending, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening admission decision log: %w", err)
+	}
+	return &FileSink{file: f, enc: json.NewEncoder(f)}, nil
+}
+
+// Write appends the record as a single line.
+func (s *FileSink) Write(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(r)
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
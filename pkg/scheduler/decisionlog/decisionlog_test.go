@@ -0,0 +1,102 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func TestFileSink(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []Record{
+		{
+			Time:            now,
+			SchedulingCycle: 1,
+			Workload:        klog.KRef("ns", "a"),
+			ClusterQueue:    "cq",
+			Cohort:          "cohort",
+			Status:          "assumed",
+			Borrowing:       1,
+			PodSets: []PodSet{{
+				Name:    "main",
+				Count:   2,
+				Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+				Attempts: []FlavorAttempt{
+					{Flavor: "reserved", Mode: "NoFit", Reasons: []string{"insufficient unused quota for cpu in flavor reserved"}},
+					{Flavor: "default", Mode: "Fit", Borrow: 1},
+				},
+			}},
+		},
+		{
+			Time:            now,
+			SchedulingCycle: 1,
+			Workload:        klog.KRef("ns", "b"),
+			ClusterQueue:    "cq",
+			Status:          "nominated",
+			Reason:          "Pending the preemption of 1 workload(s)",
+			PreemptionTargets: []PreemptionTarget{
+				{Workload: klog.KRef("ns", "c"), ClusterQueue: "cq", Reason: "InClusterQueue"},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("Failed to create sink: %v", err)
+	}
+	for _, r := range records {
+		if err := sink.Write(r); err != nil {
+			t.Fatalf("Failed to write record: %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Failed to close sink: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+	defer f.Close()
+	var got []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("Failed to decode line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, r)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	if diff := cmp.Diff(records, got); diff != "" {
+		t.Errorf("Unexpected records (-want,+got):\n%s", diff)
+	}
+}
@@ -102,6 +102,18 @@ func (a *Assignment) SetRepresentativeMode(mode FlavorAssignmentMode) {
 	}
 }
 
+// Merge appends the PodSet assignments of other, computed against a different
+// ClusterQueue, so that the representative mode, the message and the NoFit
+// reason account for all the PodSets of the Workload. Usage is left unchanged,
+// as the usage of other is charged to the other ClusterQueue.
+func (a *Assignment) Merge(other Assignment) {
+	mode := min(a.RepresentativeMode(), other.RepresentativeMode())
+	a.PodSets = append(a.PodSets, other.PodSets...)
+	a.representativeMode = &mode
+	a.Borrowing = max(a.Borrowing, other.Borrowing)
+	a.NoFitReason = mostSevereReason(a.NoFitReason, other.NoFitReason)
+}
+
 // ComputeTASNetUsage computes the net TAS usage for the assignment
 func (a *Assignment) ComputeTASNetUsage(log logr.Logger, cq *schdcache.ClusterQueueSnapshot, wl *workload.Info, prevAdmission *kueue.Admission) workload.TASUsage {
 	result := make(workload.TASUsage)
@@ -1337,7 +1349,7 @@ func (a *FlavorAssigner) fitsResourceQuota(
 	status.appendf("insufficient unused quota for %s in flavor %s, %s more needed",
 		fr.Resource, fr.Flavor, a.resourceFormatter.AmountQuantityString(fr.Resource, val.Sub(available)))
 
-	if rQuota.Nominal.Cmp(val) >= 0 || mayReclaimInHierarchy || a.canPreemptWhileBorrowing() {
+	if rQuota.Nominal.Cmp(val) >= 0 || mayReclaimInHierarchy || a.canPreemptWhileBorrowing() || a.cq.HeldQuotaReservation(a.wl.Obj) != nil {
 		preemptionPossiblity, borrowAfterPreemptions := a.oracle.SimulatePreemption(ctx, a.cq, *a.wl, fr, val)
 		mode := fromPreemptionPossibility(preemptionPossiblity)
 		if mode != noFit {
@@ -18,10 +18,14 @@ package scheduler
 
 import (
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/decisionlog"
 	"sigs.k8s.io/kueue/pkg/util/logging"
+	"sigs.k8s.io/kueue/pkg/util/priority"
 )
 
 func logAdmissionAttemptIfVerbose(log logr.Logger, e *entry) {
@@ -42,6 +46,74 @@ func logAdmissionAttemptIfVerbose(log logr.Logger, e *entry) {
 	logV.Info("Workload evaluated for admission", args...)
 }
 
+// recordAdmissionDecision writes the outcome of evaluating the entry to the
+// admission decision sink, if one is configured.
+func (s *Scheduler) recordAdmissionDecision(log logr.Logger, e *entry) {
+	if s.admissionDecisionSink == nil {
+		return
+	}
+	if err := s.admissionDecisionSink.Write(s.admissionDecisionRecord(e)); err != nil {
+		log.Error(err, "Failed to record admission decision", "workload", klog.KObj(e.Obj))
+	}
+}
+
+func (s *Scheduler) admissionDecisionRecord(e *entry) decisionlog.Record {
+	status := string(e.status)
+	if e.status == notNominated {
+		status = "notNominated"
+	}
+	r := decisionlog.Record{
+		Time:            s.clock.Now(),
+		SchedulingCycle: s.schedulingCycle,
+		Workload:        klog.KObj(e.Obj),
+		ClusterQueue:    e.ClusterQueue,
+		Priority:        priority.Priority(e.Obj),
+		Status:          status,
+		Reason:          e.inadmissibleMsg,
+		Borrowing:       e.assignment.Borrowing,
+	}
+	if cq := e.clusterQueueSnapshot; cq != nil && cq.HasParent() {
+		r.Cohort = cq.Parent().GetName()
+	}
+	for _, ps := range e.assignment.PodSets {
+		psr := decisionlog.PodSet{
+			Name:  ps.Name,
+			Count: ps.Count,
+		}
+		if len(ps.Flavors) > 0 {
+			psr.Flavors = make(map[corev1.ResourceName]kueue.ResourceFlavorReference, len(ps.Flavors))
+			for res, fa := range ps.Flavors {
+				psr.Flavors[res] = fa.Name
+			}
+		}
+		for _, att := range ps.FlavorAssignmentAttempts {
+			ar := decisionlog.FlavorAttempt{
+				Flavor:      att.Flavor,
+				Mode:        att.Mode.String(),
+				Borrow:      att.Borrow,
+				Reasons:     att.Reasons,
+				NoFitReason: att.NoFitReason,
+			}
+			if att.PreemptionPossibility != nil {
+				ar.PreemptionPossibility = att.PreemptionPossibility.String()
+			}
+			psr.Attempts = append(psr.Attempts, ar)
+		}
+		r.PodSets = append(r.PodSets, psr)
+	}
+	for _, t := range e.preemptionTargets {
+		tr := decisionlog.PreemptionTarget{
+			Workload: klog.KObj(t.WorkloadInfo.Obj),
+			Reason:   t.Reason,
+		}
+		if t.WorkloadCq != nil {
+			tr.ClusterQueue = t.WorkloadCq.Name
+		}
+		r.PreemptionTargets = append(r.PreemptionTargets, tr)
+	}
+	return r
+}
+
 func logSnapshotIfVerbose(log logr.Logger, s *schdcache.Snapshot) {
 	if logV := log.V(6); logV.Enabled() {
 		s.Log(logV)
@@ -0,0 +1,146 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/decisionlog"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/util/routine"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+type recordingSink struct {
+	mu      sync.Mutex
+	records []decisionlog.Record
+}
+
+func (s *recordingSink) Write(r decisionlog.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+func TestAdmissionDecisionSink(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	small := utiltestingapi.MakeResourceFlavor("small").Obj()
+	large := utiltestingapi.MakeResourceFlavor("large").Obj()
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas(small.Name).
+				Resource(corev1.ResourceCPU, "1").
+				Obj(),
+			*utiltestingapi.MakeFlavorQuotas(large.Name).
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+		).Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+	wl := utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Priority(10).
+		PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 1).
+			Request(corev1.ResourceCPU, "2").
+			Obj()).
+		Obj()
+
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(ns, small, large, cq, lq, wl).
+		WithStatusSubresource(&kueue.Workload{}).
+		Build()
+
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+	cqCache.AddOrUpdateResourceFlavor(log, small)
+	cqCache.AddOrUpdateResourceFlavor(log, large)
+	if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+	}
+	if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+	}
+	if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+		t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+	}
+
+	sink := &recordingSink{}
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{},
+		WithClock(t, testingclock.NewFakeClock(now)),
+		WithPreemptionExpectations(preemptexpectations.New()),
+		WithAdmissionDecisionSink(sink),
+	)
+	wg := sync.WaitGroup{}
+	scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+
+	ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+	go qManager.CleanUpOnContext(ctx)
+	defer cancel()
+
+	scheduler.schedule(ctx)
+	wg.Wait()
+
+	want := []decisionlog.Record{{
+		Time:            now,
+		SchedulingCycle: 1,
+		Workload:        klog.KObj(wl),
+		ClusterQueue:    "cq",
+		Priority:        10,
+		Status:          string(assumed),
+		PodSets: []decisionlog.PodSet{{
+			Name:  kueue.DefaultPodSetName,
+			Count: 1,
+			Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{
+				corev1.ResourceCPU: "large",
+			},
+			Attempts: []decisionlog.FlavorAttempt{
+				{
+					Flavor: "large",
+					Mode:   "Fit",
+				},
+				{
+					Flavor:      "small",
+					Mode:        "NoFit",
+					Reasons:     []string{"insufficient quota for cpu in flavor small, previously considered podsets requests (0) + current podset request (2) > maximum capacity (1)"},
+					NoFitReason: kueue.WorkloadQuotaReservedReasonExceedsMaxQuota,
+				},
+			},
+		}},
+	}}
+	if diff := cmp.Diff(want, sink.records); diff != "" {
+		t.Errorf("Unexpected admission decision records (-want,+got):\n%s", diff)
+	}
+}
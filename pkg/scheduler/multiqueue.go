@@ -0,0 +1,169 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// queueAssignment holds the PodSets of a multi-queue Workload that are
+// charged to a ClusterQueue other than the one of the entry.
+type queueAssignment struct {
+	clusterQueue *schdcache.ClusterQueueSnapshot
+	podSets      sets.Set[kueue.PodSetReference]
+	usage        workload.Usage
+}
+
+// groupPodSetsByClusterQueue resolves the LocalQueue of each PodSet of the
+// entry to its ClusterQueue. The ClusterQueues other than the entry's must
+// be active and share the root cohort of the entry's ClusterQueue.
+func (s *Scheduler) groupPodSetsByClusterQueue(e *entry, snap *schdcache.Snapshot) (map[kueue.ClusterQueueReference]sets.Set[kueue.PodSetReference], error) {
+	groups := make(map[kueue.ClusterQueueReference]sets.Set[kueue.PodSetReference])
+	for i := range e.Obj.Spec.PodSets {
+		ps := &e.Obj.Spec.PodSets[i]
+		cqName := e.ClusterQueue
+		if lqName := workload.PodSetQueueName(e.Obj, ps); lqName != e.Obj.Spec.QueueName {
+			var found bool
+			cqName, found = s.queues.ClusterQueueFromLocalQueue(utilqueue.NewLocalQueueReference(e.Obj.Namespace, lqName))
+			if !found {
+				return nil, fmt.Errorf("LocalQueue %s of podSet %s doesn't exist", lqName, ps.Name)
+			}
+		}
+		if cqName != e.ClusterQueue {
+			if snap.InactiveClusterQueueSets.Has(cqName) {
+				return nil, fmt.Errorf("ClusterQueue %s of podSet %s is inactive", cqName, ps.Name)
+			}
+			cq := snap.ClusterQueue(cqName)
+			if cq == nil {
+				return nil, fmt.Errorf("ClusterQueue %s of podSet %s not found", cqName, ps.Name)
+			}
+			if !sameRootCohort(e.clusterQueueSnapshot, cq) {
+				return nil, fmt.Errorf("ClusterQueue %s of podSet %s is not in the cohort of ClusterQueue %s", cqName, ps.Name, e.ClusterQueue)
+			}
+		}
+		if groups[cqName] == nil {
+			groups[cqName] = sets.New[kueue.PodSetReference]()
+		}
+		groups[cqName].Insert(ps.Name)
+	}
+	if len(groups[e.ClusterQueue]) == 0 {
+		return nil, fmt.Errorf("no podSet is charged to ClusterQueue %s of the Workload", e.ClusterQueue)
+	}
+	return groups, nil
+}
+
+func sameRootCohort(a, b *schdcache.ClusterQueueSnapshot) bool {
+	return a.HasParent() && b.HasParent() && a.Parent().Root().GetName() == b.Parent().Root().GetName()
+}
+
+// getMultiQueueAssignments computes the flavor assignment of the PodSets of
+// a multi-queue Workload in each of their ClusterQueues. The assignments are
+// merged into the one of the entry, so that the Workload is only admitted
+// when all its PodSets fit. Preemption targets are only returned when every
+// ClusterQueue that requires preemption found candidates, so that either all
+// the preemptions needed by the Workload are issued or none.
+func (s *Scheduler) getMultiQueueAssignments(ctx context.Context, e *entry, snap *schdcache.Snapshot, groups map[kueue.ClusterQueueReference]sets.Set[kueue.PodSetReference]) (flavorassigner.Assignment, []*preemption.Target, bool) {
+	assignment, targets, blockedByMinimumRuntime := s.getAssignments(ctx, e.ForPodSets(e.ClusterQueue, groups[e.ClusterQueue]), snap)
+	allTargetsFound := assignment.RepresentativeMode() != flavorassigner.Preempt || len(targets) > 0
+
+	e.secondaryAssignments = nil
+	for _, cqName := range slices.Sorted(maps.Keys(groups)) {
+		if cqName == e.ClusterQueue {
+			continue
+		}
+		cqAssignment, cqTargets, cqBlockedByMinimumRuntime := s.getAssignments(ctx, e.ForPodSets(cqName, groups[cqName]), snap)
+		if cqAssignment.RepresentativeMode() == flavorassigner.Preempt && len(cqTargets) == 0 {
+			allTargetsFound = false
+		}
+		blockedByMinimumRuntime = blockedByMinimumRuntime || cqBlockedByMinimumRuntime
+		targets = appendUniqueTargets(targets, cqTargets)
+		e.secondaryAssignments = append(e.secondaryAssignments, queueAssignment{
+			clusterQueue: snap.ClusterQueue(cqName),
+			podSets:      groups[cqName],
+			usage:        workload.Usage{Quota: cqAssignment.Usage.Quota},
+		})
+		assignment.Merge(cqAssignment)
+	}
+	if !allTargetsFound {
+		targets = nil
+	}
+
+	order := make(map[kueue.PodSetReference]int, len(e.Obj.Spec.PodSets))
+	for i := range e.Obj.Spec.PodSets {
+		order[e.Obj.Spec.PodSets[i].Name] = i
+	}
+	slices.SortStableFunc(assignment.PodSets, func(a, b flavorassigner.PodSetAssignment) int {
+		return order[a.Name] - order[b.Name]
+	})
+	return assignment, targets, blockedByMinimumRuntime
+}
+
+func appendUniqueTargets(targets, other []*preemption.Target) []*preemption.Target {
+	for _, t := range other {
+		key := workload.Key(t.WorkloadInfo.Obj)
+		if !slices.ContainsFunc(targets, func(existing *preemption.Target) bool {
+			return workload.Key(existing.WorkloadInfo.Obj) == key
+		}) {
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// secondaryAssignmentsFit checks that the PodSets charged to other
+// ClusterQueues still fit after the workloads processed earlier in the cycle.
+func (e *entry) secondaryAssignmentsFit(snapshot *schdcache.Snapshot, preemptedWorkloads preemption.PreemptedWorkloads) bool {
+	for i := range e.secondaryAssignments {
+		qa := &e.secondaryAssignments[i]
+		if fits(snapshot, qa.clusterQueue, &qa.usage, preemptedWorkloads, e.preemptionTargets) != schdcache.FitsCheckOk {
+			return false
+		}
+	}
+	return true
+}
+
+// addSecondaryUsage reserves the usage of the PodSets charged to other
+// ClusterQueues in the snapshot.
+func (e *entry) addSecondaryUsage() {
+	for _, qa := range e.secondaryAssignments {
+		qa.clusterQueue.AddUsage(qa.usage)
+	}
+}
+
+// setSecondaryClusterQueues records the ClusterQueue charged for the PodSets
+// that don't use the ClusterQueue of the admission.
+func (e *entry) setSecondaryClusterQueues(psas []kueue.PodSetAssignment) {
+	for _, qa := range e.secondaryAssignments {
+		for i := range psas {
+			if qa.podSets.Has(psas[i].Name) {
+				psas[i].ClusterQueue = new(qa.clusterQueue.Name)
+			}
+		}
+	}
+}
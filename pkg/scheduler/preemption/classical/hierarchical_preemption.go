@@ -17,6 +17,8 @@ limitations under the License.
 package classical
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -45,6 +47,12 @@ const (
 	ReclaimWhileBorrowing
 )
 
+// reclaimsNominalQuota returns whether the preemptor reclaims the nominal
+// quota of its ClusterQueue from the candidate.
+func (m preemptionVariant) reclaimsNominalQuota() bool {
+	return m == HiearchicalReclaim || m == ReclaimWithoutBorrowing
+}
+
 func (m preemptionVariant) PreemptionReason() string {
 	switch m {
 	case WithinCQ:
@@ -66,6 +74,15 @@ type HierarchicalPreemptionCtx struct {
 	FrsNeedPreemption sets.Set[resources.FlavorResource]
 	Requests          resources.FlavorResourceQuantities
 	WorkloadOrdering  workload.Ordering
+	Now               time.Time
+	// ProtectedCandidates counts the candidates skipped because they haven't
+	// run for the minimum runtime of their ClusterQueue.
+	ProtectedCandidates int
+	// QuotaReservation is the ongoing QuotaReservation held by the preemptor,
+	// if any. The Workloads using the reserved quota without holding the
+	// reservation can be preempted regardless of the preemption policies.
+	// Other Workloads follow the preemption policies.
+	QuotaReservation *schdcache.QuotaReservationSnapshot
 }
 
 func IsBorrowingWithinCohortForbidden(cq *schdcache.ClusterQueueSnapshot) (bool, *int32) {
@@ -83,6 +100,13 @@ func classifyPreemptionVariant(ctx *HierarchicalPreemptionCtx, wl *workload.Info
 		return Never
 	}
 
+	if reclaimsQuotaReservation(ctx, wl) {
+		if wl.ClusterQueue == ctx.Cq.Name {
+			return WithinCQ
+		}
+		return HiearchicalReclaim
+	}
+
 	var preemptionPolicy kueue.PreemptionPolicy
 	if wl.ClusterQueue == ctx.Cq.Name {
 		preemptionPolicy = ctx.Cq.Preemption.WithinClusterQueue
@@ -90,7 +114,7 @@ func classifyPreemptionVariant(ctx *HierarchicalPreemptionCtx, wl *workload.Info
 		preemptionPolicy = ctx.Cq.Preemption.ReclaimWithinCohort
 	}
 
-	if !preemptioncommon.SatisfiesPreemptionPolicy(ctx.Log, ctx.Wl, wl.Obj, ctx.WorkloadOrdering, preemptionPolicy) {
+	if !preemptioncommon.SatisfiesPreemptionPolicy(ctx.Log, ctx.Wl, wl, ctx.WorkloadOrdering, preemptionPolicy, ctx.Now) {
 		return Never
 	}
 
@@ -104,7 +128,7 @@ func classifyPreemptionVariant(ctx *HierarchicalPreemptionCtx, wl *workload.Info
 	if borrowWithinCohortForbidden {
 		return ReclaimWithoutBorrowing
 	}
-	candidatePriority := priority.EffectivePriority(ctx.Log, wl.Obj)
+	candidatePriority := priority.DecayedPriority(ctx.Log, wl.Obj, wl.PriorityDecay, ctx.Now)
 	incomingPriority := priority.EffectivePriority(ctx.Log, ctx.Wl)
 	if isAboveBorrowingThreshold(candidatePriority, incomingPriority, borrowWithinCohortThreshold) {
 		return ReclaimWithoutBorrowing
@@ -112,6 +136,12 @@ func classifyPreemptionVariant(ctx *HierarchicalPreemptionCtx, wl *workload.Info
 	return ReclaimWhileBorrowing
 }
 
+// reclaimsQuotaReservation returns whether the candidate is using the quota
+// reserved for the preemptor without holding the reservation.
+func reclaimsQuotaReservation(ctx *HierarchicalPreemptionCtx, wl *workload.Info) bool {
+	return ctx.QuotaReservation != nil && ctx.QuotaReservation.UsesReservedQuota(wl)
+}
+
 func isAboveBorrowingThreshold(candidatePriority, incomingPriority int64, borrowWithinCohortThreshold *int32) bool {
 	if candidatePriority >= incomingPriority {
 		return true
@@ -123,7 +153,7 @@ func isAboveBorrowingThreshold(candidatePriority, incomingPriority int64, borrow
 }
 
 func collectSameQueueCandidates(ctx *HierarchicalPreemptionCtx) []*candidateElem {
-	if ctx.Cq.Preemption.WithinClusterQueue == kueue.PreemptionPolicyNever {
+	if ctx.Cq.Preemption.WithinClusterQueue == kueue.PreemptionPolicyNever && ctx.QuotaReservation == nil {
 		return []*candidateElem{}
 	}
 	return getCandidatesFromCQ(ctx.Cq, nil, ctx, false)
@@ -136,6 +166,10 @@ func getCandidatesFromCQ(cq *schdcache.ClusterQueueSnapshot, lca *schdcache.Coho
 		if preemptionVariant == Never {
 			continue
 		}
+		if preemptioncommon.ProtectedByMinimumRuntime(candidateWl, cq.Preemption, preemptionVariant.reclaimsNominalQuota(), ctx.Now) {
+			ctx.ProtectedCandidates++
+			continue
+		}
 		candidates = append(candidates,
 			&candidateElem{
 				wl:                candidateWl,
@@ -149,7 +183,7 @@ func getCandidatesFromCQ(cq *schdcache.ClusterQueueSnapshot, lca *schdcache.Coho
 func collectCandidatesForHierarchicalReclaim(ctx *HierarchicalPreemptionCtx) ([]*candidateElem, []*candidateElem) {
 	hierarchyCandidates := []*candidateElem{}
 	priorityCandidates := []*candidateElem{}
-	if !ctx.Cq.HasParent() || ctx.Cq.Preemption.ReclaimWithinCohort == kueue.PreemptionPolicyNever {
+	if !ctx.Cq.HasParent() || (ctx.Cq.Preemption.ReclaimWithinCohort == kueue.PreemptionPolicyNever && ctx.QuotaReservation == nil) {
 		return hierarchyCandidates, priorityCandidates
 	}
 	var previousSubtreeRoot *schdcache.CohortSnapshot
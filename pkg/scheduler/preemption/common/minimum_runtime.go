@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
+)
+
+// ProtectedByMinimumRuntime returns whether the candidate can't be preempted
+// yet because it hasn't run for the minimumRuntimeSeconds of its ClusterQueue,
+// configured by candidateCQPreemption. reclaimingNominalQuota indicates whether
+// the preemptor reclaims the nominal quota of its ClusterQueue from the
+// candidate.
+func ProtectedByMinimumRuntime(candidate *workload.Info, candidateCQPreemption kueue.ClusterQueuePreemption, reclaimingNominalQuota bool, now time.Time) bool {
+	if !features.Enabled(features.PreemptionMinimumRuntime) || candidateCQPreemption.MinimumRuntimeSeconds == nil {
+		return false
+	}
+	if reclaimingNominalQuota && candidateCQPreemption.MinimumRuntimeExemption != kueue.MinimumRuntimeExemptionNone {
+		return false
+	}
+	// The candidates already selected for preemption stay targetable.
+	if workloadevict.IsEvicted(candidate.Obj) || meta.IsStatusConditionTrue(candidate.Obj.Status.Conditions, kueue.WorkloadPreemptionPending) {
+		return false
+	}
+	minimumRuntime := time.Duration(*candidateCQPreemption.MinimumRuntimeSeconds) * time.Second
+	return now.Sub(quotaReservationTime(candidate.Obj, now)) < minimumRuntime
+}
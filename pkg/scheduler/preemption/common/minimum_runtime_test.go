@@ -0,0 +1,97 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestProtectedByMinimumRuntime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	candidate := utiltestingapi.MakeWorkload("candidate", metav1.NamespaceDefault)
+	admittedAt := func(at time.Time) *utiltestingapi.WorkloadWrapper {
+		return candidate.Clone().ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), at)
+	}
+
+	testCases := map[string]struct {
+		disablePreemptionMinimumRuntime bool
+		candidate                       *kueue.Workload
+		preemption                      kueue.ClusterQueuePreemption
+		reclaimingNominalQuota          bool
+		want                            bool
+	}{
+		"feature disabled": {
+			disablePreemptionMinimumRuntime: true,
+			candidate:                       admittedAt(now.Add(-time.Minute)).Obj(),
+			preemption:                      kueue.ClusterQueuePreemption{MinimumRuntimeSeconds: new(int32(300))},
+		},
+		"no minimum runtime": {
+			candidate: admittedAt(now.Add(-time.Minute)).Obj(),
+		},
+		"within the minimum runtime": {
+			candidate:  admittedAt(now.Add(-time.Minute)).Obj(),
+			preemption: kueue.ClusterQueuePreemption{MinimumRuntimeSeconds: new(int32(300))},
+			want:       true,
+		},
+		"past the minimum runtime": {
+			candidate:  admittedAt(now.Add(-10 * time.Minute)).Obj(),
+			preemption: kueue.ClusterQueuePreemption{MinimumRuntimeSeconds: new(int32(300))},
+		},
+		"reclaiming nominal quota": {
+			candidate:              admittedAt(now.Add(-time.Minute)).Obj(),
+			preemption:             kueue.ClusterQueuePreemption{MinimumRuntimeSeconds: new(int32(300))},
+			reclaimingNominalQuota: true,
+		},
+		"reclaiming nominal quota without exemption": {
+			candidate: admittedAt(now.Add(-time.Minute)).Obj(),
+			preemption: kueue.ClusterQueuePreemption{
+				MinimumRuntimeSeconds:   new(int32(300)),
+				MinimumRuntimeExemption: kueue.MinimumRuntimeExemptionNone,
+			},
+			reclaimingNominalQuota: true,
+			want:                   true,
+		},
+		"already evicted": {
+			candidate: admittedAt(now.Add(-time.Minute)).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadEvicted,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadEvictedByPreemption,
+				}).
+				Obj(),
+			preemption: kueue.ClusterQueuePreemption{MinimumRuntimeSeconds: new(int32(300))},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PreemptionMinimumRuntime, !tc.disablePreemptionMinimumRuntime)
+			got := ProtectedByMinimumRuntime(workload.NewInfo(tc.candidate), tc.preemption, tc.reclaimingNominalQuota, now)
+			if got != tc.want {
+				t.Errorf("Unexpected ProtectedByMinimumRuntime, want=%v, got=%v", tc.want, got)
+			}
+		})
+	}
+}
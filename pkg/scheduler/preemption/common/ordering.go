@@ -33,11 +33,13 @@ import (
 )
 
 // CandidatesOrdering criteria:
-// 0. Workloads already marked for preemption first.
+// 0. Workloads already marked for preemption first, then the ones notified of
+// their preemption (CheckpointAwarePreemption only).
 // 1. Workloads from other ClusterQueues in the cohort before the ones in the
 // same ClusterQueue as the preemptor.
 // 2. (AdmissionFairSharing only) Workloads with lower LocalQueue's usage first
-// 3. Workloads with lower priority first.
+// 3. Workloads with lower priority first, after the priority decay of their
+// ClusterQueue.
 // 4. Workloads admitted more recently first.
 func CandidatesOrdering(log logr.Logger, afsEnabled bool, a, b *workload.Info, cq kueue.ClusterQueueReference, now time.Time) int {
 	return cmputil.LazyOr(
@@ -47,6 +49,12 @@ func CandidatesOrdering(log logr.Logger, afsEnabled bool, a, b *workload.Info, c
 				workloadevict.IsEvicted(b.Obj),
 			)
 		},
+		func() int {
+			return cmputil.CompareBool(
+				meta.IsStatusConditionTrue(a.Obj.Status.Conditions, kueue.WorkloadPreemptionPending),
+				meta.IsStatusConditionTrue(b.Obj.Status.Conditions, kueue.WorkloadPreemptionPending),
+			)
+		},
 		func() int {
 			return cmputil.CompareBool(
 				b.ClusterQueue == cq,
@@ -66,8 +74,8 @@ func CandidatesOrdering(log logr.Logger, afsEnabled bool, a, b *workload.Info, c
 		},
 		func() int {
 			return cmp.Compare(
-				priority.EffectivePriority(log, a.Obj),
-				priority.EffectivePriority(log, b.Obj),
+				priority.DecayedPriority(log, a.Obj, a.PriorityDecay, now),
+				priority.DecayedPriority(log, b.Obj, b.PriorityDecay, now),
 			)
 		},
 		func() int {
@@ -17,6 +17,8 @@ limitations under the License.
 package common
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -24,9 +26,12 @@ import (
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
-func SatisfiesPreemptionPolicy(log logr.Logger, preemptor, candidate *kueue.Workload, workloadOrdering workload.Ordering, policy kueue.PreemptionPolicy) bool {
+// SatisfiesPreemptionPolicy returns whether the policy allows the preemptor
+// to preempt the candidate, whose effective priority is lowered by the
+// priority decay of its ClusterQueue at now.
+func SatisfiesPreemptionPolicy(log logr.Logger, preemptor *kueue.Workload, candidate *workload.Info, workloadOrdering workload.Ordering, policy kueue.PreemptionPolicy, now time.Time) bool {
 	preemptorPriority := priority.EffectivePriority(log, preemptor)
-	candidatePriority := priority.EffectivePriority(log, candidate)
+	candidatePriority := priority.DecayedPriority(log, candidate.Obj, candidate.PriorityDecay, now)
 
 	lowerPriority := preemptorPriority > candidatePriority
 	if policy == kueue.PreemptionPolicyLowerPriority {
@@ -34,7 +39,7 @@ func SatisfiesPreemptionPolicy(log logr.Logger, preemptor, candidate *kueue.Work
 	}
 	if policy == kueue.PreemptionPolicyLowerOrNewerEqualPriority {
 		preemptorTS := workloadOrdering.GetQueueOrderTimestamp(preemptor)
-		candidateTS := workloadOrdering.GetQueueOrderTimestamp(candidate)
+		candidateTS := workloadOrdering.GetQueueOrderTimestamp(candidate.Obj)
 		newerEqualPriority := (preemptorPriority == candidatePriority) && preemptorTS.Before(candidateTS)
 		return lowerPriority || newerEqualPriority
 	}
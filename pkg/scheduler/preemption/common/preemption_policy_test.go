@@ -40,11 +40,12 @@ func TestSatisfiesPreemptionPolicy(t *testing.T) {
 	candidate := utiltestingapi.MakeWorkload("candidate", metav1.NamespaceDefault)
 
 	testCases := map[string]struct {
-		featureGates map[featuregate.Feature]bool
-		preemptor    *kueue.Workload
-		candidate    *kueue.Workload
-		policy       kueue.PreemptionPolicy
-		want         bool
+		featureGates  map[featuregate.Feature]bool
+		preemptor     *kueue.Workload
+		candidate     *kueue.Workload
+		priorityDecay *kueue.PriorityDecay
+		policy        kueue.PreemptionPolicy
+		want          bool
 	}{
 		"LowerPriority: preemptor has higher priority": {
 			preemptor: preemptor.Clone().Priority(10).Obj(),
@@ -142,6 +143,36 @@ func TestSatisfiesPreemptionPolicy(t *testing.T) {
 			policy: kueue.PreemptionPolicyAny,
 			want:   true,
 		},
+		"LowerPriority: decayed candidate with same priority": {
+			featureGates: map[featuregate.Feature]bool{
+				features.PriorityDecay: true,
+			},
+			preemptor: preemptor.Clone().Priority(10).Obj(),
+			candidate: candidate.Clone().Priority(10).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), now.Add(-time.Hour)).Obj(),
+			priorityDecay: &kueue.PriorityDecay{WindowSeconds: 1800, Step: 1},
+			policy:        kueue.PreemptionPolicyLowerPriority,
+			want:          true,
+		},
+		"LowerPriority: candidate with same priority within the decay window": {
+			featureGates: map[featuregate.Feature]bool{
+				features.PriorityDecay: true,
+			},
+			preemptor: preemptor.Clone().Priority(10).Obj(),
+			candidate: candidate.Clone().Priority(10).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), now.Add(-time.Minute)).Obj(),
+			priorityDecay: &kueue.PriorityDecay{WindowSeconds: 1800, Step: 1},
+			policy:        kueue.PreemptionPolicyLowerPriority,
+			want:          false,
+		},
+		"LowerPriority: decayed candidate with same priority, feature disabled": {
+			preemptor: preemptor.Clone().Priority(10).Obj(),
+			candidate: candidate.Clone().Priority(10).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), now.Add(-time.Hour)).Obj(),
+			priorityDecay: &kueue.PriorityDecay{WindowSeconds: 1800, Step: 1},
+			policy:        kueue.PreemptionPolicyLowerPriority,
+			want:          false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -149,7 +180,9 @@ func TestSatisfiesPreemptionPolicy(t *testing.T) {
 			_, log := utiltesting.ContextWithLog(t)
 			features.SetFeatureGatesDuringTest(t, tc.featureGates)
 			ordering := workload.Ordering{}
-			got := SatisfiesPreemptionPolicy(log, tc.preemptor, tc.candidate, ordering, tc.policy)
+			candidate := workload.NewInfo(tc.candidate)
+			candidate.PriorityDecay = tc.priorityDecay
+			got := SatisfiesPreemptionPolicy(log, tc.preemptor, candidate, ordering, tc.policy, now)
 			if got != tc.want {
 				t.Errorf("SatisfiesPreemptionPolicy() = %v, want %v", got, tc.want)
 			}
@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/classical"
+	preemptioncommon "sigs.k8s.io/kueue/pkg/scheduler/preemption/common"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// Reasons for which a workload using the resources that need preemption
+// can't be preempted.
+const (
+	RejectedByWithinClusterQueuePolicy  = "WithinClusterQueuePolicy"
+	RejectedByReclaimWithinCohortPolicy = "ReclaimWithinCohortPolicy"
+	RejectedNotBorrowing                = "ClusterQueueNotBorrowing"
+)
+
+// Explanation is the outcome of a dry run of the flavor assignment and the
+// preemption target selection for a pending workload.
+type Explanation struct {
+	ClusterQueue kueue.ClusterQueueReference
+	Assignment   flavorassigner.Assignment
+	Targets      []*Target
+	Rejected     []RejectedCandidate
+}
+
+// RejectedCandidate is an admitted workload using the resources that need
+// preemption, which can't be preempted.
+type RejectedCandidate struct {
+	WorkloadInfo *workload.Info
+	WorkloadCq   *schdcache.ClusterQueueSnapshot
+	Reason       string
+	Message      string
+}
+
+// RejectedCandidates returns the admitted workloads using the resources that
+// the assignment needs to preempt for, which findCandidates filters out, along
+// with the reason why each of them is rejected.
+func (p *Preemptor) RejectedCandidates(log logr.Logger, wl *kueue.Workload, cq *schdcache.ClusterQueueSnapshot, assignment flavorassigner.Assignment) []RejectedCandidate {
+	frsNeedPreemption := flavorResourcesNeedPreemption(assignment)
+	var rejected []RejectedCandidate
+	for _, candidateWl := range usingResources(cq.Workloads, frsNeedPreemption) {
+		if policy := cq.Preemption.WithinClusterQueue; !p.allowedByPolicy(log, wl, candidateWl, policy) {
+			rejected = append(rejected, RejectedCandidate{
+				WorkloadInfo: candidateWl,
+				WorkloadCq:   cq,
+				Reason:       RejectedByWithinClusterQueuePolicy,
+				Message:      fmt.Sprintf("The withinClusterQueue policy %s doesn't allow preempting the workload", policy),
+			})
+		}
+	}
+
+	if cq.HasParent() {
+		for _, cohortCQ := range cq.Parent().Root().SubtreeClusterQueues() {
+			if cq == cohortCQ {
+				continue
+			}
+			borrowing := cqIsBorrowing(cohortCQ, frsNeedPreemption)
+			policy := cq.Preemption.ReclaimWithinCohort
+			for _, candidateWl := range usingResources(cohortCQ.Workloads, frsNeedPreemption) {
+				switch {
+				case !borrowing:
+					rejected = append(rejected, RejectedCandidate{
+						WorkloadInfo: candidateWl,
+						WorkloadCq:   cohortCQ,
+						Reason:       RejectedNotBorrowing,
+						Message:      fmt.Sprintf("The ClusterQueue %s isn't borrowing the resources that need preemption", cohortCQ.Name),
+					})
+				case !p.allowedByPolicy(log, wl, candidateWl, policy):
+					rejected = append(rejected, RejectedCandidate{
+						WorkloadInfo: candidateWl,
+						WorkloadCq:   cohortCQ,
+						Reason:       RejectedByReclaimWithinCohortPolicy,
+						Message:      fmt.Sprintf("The reclaimWithinCohort policy %s doesn't allow preempting the workload", policy),
+					})
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(rejected, func(a, b RejectedCandidate) int {
+		return cmp.Compare(workload.Key(a.WorkloadInfo.Obj), workload.Key(b.WorkloadInfo.Obj))
+	})
+	return rejected
+}
+
+func (p *Preemptor) allowedByPolicy(log logr.Logger, wl *kueue.Workload, candidateWl *workload.Info, policy kueue.PreemptionPolicy) bool {
+	return policy != kueue.PreemptionPolicyNever &&
+		preemptioncommon.SatisfiesPreemptionPolicy(log, wl, candidateWl, p.workloadOrdering, policy, p.clock.Now())
+}
+
+func usingResources(workloads map[workload.Reference]*workload.Info, frsNeedPreemption sets.Set[resources.FlavorResource]) []*workload.Info {
+	var result []*workload.Info
+	for _, wl := range workloads {
+		if classical.WorkloadUsesResources(wl, frsNeedPreemption) {
+			result = append(result, wl)
+		}
+	}
+	return result
+}
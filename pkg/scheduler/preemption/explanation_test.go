@@ -0,0 +1,170 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestRejectedCandidates(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admittedWorkload := func(name, cq string, priority int32, cpu string) kueue.Workload {
+		return *utiltestingapi.MakeWorkload(name, "").
+			Priority(priority).
+			Request(corev1.ResourceCPU, cpu).
+			ReserveQuotaAt(
+				utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(cq)).
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", cpu).
+						Obj()).
+					Obj(),
+				now,
+			).
+			Obj()
+	}
+	clusterQueue := func(name string, preemption kueue.ClusterQueuePreemption) *kueue.ClusterQueue {
+		return utiltestingapi.MakeClusterQueue(name).
+			Cohort("cohort").
+			ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+			).
+			Preemption(preemption).
+			Obj()
+	}
+	admitted := []kueue.Workload{
+		admittedWorkload("c1-low", "c1", -1, "2"),
+		admittedWorkload("c1-high", "c1", 1, "2"),
+		admittedWorkload("c2-low", "c2", -1, "1"),
+		admittedWorkload("c2-high", "c2", 1, "6"),
+		admittedWorkload("c3-low", "c3", -1, "2"),
+	}
+	incoming := utiltestingapi.MakeWorkload("in", "").
+		Request(corev1.ResourceCPU, "2").
+		Obj()
+
+	type rejectedCandidate struct {
+		Workload     workload.Reference
+		ClusterQueue kueue.ClusterQueueReference
+		Reason       string
+	}
+	cases := map[string]struct {
+		preemption kueue.ClusterQueuePreemption
+		assignment flavorassigner.Assignment
+		want       []rejectedCandidate
+	}{
+		"lower priority policies": {
+			preemption: kueue.ClusterQueuePreemption{
+				WithinClusterQueue:  kueue.PreemptionPolicyLowerPriority,
+				ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
+			},
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			want: []rejectedCandidate{
+				{Workload: "/c1-high", ClusterQueue: "c1", Reason: RejectedByWithinClusterQueuePolicy},
+				{Workload: "/c2-high", ClusterQueue: "c2", Reason: RejectedByReclaimWithinCohortPolicy},
+				{Workload: "/c3-low", ClusterQueue: "c3", Reason: RejectedNotBorrowing},
+			},
+		},
+		"never policies": {
+			preemption: kueue.ClusterQueuePreemption{
+				WithinClusterQueue:  kueue.PreemptionPolicyNever,
+				ReclaimWithinCohort: kueue.PreemptionPolicyNever,
+			},
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			want: []rejectedCandidate{
+				{Workload: "/c1-high", ClusterQueue: "c1", Reason: RejectedByWithinClusterQueuePolicy},
+				{Workload: "/c1-low", ClusterQueue: "c1", Reason: RejectedByWithinClusterQueuePolicy},
+				{Workload: "/c2-high", ClusterQueue: "c2", Reason: RejectedByReclaimWithinCohortPolicy},
+				{Workload: "/c2-low", ClusterQueue: "c2", Reason: RejectedByReclaimWithinCohortPolicy},
+				{Workload: "/c3-low", ClusterQueue: "c3", Reason: RejectedNotBorrowing},
+			},
+		},
+		"no resources need preemption": {
+			preemption: kueue.ClusterQueuePreemption{
+				WithinClusterQueue:  kueue.PreemptionPolicyNever,
+				ReclaimWithinCohort: kueue.PreemptionPolicyNever,
+			},
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Fit,
+				},
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithLists(&kueue.WorkloadList{Items: admitted}).
+				Build()
+
+			cqCache := schdcache.New(cl)
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			for _, cq := range []*kueue.ClusterQueue{
+				clusterQueue("c1", tc.preemption),
+				clusterQueue("c2", kueue.ClusterQueuePreemption{}),
+				clusterQueue("c3", kueue.ClusterQueuePreemption{}),
+			} {
+				if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+				}
+			}
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+
+			preemptor := New(cl, workload.Ordering{}, &utiltesting.EventRecorder{}, nil, false, clocktesting.NewFakeClock(now), nil, preemptexpectations.New(), nil)
+			rejected := preemptor.RejectedCandidates(log, incoming, snapshot.ClusterQueue("c1"), tc.assignment)
+			var got []rejectedCandidate
+			for _, r := range rejected {
+				got = append(got, rejectedCandidate{
+					Workload:     workload.Key(r.WorkloadInfo.Obj),
+					ClusterQueue: r.WorkloadCq.Name,
+					Reason:       r.Reason,
+				})
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected rejected candidates (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadpatching "sigs.k8s.io/kueue/pkg/workload/patching"
+)
+
+// preemptionNotices tracks the preemption notices issued to the targets whose
+// PreemptionPending condition isn't observed yet in the snapshots.
+type preemptionNotices struct {
+	sync.Mutex
+	issued map[types.UID]issuedNotice
+}
+
+type issuedNotice struct {
+	at       time.Time
+	deadline time.Time
+}
+
+func newPreemptionNotices() *preemptionNotices {
+	return &preemptionNotices{issued: make(map[types.UID]issuedNotice)}
+}
+
+// deadline returns the time at which the notified workload is evicted unless
+// it acknowledges the checkpoint earlier. It returns false if the workload
+// wasn't notified of its preemption, or if the notice was withdrawn.
+func (n *preemptionNotices) deadline(wl *kueue.Workload) (time.Time, bool) {
+	n.Lock()
+	defer n.Unlock()
+	if deadline, notified := workload.PreemptionNoticeDeadline(wl); notified {
+		delete(n.issued, wl.UID)
+		return deadline, true
+	}
+	notice, notified := n.issued[wl.UID]
+	if !notified {
+		return time.Time{}, false
+	}
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreemptionPending); cond != nil && !cond.LastTransitionTime.Time.Before(notice.at) {
+		// The notice was withdrawn.
+		delete(n.issued, wl.UID)
+		return time.Time{}, false
+	}
+	return notice.deadline, true
+}
+
+// record tracks the notice issued to the workload. It forgets the notices
+// which would have been withdrawn by now, in particular the ones of deleted
+// workloads.
+func (n *preemptionNotices) record(wl *kueue.Workload, now time.Time) {
+	n.Lock()
+	defer n.Unlock()
+	for uid, notice := range n.issued {
+		if now.After(notice.deadline.Add(workload.PreemptionNoticeTimeout)) {
+			delete(n.issued, uid)
+		}
+	}
+	n.issued[wl.UID] = issuedNotice{at: now, deadline: now.Add(workload.PreemptionGracePeriod(wl))}
+}
+
+func (n *preemptionNotices) forget(uid types.UID) {
+	n.Lock()
+	defer n.Unlock()
+	delete(n.issued, uid)
+}
+
+// NotifiedTargets returns the workloads of the targets notified of their
+// preemption, and the time at which the last of their notices is withdrawn.
+func (p *Preemptor) NotifiedTargets(targets []*Target) ([]*workload.Info, time.Time) {
+	var notified []*workload.Info
+	var expiry time.Time
+	for _, target := range targets {
+		deadline, found := p.notices.deadline(target.WorkloadInfo.Obj)
+		if !found {
+			continue
+		}
+		notified = append(notified, target.WorkloadInfo)
+		if targetExpiry := deadline.Add(workload.PreemptionNoticeTimeout); targetExpiry.After(expiry) {
+			expiry = targetExpiry
+		}
+	}
+	return notified, expiry
+}
+
+// needsPreemptionNotice returns whether the target must be notified of its
+// preemption, and given its grace period to checkpoint, before it's evicted.
+func needsPreemptionNotice(wl *kueue.Workload) bool {
+	return features.Enabled(features.CheckpointAwarePreemption) && workload.PreemptionGracePeriod(wl) > 0
+}
+
+// notifyPreemption sets the PreemptionPending condition of the target.
+func (p *Preemptor) notifyPreemption(ctx context.Context, target *Target, message string) error {
+	now := p.clock.Now()
+	wlCopy := target.WorkloadInfo.Obj.DeepCopy()
+	err := workloadpatching.PatchAdmissionStatus(ctx, p.client, wlCopy, p.clock, func(wl *kueue.Workload) (bool, error) {
+		return workload.SetPreemptionPendingCondition(wl, now, target.Reason, message), nil
+	}, workloadpatching.WithLooseOnApply(), workloadpatching.WithRetryOnConflict())
+	if err != nil {
+		return err
+	}
+	p.notices.record(wlCopy, now)
+	return nil
+}
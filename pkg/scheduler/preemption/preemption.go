@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
@@ -72,6 +73,7 @@ type Preemptor struct {
 	roleTracker            *roletracker.RoleTracker
 	customLabels           *metrics.CustomLabels
 	preemptionExpectations *expectations.Store
+	notices                *preemptionNotices
 }
 
 type preemptionCtx struct {
@@ -84,6 +86,9 @@ type preemptionCtx struct {
 	workloadUsage     workload.Usage
 	tasRequests       schdcache.WorkloadTASRequests
 	frsNeedPreemption sets.Set[resources.FlavorResource]
+	// protectedCandidates counts the candidates skipped because they haven't
+	// run for the minimum runtime of their ClusterQueue.
+	protectedCandidates int
 }
 
 func New(
@@ -108,6 +113,7 @@ func New(
 		roleTracker:            tracker,
 		customLabels:           customLabels,
 		preemptionExpectations: preemptionExpectations,
+		notices:                newPreemptionNotices(),
 	}
 	return p
 }
@@ -127,15 +133,17 @@ func (t *Target) GetObject() client.Object {
 }
 
 // GetTargets returns the list of workloads that should be evicted in
-// order to make room for wl.
-func (p *Preemptor) GetTargets(ctx context.Context, wl workload.Info, assignment flavorassigner.Assignment, snapshot *schdcache.Snapshot) []*Target {
+// order to make room for wl. When no targets are found, it also returns the
+// number of candidates skipped because they haven't reached their minimum
+// runtime, so that the caller can report the blocked preemption.
+func (p *Preemptor) GetTargets(ctx context.Context, wl workload.Info, assignment flavorassigner.Assignment, snapshot *schdcache.Snapshot) ([]*Target, int) {
 	log := log.FromContext(ctx)
 	cq := snapshot.ClusterQueue(wl.ClusterQueue)
 	var tasRequests schdcache.WorkloadTASRequests
 	if features.Enabled(features.TopologyAwareScheduling) {
 		tasRequests = assignment.WorkloadsTopologyRequests(log, &wl, cq)
 	}
-	return p.getTargets(&preemptionCtx{
+	preemptionCtx := &preemptionCtx{
 		ctx:               ctx,
 		clock:             p.clock,
 		log:               log,
@@ -150,14 +158,56 @@ func (p *Preemptor) GetTargets(ctx context.Context, wl workload.Info, assignment
 			},
 			TAS: wl.TASUsage(),
 		},
-	})
+	}
+	targets := p.getTargets(preemptionCtx)
+	if len(targets) > 0 {
+		return targets, 0
+	}
+	if preemptionCtx.protectedCandidates > 0 {
+		log.V(3).Info("Preemption blocked by the minimum runtime of the candidates", "protectedCandidates", preemptionCtx.protectedCandidates)
+	}
+	return targets, preemptionCtx.protectedCandidates
 }
 
 func (p *Preemptor) getTargets(preemptionCtx *preemptionCtx) []*Target {
+	var targets []*Target
 	if p.enableFairSharing {
-		return p.fairPreemptions(preemptionCtx, p.fsStrategies)
+		targets = p.fairPreemptions(preemptionCtx, p.fsStrategies)
+	} else {
+		targets = p.classicalPreemptions(preemptionCtx)
+	}
+	if !p.PreemptionBudgetAllows(preemptionCtx.log, preemptionCtx.preemptorCQ, targets) {
+		preemptionCtx.log.V(3).Info("Preemption budget of the ClusterQueue exhausted", "targets", len(targets), "budgetUsage", preemptionCtx.preemptorCQ.PreemptionBudgetUsage)
+		return nil
+	}
+	return targets
+}
+
+// PreemptionBudgetAllows returns whether issuing the preemption of the
+// targets keeps the ClusterQueue within its preemption budget.
+func (p *Preemptor) PreemptionBudgetAllows(log logr.Logger, cq *schdcache.ClusterQueueSnapshot, targets []*Target) bool {
+	if len(targets) == 0 || cq.PreemptionBudgetUsage == nil {
+		return true
+	}
+	return cq.PreemptionBudgetAllows(p.unissuedTargetWorkloads(log, targets))
+}
+
+// unissuedTargetWorkloads returns the workloads of the targets whose
+// preemption wasn't issued yet. The issued ones, including the ones notified
+// of their preemption, are already accounted in the preemption budget.
+func (p *Preemptor) unissuedTargetWorkloads(log logr.Logger, targets []*Target) []*workload.Info {
+	wls := make([]*workload.Info, 0, len(targets))
+	for _, target := range targets {
+		targetKey := types.NamespacedName{Name: target.WorkloadInfo.Obj.Name, Namespace: target.WorkloadInfo.Obj.Namespace}
+		if workloadevict.IsEvicted(target.WorkloadInfo.Obj) || !p.preemptionExpectations.Satisfied(log, targetKey) {
+			continue
+		}
+		if _, notified := p.notices.deadline(target.WorkloadInfo.Obj); notified {
+			continue
+		}
+		wls = append(wls, target.WorkloadInfo)
 	}
-	return p.classicalPreemptions(preemptionCtx)
+	return wls
 }
 
 var HumanReadablePreemptionReasons = map[string]string{
@@ -209,6 +259,14 @@ func (p *Preemptor) IssuePreemptions(
 	ctx, cancel := context.WithCancel(ctx)
 	var successfullyPreempted atomic.Int64
 	var preemptionErrors atomic.Int64
+	var recordedMu sync.Mutex
+	var recorded []*workload.Info
+	recordPreemption := func(victim *workload.Info) {
+		cache.RecordPreemption(preemptor.ClusterQueue, victim)
+		recordedMu.Lock()
+		defer recordedMu.Unlock()
+		recorded = append(recorded, victim)
+	}
 	defer cancel()
 	workqueue.ParallelizeUntil(ctx, parallelPreemptions, len(targets), func(i int) {
 		target := targets[i]
@@ -229,10 +287,36 @@ func (p *Preemptor) IssuePreemptions(
 
 		preemptorPath := buildCQPath(string(preemptor.ClusterQueue), snap)
 		preempteePath := buildCQPath(string(target.WorkloadInfo.ClusterQueue), target.WorkloadCq)
+		message := preemptionMessage(preemptor.Obj, target.Reason, preemptorPath, preempteePath)
 
-		p.preemptionExpectations.ExpectUIDs(log, targetKey, []types.UID{target.WorkloadInfo.Obj.UID})
+		noticed := false
+		if needsPreemptionNotice(target.WorkloadInfo.Obj) {
+			deadline, notified := p.notices.deadline(target.WorkloadInfo.Obj)
+			if !notified {
+				if err := p.notifyPreemption(ctx, target, message); err != nil {
+					errCh.SendErrorWithCancel(err, cancel)
+					preemptionErrors.Add(1)
+					return
+				}
+				gracePeriod := workload.PreemptionGracePeriod(target.WorkloadInfo.Obj)
+				log.V(3).Info("Notified the preemption", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj),
+					"reason", target.Reason, "gracePeriod", gracePeriod)
+				p.recorder.Eventf(target.WorkloadInfo.Obj, nil, corev1.EventTypeNormal, "PreemptionPending", "Preempted",
+					"%s; the workload is evicted in %s unless it acknowledges the checkpoint earlier", message, gracePeriod)
+				recordPreemption(target.WorkloadInfo)
+				successfullyPreempted.Add(1)
+				return
+			}
+			if p.clock.Now().Before(deadline) && !workload.CheckpointAcknowledged(target.WorkloadInfo.Obj) {
+				log.V(3).Info("Waiting for the preempted workload to checkpoint", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj),
+					"preemptingWorkload", klog.KObj(preemptor.Obj), "deadline", deadline)
+				successfullyPreempted.Add(1)
+				return
+			}
+			noticed = true
+		}
 
-		message := preemptionMessage(preemptor.Obj, target.Reason, preemptorPath, preempteePath)
+		p.preemptionExpectations.ExpectUIDs(log, targetKey, []types.UID{target.WorkloadInfo.Obj.UID})
 		wlCopy := target.WorkloadInfo.Obj.DeepCopy()
 		exposeLqMetrics := cache.ShouldExposeLocalQueueMetricsForWorkload(log, wlCopy)
 		err := workloadevict.Evict(
@@ -250,21 +334,34 @@ func (p *Preemptor) IssuePreemptions(
 		}
 		preemptorEffPri, preemptorBase, preemptorBoost := priorityInfo(log, preemptor.Obj)
 		targetEffPri, targetBase, targetBoost := priorityInfo(log, target.WorkloadInfo.Obj)
+		// The target was selected by its priority lowered by the priority decay
+		// of its ClusterQueue.
+		targetDecay := priority.Decay(target.WorkloadInfo.Obj, target.WorkloadInfo.PriorityDecay, p.clock.Now())
+		targetEffPri -= targetDecay
 		log.V(3).Info("Preempted", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj), "preemptorUID", string(preemptor.Obj.UID),
 			"preemptorJobUID", preemptor.Obj.Labels[constants.JobUIDLabel], "reason", target.Reason, "message", message, "targetClusterQueue", klog.KRef("", string(target.WorkloadInfo.ClusterQueue)),
 			"preemptorPath", preemptorPath, "preempteePath", preempteePath,
 			"preemptorEffectivePriority", preemptorEffPri, "preemptorBoost", preemptorBoost,
-			"targetEffectivePriority", targetEffPri, "targetBoost", targetBoost)
+			"targetEffectivePriority", targetEffPri, "targetBoost", targetBoost, "targetDecay", targetDecay)
 		p.recorder.Eventf(target.WorkloadInfo.Obj, nil, corev1.EventTypeNormal, "Preempted", "Preempted",
-			message+fmt.Sprintf("; preemptor effective priority: %d (base: %d, boost: %d); preemptee effective priority: %d (base: %d, boost: %d)",
-				preemptorEffPri, preemptorBase, preemptorBoost, targetEffPri, targetBase, targetBoost))
+			message+fmt.Sprintf("; preemptor effective priority: %d (base: %d, boost: %d); preemptee effective priority: %d (base: %d, boost: %d, decay: %d)",
+				preemptorEffPri, preemptorBase, preemptorBoost, targetEffPri, targetBase, targetBoost, targetDecay))
 		p.recorder.Eventf(preemptor.Obj, nil, corev1.EventTypeNormal, "PreemptedWorkload", "PreemptedWorkload",
-			"Preempted workload %s (UID: %s) in ClusterQueue %s; preemptor effective priority: %d (base: %d, boost: %d); preemptee effective priority: %d (base: %d, boost: %d)",
+			"Preempted workload %s (UID: %s) in ClusterQueue %s; preemptor effective priority: %d (base: %d, boost: %d); preemptee effective priority: %d (base: %d, boost: %d, decay: %d)",
 			klog.KObj(target.WorkloadInfo.Obj), target.WorkloadInfo.Obj.UID, target.WorkloadInfo.ClusterQueue,
-			preemptorEffPri, preemptorBase, preemptorBoost, targetEffPri, targetBase, targetBoost)
+			preemptorEffPri, preemptorBase, preemptorBoost, targetEffPri, targetBase, targetBoost, targetDecay)
 		workloadevict.ReportPreemption(preemptor.ClusterQueue, target.Reason, target.WorkloadInfo.ClusterQueue, p.roleTracker, p.customLabels)
+		if noticed {
+			// The preemption was accounted in the budget when the notice was issued.
+			p.notices.forget(target.WorkloadInfo.Obj.UID)
+		} else {
+			recordPreemption(target.WorkloadInfo)
+		}
 		successfullyPreempted.Add(1)
 	})
+	// The workloads scheduled later in the cycle observe the preemption
+	// budget consumed by these preemptions.
+	snap.RecordPreemptions(recorded)
 	return int(successfullyPreempted.Load()), int(preemptionErrors.Load()), errCh.ReceiveError()
 }
 
@@ -288,8 +385,11 @@ func (p *Preemptor) classicalPreemptions(preemptionCtx *preemptionCtx) []*Target
 		FrsNeedPreemption: preemptionCtx.frsNeedPreemption,
 		Requests:          preemptionCtx.workloadUsage.Quota.Assigned,
 		WorkloadOrdering:  p.workloadOrdering,
+		Now:               p.clock.Now(),
+		QuotaReservation:  preemptionCtx.preemptorCQ.HeldQuotaReservation(preemptionCtx.preemptor.Obj),
 	}
 	candidatesGenerator := classical.NewCandidateIterator(hierarchicalReclaimCtx, p.enabledAfs, preemptionCtx.frsNeedPreemption, preemptionCtx.snapshot, p.clock, preemptioncommon.CandidatesOrdering)
+	preemptionCtx.protectedCandidates += hierarchicalReclaimCtx.ProtectedCandidates
 	var attemptPossibleOpts []preemptionAttemptOpts
 	borrowWithinCohortForbidden, _ := classical.IsBorrowingWithinCohortForbidden(preemptionCtx.preemptorCQ)
 	// We have three types of candidates:
@@ -490,7 +590,7 @@ func runSecondFsStrategy(retryCandidates []*workload.Info, preemptionCtx *preemp
 }
 
 func (p *Preemptor) fairPreemptions(preemptionCtx *preemptionCtx, strategies []fairsharing.Strategy) []*Target {
-	candidates := p.findCandidates(preemptionCtx.log, preemptionCtx.preemptor.Obj, preemptionCtx.preemptorCQ, preemptionCtx.frsNeedPreemption)
+	candidates := p.findCandidates(preemptionCtx)
 	if len(candidates) == 0 {
 		return nil
 	}
@@ -557,25 +657,32 @@ func flavorResourcesNeedPreemption(assignment flavorassigner.Assignment) sets.Se
 }
 
 func findCandidatesForPolicy(
-	log logr.Logger,
-	wl *kueue.Workload,
+	preemptionCtx *preemptionCtx,
 	workloadsToFilter map[workload.Reference]*workload.Info,
 	policy kueue.PreemptionPolicy,
-	frsNeedPreemption sets.Set[resources.FlavorResource],
+	candidateCQPreemption kueue.ClusterQueuePreemption,
+	reclaimingNominalQuota bool,
 	workloadOrdering workload.Ordering,
 ) []*workload.Info {
 	var candidates []*workload.Info
+	now := preemptionCtx.clock.Now()
 	for _, candidateWl := range workloadsToFilter {
 		if !preemptioncommon.SatisfiesPreemptionPolicy(
-			log,
-			wl,
-			candidateWl.Obj,
+			preemptionCtx.log,
+			preemptionCtx.preemptor.Obj,
+			candidateWl,
 			workloadOrdering,
-			policy) {
+			policy,
+			now) {
 			continue
 		}
 
-		if !classical.WorkloadUsesResources(candidateWl, frsNeedPreemption) {
+		if !classical.WorkloadUsesResources(candidateWl, preemptionCtx.frsNeedPreemption) {
+			continue
+		}
+
+		if preemptioncommon.ProtectedByMinimumRuntime(candidateWl, candidateCQPreemption, reclaimingNominalQuota, now) {
+			preemptionCtx.protectedCandidates++
 			continue
 		}
 		candidates = append(candidates, candidateWl)
@@ -586,21 +693,23 @@ func findCandidatesForPolicy(
 // findCandidates obtains candidates for preemption within the ClusterQueue and
 // cohort that respect the preemption policy and are using a resource that the
 // preempting workload needs.
-func (p *Preemptor) findCandidates(log logr.Logger, wl *kueue.Workload, cq *schdcache.ClusterQueueSnapshot, frsNeedPreemption sets.Set[resources.FlavorResource]) []*workload.Info {
+func (p *Preemptor) findCandidates(preemptionCtx *preemptionCtx) []*workload.Info {
 	var candidates []*workload.Info
+	cq := preemptionCtx.preemptorCQ
 
 	if cq.Preemption.WithinClusterQueue != kueue.PreemptionPolicyNever {
-		newCandidates := findCandidatesForPolicy(log, wl, cq.Workloads, cq.Preemption.WithinClusterQueue, frsNeedPreemption, p.workloadOrdering)
+		newCandidates := findCandidatesForPolicy(preemptionCtx, cq.Workloads, cq.Preemption.WithinClusterQueue, cq.Preemption, false, p.workloadOrdering)
 		candidates = append(candidates, newCandidates...)
 	}
 
 	if cq.HasParent() && cq.Preemption.ReclaimWithinCohort != kueue.PreemptionPolicyNever {
+		reclaimingNominalQuota := features.Enabled(features.PreemptionMinimumRuntime) && queueWithinNominalAfterAdmission(preemptionCtx)
 		for _, cohortCQ := range cq.Parent().Root().SubtreeClusterQueues() {
-			if cq == cohortCQ || !cqIsBorrowing(cohortCQ, frsNeedPreemption) {
+			if cq == cohortCQ || !cqIsBorrowing(cohortCQ, preemptionCtx.frsNeedPreemption) {
 				// Can't reclaim quota from itself or ClusterQueues that are not borrowing.
 				continue
 			}
-			newCandidates := findCandidatesForPolicy(log, wl, cohortCQ.Workloads, cq.Preemption.ReclaimWithinCohort, frsNeedPreemption, p.workloadOrdering)
+			newCandidates := findCandidatesForPolicy(preemptionCtx, cohortCQ.Workloads, cq.Preemption.ReclaimWithinCohort, cohortCQ.Preemption, reclaimingNominalQuota, p.workloadOrdering)
 			candidates = append(candidates, newCandidates...)
 		}
 	}
@@ -676,6 +785,15 @@ func queueWithinNominalInResourcesNeedingPreemption(preemptionCtx *preemptionCtx
 	return true
 }
 
+// queueWithinNominalAfterAdmission checks whether the preemptor CQ's usage,
+// including the incoming workload, stays within nominal quota for all
+// flavor-resources needing preemption.
+func queueWithinNominalAfterAdmission(preemptionCtx *preemptionCtx) bool {
+	revertSimulation := preemptionCtx.preemptorCQ.SimulateUsageAddition(preemptionCtx.workloadUsage)
+	defer revertSimulation()
+	return queueWithinNominalInResourcesNeedingPreemption(preemptionCtx)
+}
+
 // buildCQPath constructs a path like "/parent/.../cq" for a given ClusterQueue snapshot.
 func buildCQPath(cqName string, cqSnap *schdcache.ClusterQueueSnapshot) string {
 	parts := []string{cqName}
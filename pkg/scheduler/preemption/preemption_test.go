@@ -25,15 +25,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/component-base/featuregate"
 	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
@@ -4141,7 +4145,7 @@ func TestPreemption(t *testing.T) {
 				}
 				wlInfo := workload.NewInfo(tc.incoming)
 				wlInfo.ClusterQueue = tc.targetCQ
-				targets := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshotWorkingCopy)
+				targets, _ := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshotWorkingCopy)
 				preempted, failed, err := preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshotWorkingCopy.ClusterQueue(wlInfo.ClusterQueue))
 				if err != nil {
 					t.Fatalf("Failed doing preemption")
@@ -4358,7 +4362,7 @@ func TestPreemptionWhenWorkloadModifiedConcurrently(t *testing.T) {
 				}
 				wlInfo := workload.NewInfo(tc.incoming)
 				wlInfo.ClusterQueue = kueue.ClusterQueueReference(cq.Name)
-				targets := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshotWorkingCopy)
+				targets, _ := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshotWorkingCopy)
 				_, _, err = preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshotWorkingCopy.ClusterQueue(wlInfo.ClusterQueue))
 				if err != nil {
 					t.Fatalf("Failed doing preemption")
@@ -4575,7 +4579,7 @@ func TestIssuePreemptionsSkipsDuplicate(t *testing.T) {
 				}
 				wlInfo := workload.NewInfo(tc.incoming)
 				wlInfo.ClusterQueue = kueue.ClusterQueueReference(cq.Name)
-				targets := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshot)
+				targets, _ := preemptor.GetTargets(ctx, *wlInfo, tc.assignment, snapshot)
 
 				if len(targets) == 0 {
 					t.Fatal("Expected preemption targets")
@@ -4610,6 +4614,702 @@ func TestIssuePreemptionsSkipsDuplicate(t *testing.T) {
 func targetKeyReason(key workload.Reference, reason string) string {
 	return fmt.Sprintf("%s:%s", key, reason)
 }
+func TestPreemptionBudgetLimitsTargets(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admitted := func(name string) kueue.Workload {
+		return *utiltestingapi.MakeWorkload(name, "").
+			Priority(-1).
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuotaAt(
+				utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "2").
+						Obj()).
+					Obj(),
+				now,
+			).
+			Obj()
+	}
+
+	cases := map[string]struct {
+		enablePreemptionBudgets bool
+		maxWorkloads            int32
+		wantTargets             int
+		wantRecorded            int32
+	}{
+		"feature disabled": {
+			maxWorkloads: 1,
+			wantTargets:  2,
+		},
+		"budget allows the preemptions": {
+			enablePreemptionBudgets: true,
+			maxWorkloads:            2,
+			wantTargets:             2,
+			wantRecorded:            2,
+		},
+		"budget exhausted": {
+			enablePreemptionBudgets: true,
+			maxWorkloads:            1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PreemptionBudgets, tc.enablePreemptionBudgets)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithLists(&kueue.WorkloadList{Items: []kueue.Workload{admitted("low-1"), admitted("low-2")}}).
+				WithStatusSubresource(&kueue.Workload{}).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+
+			cqCache := schdcache.New(cl, schdcache.WithClock(clocktesting.NewFakeClock(now)))
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+					Resource(corev1.ResourceCPU, "4").
+					Obj()).
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+					PreemptionBudget: &kueue.PreemptionBudget{
+						Window:       metav1.Duration{Duration: time.Hour},
+						MaxWorkloads: &tc.maxWorkloads,
+					},
+				}).
+				Obj()); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+
+			preemptor := New(cl, workload.Ordering{}, &utiltesting.EventRecorder{}, nil, false, clocktesting.NewFakeClock(now), nil, preemptexpectations.New(), nil)
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("in", "").
+				Priority(1).
+				Request(corev1.ResourceCPU, "4").
+				Obj())
+			wlInfo.ClusterQueue = "cq"
+			targets, _ := preemptor.GetTargets(ctx, *wlInfo, singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}), snapshot)
+			if len(targets) != tc.wantTargets {
+				t.Fatalf("Got %d targets, want %d", len(targets), tc.wantTargets)
+			}
+			if _, _, err := preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshot.ClusterQueue("cq")); err != nil {
+				t.Fatalf("Failed doing preemption: %v", err)
+			}
+
+			status, err := cqCache.PreemptionBudgetStatus("cq")
+			if err != nil {
+				t.Fatalf("Failed getting the preemption budget status: %v", err)
+			}
+			var recorded int32
+			if status != nil {
+				recorded = status.PreemptedWorkloads
+			}
+			if recorded != tc.wantRecorded {
+				t.Errorf("Recorded %d preemptions in the budget, want %d", recorded, tc.wantRecorded)
+			}
+			if usage := snapshot.ClusterQueue("cq").PreemptionBudgetUsage; usage != nil && int32(usage.Workloads) != tc.wantRecorded {
+				t.Errorf("Recorded %d preemptions in the budget of the snapshot, want %d", usage.Workloads, tc.wantRecorded)
+			}
+		})
+	}
+}
+
+func TestIssuePreemptionsWithPreemptionNotice(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admitted := utiltestingapi.MakeWorkload("low", "").
+		UID("low").
+		Priority(-1).
+		Request(corev1.ResourceCPU, "4").
+		PreemptionGracePeriodSeconds(300).
+		ReserveQuotaAt(
+			utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "4").
+					Obj()).
+				Obj(),
+			now,
+		)
+	noticed := func(at time.Time) metav1.Condition {
+		return metav1.Condition{
+			Type:               kueue.WorkloadPreemptionPending,
+			Status:             metav1.ConditionTrue,
+			Reason:             kueue.InClusterQueueReason,
+			LastTransitionTime: metav1.NewTime(at),
+		}
+	}
+	withdrawn := func(at time.Time) metav1.Condition {
+		return metav1.Condition{
+			Type:               kueue.WorkloadPreemptionPending,
+			Status:             metav1.ConditionFalse,
+			Reason:             kueue.WorkloadPreemptionNoticeExpired,
+			LastTransitionTime: metav1.NewTime(at),
+		}
+	}
+
+	cases := map[string]struct {
+		enableCheckpointAwarePreemption bool
+		target                          *kueue.Workload
+		wantPreemptionPending           bool
+		wantEvicted                     bool
+	}{
+		"feature disabled": {
+			target:      admitted.Clone().Obj(),
+			wantEvicted: true,
+		},
+		"notice issued": {
+			enableCheckpointAwarePreemption: true,
+			target:                          admitted.Clone().Obj(),
+			wantPreemptionPending:           true,
+		},
+		"within the grace period": {
+			enableCheckpointAwarePreemption: true,
+			target:                          admitted.Clone().Condition(noticed(now.Add(-time.Minute))).Obj(),
+			wantPreemptionPending:           true,
+		},
+		"grace period elapsed": {
+			enableCheckpointAwarePreemption: true,
+			target:                          admitted.Clone().Condition(noticed(now.Add(-10 * time.Minute))).Obj(),
+			wantEvicted:                     true,
+		},
+		"notice withdrawn": {
+			enableCheckpointAwarePreemption: true,
+			target:                          admitted.Clone().Condition(withdrawn(now.Add(-time.Hour))).Obj(),
+			wantPreemptionPending:           true,
+		},
+		"checkpoint acknowledged": {
+			enableCheckpointAwarePreemption: true,
+			target: admitted.Clone().
+				Annotation(controllerconstants.CheckpointAcknowledgedAnnotation, "true").
+				Condition(noticed(now.Add(-time.Minute))).
+				Obj(),
+			wantEvicted: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CheckpointAwarePreemption, tc.enableCheckpointAwarePreemption)
+			// The fake client doesn't drop the conditions removed by an apply patch.
+			features.SetFeatureGateDuringTest(t, features.WorkloadRequestUseMergePatch, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(tc.target).
+				WithStatusSubresource(&kueue.Workload{}).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+
+			cqCache := schdcache.New(cl)
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+					Resource(corev1.ResourceCPU, "4").
+					Obj()).
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+				}).
+				Obj()); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+			cqCache.AddOrUpdateWorkload(log, tc.target.DeepCopy())
+
+			preemptor := New(cl, workload.Ordering{}, &utiltesting.EventRecorder{}, nil, false, clocktesting.NewFakeClock(now), nil, preemptexpectations.New(), nil)
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("in", "").
+				Priority(1).
+				Request(corev1.ResourceCPU, "4").
+				Obj())
+			wlInfo.ClusterQueue = "cq"
+			targets, _ := preemptor.GetTargets(ctx, *wlInfo, singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}), snapshot)
+			if len(targets) != 1 {
+				t.Fatalf("Got %d targets, want 1", len(targets))
+			}
+			preempted, _, err := preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshot.ClusterQueue("cq"))
+			if err != nil {
+				t.Fatalf("Failed doing preemption: %v", err)
+			}
+			if preempted != 1 {
+				t.Errorf("Got %d preempted workloads, want 1", preempted)
+			}
+
+			var got kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.target), &got); err != nil {
+				t.Fatalf("Failed getting the target: %v", err)
+			}
+			if pending := apimeta.IsStatusConditionTrue(got.Status.Conditions, kueue.WorkloadPreemptionPending); pending != tc.wantPreemptionPending {
+				t.Errorf("Unexpected PreemptionPending condition, want=%v, got=%v", tc.wantPreemptionPending, pending)
+			}
+			if evicted := apimeta.IsStatusConditionTrue(got.Status.Conditions, kueue.WorkloadEvicted); evicted != tc.wantEvicted {
+				t.Errorf("Unexpected Evicted condition, want=%v, got=%v", tc.wantEvicted, evicted)
+			}
+		})
+	}
+}
+
+func TestIssuePreemptionsReportsDecayedPriority(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PriorityDecay, true)
+	now := time.Now().Truncate(time.Second)
+	ctx, log := utiltesting.ContextWithLog(t)
+	target := utiltestingapi.MakeWorkload("decayed", "default").
+		UID("decayed").
+		Priority(5).
+		Request(corev1.ResourceCPU, "4").
+		ReserveQuotaAt(
+			utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "4").
+					Obj()).
+				Obj(),
+			now.Add(-25*time.Minute),
+		).
+		Obj()
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(target).
+		WithStatusSubresource(&kueue.Workload{}).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		Build()
+
+	cqCache := schdcache.New(cl)
+	cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	if err := cqCache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "4").
+			Obj()).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+			PriorityDecay: &kueue.PriorityDecay{
+				WindowSeconds: 600,
+				Step:          2,
+			},
+		}).
+		Obj()); err != nil {
+		t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+	}
+	cqCache.AddOrUpdateWorkload(log, target.DeepCopy())
+
+	recorder := &utiltesting.EventRecorder{}
+	preemptor := New(cl, workload.Ordering{}, recorder, nil, false, clocktesting.NewFakeClock(now), nil, preemptexpectations.New(), nil)
+	snapshot, err := cqCache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error while building snapshot: %v", err)
+	}
+	wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("in", "default").
+		UID("in").
+		Priority(3).
+		Request(corev1.ResourceCPU, "4").
+		Obj())
+	wlInfo.ClusterQueue = "cq"
+	targets, _ := preemptor.GetTargets(ctx, *wlInfo, singlePodSetAssignment(flavorassigner.ResourceAssignment{
+		corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+			Name: "default",
+			Mode: flavorassigner.Preempt,
+		},
+	}), snapshot)
+	if len(targets) != 1 {
+		t.Fatalf("Got %d targets, want 1", len(targets))
+	}
+	if _, _, err := preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshot.ClusterQueue("cq")); err != nil {
+		t.Fatalf("Failed doing preemption: %v", err)
+	}
+
+	wantEvents := []utiltesting.EventRecord{
+		{
+			Key:       types.NamespacedName{Namespace: "default", Name: "decayed"},
+			EventType: corev1.EventTypeNormal,
+			Reason:    "Preempted",
+			Message:   "Preempted to accommodate a workload (UID: in, JobUID: UNKNOWN) due to prioritization in the ClusterQueue; preemptor path: /cq; preemptee path: /cq; preemptor effective priority: 3 (base: 3, boost: 0); preemptee effective priority: 1 (base: 5, boost: 0, decay: 4)",
+		},
+		{
+			Key:       types.NamespacedName{Namespace: "default", Name: "in"},
+			EventType: corev1.EventTypeNormal,
+			Reason:    "PreemptedWorkload",
+			Message:   "Preempted workload default/decayed (UID: decayed) in ClusterQueue cq; preemptor effective priority: 3 (base: 3, boost: 0); preemptee effective priority: 1 (base: 5, boost: 0, decay: 4)",
+		},
+	}
+	var gotEvents []utiltesting.EventRecord
+	for _, event := range recorder.RecordedEvents {
+		if event.Reason == "Preempted" || event.Reason == "PreemptedWorkload" {
+			gotEvents = append(gotEvents, event)
+		}
+	}
+	if diff := cmp.Diff(wantEvents, gotEvents, cmpopts.SortSlices(utiltesting.SortEvents)); diff != "" {
+		t.Errorf("Unexpected events (-want,+got):\n%s", diff)
+	}
+}
+
+// The quota released by the targets notified of their preemption is reserved
+// for the preemptor until they are evicted, so that other workloads can't
+// take it in the meantime.
+func TestPreemptionNoticeReservesQuota(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	target := utiltestingapi.MakeWorkload("low", "").
+		UID("low").
+		Priority(-1).
+		Request(corev1.ResourceCPU, "4").
+		PreemptionGracePeriodSeconds(300).
+		ReserveQuotaAt(
+			utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "4").
+					Obj()).
+				Obj(),
+			now,
+		).
+		Obj()
+	incoming := utiltestingapi.MakeWorkload("in", "").
+		Priority(1).
+		Request(corev1.ResourceCPU, "4")
+	fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	cases := map[string]struct {
+		// update is applied to the cache once the target is notified.
+		update                func(*schdcache.Cache, *clocktesting.FakeClock, logr.Logger)
+		wantReserved          bool
+		wantAvailable         int64
+		wantReleasedAvailable int64
+	}{
+		"target notified": {
+			wantReserved:          true,
+			wantAvailable:         2_000,
+			wantReleasedAvailable: 2_000,
+		},
+		"target evicted": {
+			update: func(cqCache *schdcache.Cache, _ *clocktesting.FakeClock, log logr.Logger) {
+				_ = cqCache.DeleteWorkload(log, workload.Key(target))
+			},
+			wantReserved:          true,
+			wantAvailable:         2_000,
+			wantReleasedAvailable: 6_000,
+		},
+		"notice withdrawn": {
+			update: func(cqCache *schdcache.Cache, fakeClock *clocktesting.FakeClock, log logr.Logger) {
+				fakeClock.Step(6 * time.Minute)
+				withdrawn := target.DeepCopy()
+				workload.WithdrawPreemptionNotice(withdrawn, fakeClock.Now())
+				cqCache.AddOrUpdateWorkload(log, withdrawn)
+			},
+			wantAvailable:         2_000,
+			wantReleasedAvailable: 2_000,
+		},
+		"notice expired": {
+			update: func(cqCache *schdcache.Cache, fakeClock *clocktesting.FakeClock, log logr.Logger) {
+				_ = cqCache.DeleteWorkload(log, workload.Key(target))
+				fakeClock.Step(5*time.Minute + workload.PreemptionNoticeTimeout)
+			},
+			wantAvailable:         6_000,
+			wantReleasedAvailable: 6_000,
+		},
+		"preemptor admitted": {
+			update: func(cqCache *schdcache.Cache, _ *clocktesting.FakeClock, log logr.Logger) {
+				_ = cqCache.DeleteWorkload(log, workload.Key(target))
+				cqCache.AddOrUpdateWorkload(log, incoming.Clone().
+					ReserveQuotaAt(
+						utiltestingapi.MakeAdmission("cq").
+							PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "default", "4").
+								Obj()).
+							Obj(),
+						now,
+					).
+					Obj())
+			},
+			wantAvailable:         2_000,
+			wantReleasedAvailable: 2_000,
+		},
+		"preemptor deleted": {
+			update: func(cqCache *schdcache.Cache, _ *clocktesting.FakeClock, log logr.Logger) {
+				_ = cqCache.DeleteWorkload(log, workload.Key(target))
+				_ = cqCache.DeleteWorkload(log, workload.Key(incoming.Obj()))
+			},
+			wantAvailable:         6_000,
+			wantReleasedAvailable: 6_000,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CheckpointAwarePreemption, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(target.DeepCopy()).
+				WithStatusSubresource(&kueue.Workload{}).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+
+			fakeClock := clocktesting.NewFakeClock(now)
+			cqCache := schdcache.New(cl, schdcache.WithClock(fakeClock))
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+					Resource(corev1.ResourceCPU, "6").
+					Obj()).
+				Preemption(kueue.ClusterQueuePreemption{
+					WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+				}).
+				Obj()); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+			cqCache.AddOrUpdateWorkload(log, target.DeepCopy())
+
+			preemptor := New(cl, workload.Ordering{}, &utiltesting.EventRecorder{}, nil, false, fakeClock, nil, preemptexpectations.New(), nil)
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			wlInfo := workload.NewInfo(incoming.Clone().Obj())
+			wlInfo.ClusterQueue = "cq"
+			targets, _ := preemptor.GetTargets(ctx, *wlInfo, singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}), snapshot)
+			if _, _, err := preemptor.IssuePreemptions(ctx, cqCache, wlInfo, targets, snapshot.ClusterQueue("cq")); err != nil {
+				t.Fatalf("Failed doing preemption: %v", err)
+			}
+			notified, expiry := preemptor.NotifiedTargets(targets)
+			if len(notified) != 1 {
+				t.Fatalf("Got %d notified targets, want 1", len(notified))
+			}
+			if wantExpiry := now.Add(5*time.Minute + workload.PreemptionNoticeTimeout); !expiry.Equal(wantExpiry) {
+				t.Errorf("Unexpected expiry of the notices, want=%v, got=%v", wantExpiry, expiry)
+			}
+			cqCache.ReservePreemptionQuota(wlInfo, resources.FlavorResourceQuantities{fr: resources.NewAmount(4_000)}, notified, expiry)
+			if tc.update != nil {
+				tc.update(cqCache, fakeClock, log)
+			}
+
+			snapshot, err = cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			cq := snapshot.ClusterQueue("cq")
+			if _, reserved := cq.PreemptionReservations[workload.Key(wlInfo.Obj)]; reserved != tc.wantReserved {
+				t.Errorf("Unexpected quota reserved for the preemptor, want=%v, got=%v", tc.wantReserved, reserved)
+			}
+			if got := cq.Available(fr); got.Cmp(resources.NewAmount(tc.wantAvailable)) != 0 {
+				t.Errorf("Unexpected available quota for other workloads, want=%v, got=%v", tc.wantAvailable, got)
+			}
+			revert := snapshot.SimulatePreemptionReservationRelease(wlInfo)
+			if got := cq.Available(fr); got.Cmp(resources.NewAmount(tc.wantReleasedAvailable)) != 0 {
+				t.Errorf("Unexpected available quota for the preemptor, want=%v, got=%v", tc.wantReleasedAvailable, got)
+			}
+			revert()
+			if got := cq.Available(fr); got.Cmp(resources.NewAmount(tc.wantAvailable)) != 0 {
+				t.Errorf("Unexpected available quota after reverting the release, want=%v, got=%v", tc.wantAvailable, got)
+			}
+		})
+	}
+}
+
+func TestPreemptionNoticesDeadline(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	withdrawn := func(at time.Time) metav1.Condition {
+		return metav1.Condition{
+			Type:               kueue.WorkloadPreemptionPending,
+			Status:             metav1.ConditionFalse,
+			Reason:             kueue.WorkloadPreemptionNoticeExpired,
+			LastTransitionTime: metav1.NewTime(at),
+		}
+	}
+	notified := utiltestingapi.MakeWorkload("notified", "").UID("notified").PreemptionGracePeriodSeconds(60)
+
+	cases := map[string]struct {
+		wl           *kueue.Workload
+		wantDeadline time.Time
+		wantNotified bool
+	}{
+		"notice not observed yet": {
+			wl:           notified.Clone().Obj(),
+			wantDeadline: now.Add(time.Minute),
+			wantNotified: true,
+		},
+		"previous notice withdrawn": {
+			wl:           notified.Clone().Condition(withdrawn(now.Add(-time.Hour))).Obj(),
+			wantDeadline: now.Add(time.Minute),
+			wantNotified: true,
+		},
+		"notice withdrawn": {
+			wl: notified.Clone().Condition(withdrawn(now.Add(2 * time.Minute))).Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			notices := newPreemptionNotices()
+			notices.record(tc.wl, now)
+			deadline, notified := notices.deadline(tc.wl)
+			if notified != tc.wantNotified || !deadline.Equal(tc.wantDeadline) {
+				t.Errorf("Unexpected deadline, want=(%v, %v), got=(%v, %v)", tc.wantDeadline, tc.wantNotified, deadline, notified)
+			}
+		})
+	}
+}
+
+func TestPreemptionNoticesForgetExpiredNotices(t *testing.T) {
+	now := time.Now()
+	notices := newPreemptionNotices()
+	deleted := utiltestingapi.MakeWorkload("deleted", "").UID("deleted").PreemptionGracePeriodSeconds(60).Obj()
+	notices.record(deleted, now)
+
+	notified := utiltestingapi.MakeWorkload("notified", "").UID("notified").PreemptionGracePeriodSeconds(60).Obj()
+	notices.record(notified, now.Add(time.Minute+workload.PreemptionNoticeTimeout+time.Second))
+	if _, found := notices.issued[deleted.UID]; found {
+		t.Error("The expired notice of the deleted workload is still tracked")
+	}
+	if _, found := notices.issued[notified.UID]; !found {
+		t.Error("The notice of the notified workload isn't tracked")
+	}
+}
+
+func TestPreemptionMinimumRuntime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admitted := func(name string, cq kueue.ClusterQueueReference, at time.Time) kueue.Workload {
+		return *utiltestingapi.MakeWorkload(name, "").
+			Priority(-1).
+			Request(corev1.ResourceCPU, "4").
+			ReserveQuotaAt(
+				utiltestingapi.MakeAdmission(cq).
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "4").
+						Obj()).
+					Obj(),
+				at,
+			).
+			Obj()
+	}
+
+	cases := map[string]struct {
+		disablePreemptionMinimumRuntime bool
+		fairSharing                     bool
+		admitted                        kueue.Workload
+		exemption                       kueue.MinimumRuntimeExemption
+		wantTargets                     []string
+		wantBlocked                     bool
+	}{
+		"feature disabled": {
+			disablePreemptionMinimumRuntime: true,
+			admitted:                        admitted("recent", "a", now.Add(-time.Minute)),
+			wantTargets:                     []string{"recent"},
+		},
+		"within the ClusterQueue, within the minimum runtime": {
+			admitted:    admitted("recent", "a", now.Add(-time.Minute)),
+			wantBlocked: true,
+		},
+		"within the ClusterQueue, past the minimum runtime": {
+			admitted:    admitted("old", "a", now.Add(-10*time.Minute)),
+			wantTargets: []string{"old"},
+		},
+		"reclaiming nominal quota": {
+			admitted:    admitted("recent", "b", now.Add(-time.Minute)),
+			wantTargets: []string{"recent"},
+		},
+		"reclaiming nominal quota without exemption": {
+			admitted:    admitted("recent", "b", now.Add(-time.Minute)),
+			exemption:   kueue.MinimumRuntimeExemptionNone,
+			wantBlocked: true,
+		},
+		"fair sharing, within the ClusterQueue, within the minimum runtime": {
+			fairSharing: true,
+			admitted:    admitted("recent", "a", now.Add(-time.Minute)),
+			wantBlocked: true,
+		},
+		"fair sharing, reclaiming nominal quota": {
+			fairSharing: true,
+			admitted:    admitted("recent", "b", now.Add(-time.Minute)),
+			wantTargets: []string{"recent"},
+		},
+		"fair sharing, reclaiming nominal quota without exemption": {
+			fairSharing: true,
+			admitted:    admitted("recent", "b", now.Add(-time.Minute)),
+			exemption:   kueue.MinimumRuntimeExemptionNone,
+			wantBlocked: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PreemptionMinimumRuntime, !tc.disablePreemptionMinimumRuntime)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithLists(&kueue.WorkloadList{Items: []kueue.Workload{tc.admitted}}).
+				WithStatusSubresource(&kueue.Workload{}).
+				Build()
+
+			cqCache := schdcache.New(cl)
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			preemption := kueue.ClusterQueuePreemption{
+				WithinClusterQueue:      kueue.PreemptionPolicyLowerPriority,
+				ReclaimWithinCohort:     kueue.PreemptionPolicyAny,
+				MinimumRuntimeSeconds:   new(int32(300)),
+				MinimumRuntimeExemption: tc.exemption,
+			}
+			for _, cq := range []*kueue.ClusterQueue{
+				utiltestingapi.MakeClusterQueue("a").
+					Cohort("cohort").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "4").
+						Obj()).
+					Preemption(preemption).
+					Obj(),
+				utiltestingapi.MakeClusterQueue("b").
+					Cohort("cohort").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "0").
+						Obj()).
+					Preemption(preemption).
+					Obj(),
+			} {
+				if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+				}
+			}
+			cqCache.AddOrUpdateWorkload(log, tc.admitted.DeepCopy())
+
+			var fs *config.FairSharing
+			if tc.fairSharing {
+				fs = &config.FairSharing{}
+			}
+			preemptor := New(cl, workload.Ordering{}, &utiltesting.EventRecorder{}, fs, false, clocktesting.NewFakeClock(now), nil, preemptexpectations.New(), nil)
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("in", "").
+				Priority(1).
+				Request(corev1.ResourceCPU, "4").
+				Obj())
+			wlInfo.ClusterQueue = "a"
+
+			targets, protected := preemptor.GetTargets(ctx, *wlInfo, singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}), snapshot)
+			gotTargets := make([]string, 0, len(targets))
+			for _, target := range targets {
+				gotTargets = append(gotTargets, target.WorkloadInfo.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.wantTargets, gotTargets, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected targets (-want,+got):\n%s", diff)
+			}
+			if blocked := protected > 0; blocked != tc.wantBlocked {
+				t.Errorf("Unexpected preemption blocked by the minimum runtime, want=%v, got=%v", tc.wantBlocked, blocked)
+			}
+		})
+	}
+}
+
 func TestCandidatesOrdering(t *testing.T) {
 	now := time.Now()
 
@@ -4636,6 +5336,12 @@ func TestCandidatesOrdering(t *testing.T) {
 		Obj())
 	wlHighUsageLqDifCQ.LocalQueueFSUsage = new(1.0)
 
+	wlDecayed := workload.NewInfo(utiltestingapi.MakeWorkload("decayed", "").
+		ReserveQuotaAt(utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(preemptorCq)).Obj(), now.Add(-time.Hour)).
+		Priority(10).
+		Obj())
+	wlDecayed.PriorityDecay = &kueue.PriorityDecay{WindowSeconds: 1800, Step: 5}
+
 	cases := map[string]struct {
 		candidates     []workload.Info
 		wantCandidates []workload.Reference
@@ -4759,6 +5465,21 @@ func TestCandidatesOrdering(t *testing.T) {
 			},
 			wantCandidates: []workload.Reference{"high_lq_usage_different_cq", "mid_lq_usage"},
 			featureGates:   map[featuregate.Feature]bool{features.AdmissionFairSharing: true},
+		},
+		"workloads with lower decayed priority first": {
+			candidates: []workload.Info{
+				*workload.NewInfo(utiltestingapi.MakeWorkload("low", "").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(preemptorCq)).Obj(), now).
+					Priority(1).
+					Obj()),
+				*wlDecayed,
+				*workload.NewInfo(utiltestingapi.MakeWorkload("mid", "").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(preemptorCq)).Obj(), now).
+					Priority(5).
+					Obj()),
+			},
+			wantCandidates: []workload.Reference{"decayed", "low", "mid"},
+			featureGates:   map[featuregate.Feature]bool{features.PriorityDecay: true},
 		}}
 
 	_, log := utiltesting.ContextWithLog(t)
@@ -0,0 +1,75 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var ErrWorkloadNotPending = errors.New("workload is not pending")
+
+// ExplainPreemption runs the flavor assignment and the preemption target
+// selection for a pending workload against a snapshot of the cache, without
+// admitting the workload or issuing any preemption.
+func (s *Scheduler) ExplainPreemption(ctx context.Context, wlKey workload.Reference) (*preemption.Explanation, error) {
+	wl := s.queues.PendingWorkloadInfo(wlKey)
+	if wl == nil {
+		return nil, ErrWorkloadNotPending
+	}
+	var snapshotOpts []schdcache.SnapshotOption
+	if afs.Enabled(s.admissionFairSharing) {
+		snapshotOpts = append(snapshotOpts, schdcache.WithAfsUsageLedger(s.queues.AfsUsageLedger))
+	}
+	snapshot, err := s.cache.Snapshot(ctx, snapshotOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("building snapshot: %w", err)
+	}
+	cq := snapshot.ClusterQueue(wl.ClusterQueue)
+	if cq == nil {
+		return nil, fmt.Errorf("ClusterQueue %s not found in the cache", wl.ClusterQueue)
+	}
+
+	// The flavor scan starts over, as the progress recorded by the scheduling
+	// cycles doesn't apply to this dry run.
+	wl.LastAssignment = nil
+	flvAssigner := flavorassigner.New(
+		wl, cq, snapshot.ResourceFlavors, fairsharing.Enabled(s.fairSharing),
+		preemption.NewOracle(s.preemptor, snapshot), nil,
+		s.quotaCheckStrategy, s.resourceFormatter, 0,
+	)
+	assignment := flvAssigner.Assign(ctx, nil)
+	explanation := &preemption.Explanation{
+		ClusterQueue: wl.ClusterQueue,
+		Assignment:   assignment,
+	}
+	if assignment.RepresentativeMode() == flavorassigner.Preempt {
+		explanation.Targets, _ = s.preemptor.GetTargets(ctx, *wl, assignment, snapshot)
+		explanation.Rejected = s.preemptor.RejectedCandidates(log.FromContext(ctx), wl.Obj, cq, assignment)
+	}
+	return explanation, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestExplainPreemption(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admittedWorkload := func(name string, priority int32) *kueue.Workload {
+		return utiltestingapi.MakeWorkload(name, "ns").
+			Priority(priority).
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuotaAt(
+				utiltestingapi.MakeAdmission("cq").
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "default", "1").
+						Obj()).
+					Obj(),
+				now,
+			).
+			Obj()
+	}
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "2").
+			Obj(),
+		).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+		}).
+		Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj()
+	pending := utiltestingapi.MakeWorkload("pending", "ns").
+		Queue("lq").
+		Priority(5).
+		Request(corev1.ResourceCPU, "1").
+		Obj()
+
+	type candidate struct {
+		Workload workload.Reference
+		Reason   string
+	}
+	cases := map[string]struct {
+		wlKey        workload.Reference
+		wantMode     flavorassigner.FlavorAssignmentMode
+		wantTargets  []candidate
+		wantRejected []candidate
+		wantErr      error
+	}{
+		"pending workload": {
+			wlKey:    workload.Key(pending),
+			wantMode: flavorassigner.Preempt,
+			wantTargets: []candidate{
+				{Workload: "ns/low", Reason: kueue.InClusterQueueReason},
+			},
+			wantRejected: []candidate{
+				{Workload: "ns/high", Reason: preemption.RejectedByWithinClusterQueuePolicy},
+			},
+		},
+		"workload not pending": {
+			wlKey:   "ns/low",
+			wantErr: ErrWorkloadNotPending,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			objs := []client.Object{admittedWorkload("low", -1), admittedWorkload("high", 10), pending, lq, utiltesting.MakeNamespace("ns")}
+			cl := utiltesting.NewClientBuilder().WithObjects(objs...).WithStatusSubresource(objs...).Build()
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithPreemptionExpectations(preemptexpectations.New()))
+			scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{}, WithPreemptionExpectations(preemptexpectations.New()))
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s to cache: %v", cq.Name, err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+			}
+			if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+				t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+			}
+
+			explanation, err := scheduler.ExplainPreemption(ctx, tc.wlKey)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if mode := explanation.Assignment.RepresentativeMode(); mode != tc.wantMode {
+				t.Errorf("Unexpected assignment mode: got %v, want %v", mode, tc.wantMode)
+			}
+			var gotTargets []candidate
+			for _, target := range explanation.Targets {
+				gotTargets = append(gotTargets, candidate{Workload: workload.Key(target.WorkloadInfo.Obj), Reason: target.Reason})
+			}
+			if diff := cmp.Diff(tc.wantTargets, gotTargets); diff != "" {
+				t.Errorf("Unexpected targets (-want,+got):\n%s", diff)
+			}
+			var gotRejected []candidate
+			for _, rejected := range explanation.Rejected {
+				gotRejected = append(gotRejected, candidate{Workload: workload.Key(rejected.WorkloadInfo.Obj), Reason: rejected.Reason})
+			}
+			if diff := cmp.Diff(tc.wantRejected, gotRejected); diff != "" {
+				t.Errorf("Unexpected rejected candidates (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/scheduler/decisionlog"
 	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
@@ -91,6 +92,7 @@ type Scheduler struct {
 	roleTracker             *roletracker.RoleTracker
 	customLabels            *metrics.CustomLabels
 	resourceFormatter       *resources.ResourceFormatter
+	admissionDecisionSink   decisionlog.Sink
 
 	// schedulingCycle identifies the number of scheduling
 	// attempts since the last restart.
@@ -107,6 +109,7 @@ type options struct {
 	preemptionExpectations      *expectations.Store
 	customLabels                *metrics.CustomLabels
 	resourceFormatter           *resources.ResourceFormatter
+	admissionDecisionSink       decisionlog.Sink
 }
 
 // Option configures the reconciler.
@@ -179,6 +182,14 @@ func WithResourceFormatter(formatter *resources.ResourceFormatter) Option {
 	}
 }
 
+// WithAdmissionDecisionSink sets the sink that receives a structured record
+// for every workload evaluated in a scheduling cycle.
+func WithAdmissionDecisionSink(sink decisionlog.Sink) Option {
+	return func(o *options) {
+		o.admissionDecisionSink = sink
+	}
+}
+
 func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recorder events.EventRecorder, opts ...Option) *Scheduler {
 	options := defaultOptions
 	for _, opt := range opts {
@@ -215,6 +226,7 @@ func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recor
 		roleTracker:             options.roleTracker,
 		customLabels:            options.customLabels,
 		resourceFormatter:       options.resourceFormatter,
+		admissionDecisionSink:   options.admissionDecisionSink,
 	}
 	return s
 }
@@ -278,9 +290,10 @@ func (e *entry) markAssumed() {
 // recordAssignment stores a flavor assignment and its preemption
 // targets from nominate. LastAssignment aliases the stored
 // assignment's LastState so it tracks any later mutation.
-func (e *entry) recordAssignment(a flavorassigner.Assignment, targets []*preemption.Target) {
+func (e *entry) recordAssignment(a flavorassigner.Assignment, targets []*preemption.Target, blockedByMinimumRuntime bool) {
 	e.assignment = a
 	e.preemptionTargets = targets
+	e.preemptionBlockedByMinimumRuntime = blockedByMinimumRuntime
 	e.inadmissibleMsg = e.assignment.Message()
 	e.LastAssignment = &e.assignment.LastState
 }
@@ -363,6 +376,7 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	result := metrics.AdmissionResultInadmissible
 	for _, e := range entries {
 		logAdmissionAttemptIfVerbose(log, &e)
+		s.recordAdmissionDecision(log, &e)
 		// When the workload is evicted by scheduler we skip requeueAndUpdate.
 		// The eviction process will be finalized by the workload controller.
 		if e.status != assumed && e.status != evicted {
@@ -373,6 +387,7 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	}
 	for _, e := range inadmissibleEntries {
 		logAdmissionAttemptIfVerbose(log, &e)
+		s.recordAdmissionDecision(log, &e)
 		s.requeueAndUpdate(ctx, e)
 	}
 
@@ -404,6 +419,10 @@ func (s *Scheduler) processEntry(
 	ctx = ctrl.LoggerInto(ctx, log)
 	log.V(2).Info("Attempting to schedule workload")
 
+	// The quota reserved for the workload is only released while processing it.
+	defer snapshot.SimulateQuotaReservationRelease(&e.Info)()
+	defer snapshot.SimulatePreemptionReservationRelease(&e.Info)()
+
 	if features.Enabled(features.ConcurrentAdmission) && concurrentadmission.IsVariant(e.Obj) {
 		if moreFavorableSibling := s.findAdmittedMoreFavorableSibling(&e.Info, snapshot); moreFavorableSibling != nil {
 			log.V(3).Info("Skipping workload as a more favorable variant is already admitted", "moreFavorableVariant", klog.KObj(moreFavorableSibling.Obj))
@@ -420,6 +439,9 @@ func (s *Scheduler) processEntry(
 	// Recompute when needed so CQs considered later in the cycle don't repeatedly
 	// lose to earlier CQs and starve for prolonged periods.
 	usage, fits := s.updateAssignmentIfNeeded(ctx, log, e, snapshot, cq, preemptedWorkloads)
+	if fits && len(e.secondaryAssignments) > 0 {
+		fits = e.secondaryAssignmentsFit(snapshot, preemptedWorkloads)
+	}
 	mode := e.assignment.RepresentativeMode()
 
 	if features.Enabled(features.TASFailedNodeReplacementFailFast) && workload.HasTopologyAssignmentWithUnhealthyNode(e.Obj) && mode != flavorassigner.Fit {
@@ -436,6 +458,9 @@ func (s *Scheduler) processEntry(
 
 	if mode == flavorassigner.Preempt {
 		if len(e.preemptionTargets) == 0 {
+			if e.preemptionBlockedByMinimumRuntime {
+				metrics.ReportPreemptionBlockedByMinimumRuntime(e.ClusterQueue, s.customLabels.CQGet(e.ClusterQueue), s.roleTracker)
+			}
 			e.requeueReason = qcache.RequeueReasonPreemptionNoCandidates
 			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
 			s.reserveCapacityForUnreclaimablePreempt(log, e, cq)
@@ -474,6 +499,15 @@ func (s *Scheduler) processEntry(
 		return
 	}
 
+	// The preemption budget may have been consumed by another workload of
+	// the ClusterQueue earlier in the cycle.
+	if mode == flavorassigner.Preempt && !s.preemptor.PreemptionBudgetAllows(log, cq, e.preemptionTargets) {
+		e.markSkipped("Preemption budget of the ClusterQueue exhausted by another workload")
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+		skippedPreemptions[cq.Name]++
+		return
+	}
+
 	if !fits {
 		e.markSkipped("Workload no longer fits after processing another workload")
 		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
@@ -484,6 +518,7 @@ func (s *Scheduler) processEntry(
 	}
 	preemptedWorkloads.Insert(e.preemptionTargets)
 	cq.AddUsage(usage)
+	e.addSecondaryUsage()
 
 	// Filter out the old workload slice from the preemption targets.
 	// The old workload slice is initially included in the preemption targets because it is treated
@@ -493,7 +528,7 @@ func (s *Scheduler) processEntry(
 
 	if mode == flavorassigner.Preempt {
 		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForPreemptedWorkloads
-		s.issuePreemptions(ctx, log, e, preemptionTargets)
+		s.issuePreemptions(ctx, log, e, preemptionTargets, usage.Quota.Assigned)
 		return
 	}
 
@@ -559,11 +594,18 @@ func (s *Scheduler) issueMigration(ctx context.Context, log logr.Logger, e *entr
 	e.inadmissibleMsg += ". Pending the migration of 1 workload(s)"
 }
 
-func (s *Scheduler) issuePreemptions(ctx context.Context, log logr.Logger, e *entry, preemptionTargets []*preemption.Target) {
+func (s *Scheduler) issuePreemptions(ctx context.Context, log logr.Logger, e *entry, preemptionTargets []*preemption.Target, quota resources.FlavorResourceQuantities) {
 	preempted, errors, err := s.preemptor.IssuePreemptions(ctx, s.cache, &e.Info, preemptionTargets, e.clusterQueueSnapshot)
 	if err != nil {
 		log.Error(err, "Failed to preempt workloads")
 	}
+	// The quota released by the targets given a grace period to checkpoint
+	// is reserved for the workload until they are evicted.
+	if features.Enabled(features.CheckpointAwarePreemption) {
+		if notified, expiry := s.preemptor.NotifiedTargets(preemptionTargets); len(notified) > 0 {
+			s.cache.ReservePreemptionQuota(&e.Info, quota, notified, expiry)
+		}
+	}
 	e.markPreemptionOutcome(preempted, errors)
 }
 
@@ -641,6 +683,12 @@ type entry struct {
 	clusterQueueSnapshot *schdcache.ClusterQueueSnapshot
 	quotaReservedReason  string
 	skipStatusUpdate     bool
+	// secondaryAssignments holds the PodSets of a multi-queue Workload
+	// charged to ClusterQueues other than clusterQueueSnapshot.
+	secondaryAssignments []queueAssignment
+	// preemptionBlockedByMinimumRuntime is set when no preemption targets
+	// were found because the candidates haven't reached their minimum runtime.
+	preemptionBlockedByMinimumRuntime bool
 }
 
 func (e *entry) assignmentUsage(log logr.Logger) workload.Usage {
@@ -681,6 +729,10 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 		} else if e.clusterQueueSnapshot == nil {
 			e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s not found", w.ClusterQueue)
 			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
+		} else if features.Enabled(features.LocalQueueBudgets) && s.queues.LocalQueueBudgetBlocked(utilqueue.KeyFromWorkload(w.Obj)) {
+			e.inadmissibleMsg = fmt.Sprintf("The budget of LocalQueue %s is exhausted", w.Obj.Spec.QueueName)
+			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonBudgetExhausted
+			e.requeueReason = qcache.RequeueReasonBudgetExhausted
 		} else if err := workload.ValidateAdmissibility(ctx, s.client, &w, e.clusterQueueSnapshot.NamespaceSelector); err != nil {
 			e.inadmissibleMsg = err.Error()
 			if errors.Is(err, workload.ErrInternal) {
@@ -692,9 +744,26 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 					e.requeueReason = qcache.RequeueReasonNamespaceMismatch
 				}
 			}
+		} else if features.Enabled(features.MultiQueueWorkloads) && workload.IsMultiQueue(w.Obj) {
+			groups, err := s.groupPodSetsByClusterQueue(&e, snap)
+			if err == nil {
+				assignment, targets, blockedByMinimumRuntime := s.getMultiQueueAssignments(ctx, &e, snap, groups)
+				e.recordAssignment(assignment, targets, blockedByMinimumRuntime)
+				// The flavor scan progress is indexed by the PodSets of a single
+				// ClusterQueue, so it isn't kept for multi-queue Workloads.
+				e.LastAssignment = nil
+				entries = append(entries, e)
+				continue
+			}
+			e.inadmissibleMsg = err.Error()
+			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
 		} else {
-			assignment, targets := s.getAssignments(ctx, &e.Info, snap)
-			e.recordAssignment(assignment, targets)
+			revertRelease := snap.SimulateQuotaReservationRelease(&e.Info)
+			revertPreemptionRelease := snap.SimulatePreemptionReservationRelease(&e.Info)
+			assignment, targets, blockedByMinimumRuntime := s.getAssignments(ctx, &e.Info, snap)
+			revertPreemptionRelease()
+			revertRelease()
+			e.recordAssignment(assignment, targets, blockedByMinimumRuntime)
 			entries = append(entries, e)
 			continue
 		}
@@ -715,6 +784,11 @@ func (s *Scheduler) updateAssignmentIfNeeded(
 
 	needsTASRecompute := fitsCheck == schdcache.FitsCheckNoTAS && features.Enabled(features.TASRecomputeAssignmentWithinSchedulingCycle)
 	needsOverlapRecompute := preemptedWorkloads.HasAny(e.preemptionTargets) && features.Enabled(features.RecomputeAssignmentUponPreemptionTargetsOverlap)
+	if len(e.secondaryAssignments) > 0 {
+		// The assignment of a multi-queue Workload spans several ClusterQueues
+		// and is only computed during nomination.
+		needsTASRecompute, needsOverlapRecompute = false, false
+	}
 
 	var revertRemoval func()
 	switch {
@@ -734,8 +808,8 @@ func (s *Scheduler) updateAssignmentIfNeeded(
 	// reach all flavors from the nomination.
 	e.LastAssignment = nil
 	e.NominationMapping = e.readResourceToFlavorMapping()
-	newAssignment, newTargets := s.getAssignments(ctx, &e.Info, snapshot)
-	e.recordAssignment(newAssignment, newTargets)
+	newAssignment, newTargets, blockedByMinimumRuntime := s.getAssignments(ctx, &e.Info, snapshot)
+	e.recordAssignment(newAssignment, newTargets, blockedByMinimumRuntime)
 	if needsOverlapRecompute {
 		if revertRemoval != nil {
 			revertRemoval()
@@ -817,7 +891,7 @@ type partialAssignment struct {
 	preemptionTargets []*preemption.Target
 }
 
-func (s *Scheduler) getAssignments(ctx context.Context, wl *workload.Info, snap *schdcache.Snapshot) (flavorassigner.Assignment, []*preemption.Target) {
+func (s *Scheduler) getAssignments(ctx context.Context, wl *workload.Info, snap *schdcache.Snapshot) (flavorassigner.Assignment, []*preemption.Target, bool) {
 	cq := snap.ClusterQueue(wl.ClusterQueue)
 	// The flavor scan resumes from the progress recorded in LastAssignment, so it has to be
 	// dropped once it no longer describes the current state. Deciding that here rather than
@@ -829,9 +903,9 @@ func (s *Scheduler) getAssignments(ctx context.Context, wl *workload.Info, snap
 			"wl.LastAssignment.ClusterQueueGeneration", wl.LastAssignment.ClusterQueueGeneration)
 		wl.LastAssignment = nil
 	}
-	assignment, targets := s.getInitialAssignments(ctx, wl, snap)
+	assignment, targets, blockedByMinimumRuntime := s.getInitialAssignments(ctx, wl, snap)
 	updateAssignmentForTAS(ctx, snap, cq, wl, &assignment, targets)
-	return assignment, targets
+	return assignment, targets, blockedByMinimumRuntime
 }
 
 // lastAssignmentOutdated reports whether the recorded flavor assignment no longer describes
@@ -874,9 +948,10 @@ func lastAssignmentOutdated(last *workload.AssignmentClusterQueueState, currentC
 //   - A flavorassigner.Assignment representing the selected (possibly reduced) flavor allocation.
 //   - A slice of preemption targets, which may include both explicitly annotated slices and those
 //     identified during scheduling.
+//   - Whether preemption was blocked because the candidates haven't reached their minimum runtime.
 //
 // If no valid assignment can be made, returns the original full assignment with no preemption targets.
-func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info, snap *schdcache.Snapshot) (flavorassigner.Assignment, []*preemption.Target) {
+func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info, snap *schdcache.Snapshot) (flavorassigner.Assignment, []*preemption.Target, bool) {
 	cq := snap.ClusterQueue(wl.ClusterQueue)
 
 	preemptionTargets, replaceableWorkloadSlice := workloadslicing.ReplacedWorkloadSlice(wl, snap)
@@ -889,14 +964,16 @@ func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info
 
 	arm := fullAssignment.RepresentativeMode()
 	if arm == flavorassigner.Fit {
-		return fullAssignment, preemptionTargets
+		return fullAssignment, preemptionTargets, false
 	}
 
+	blockedByMinimumRuntime := false
 	if arm == flavorassigner.Preempt {
-		faPreemptionTargets := s.preemptor.GetTargets(ctx, *wl, fullAssignment, snap)
+		faPreemptionTargets, protected := s.preemptor.GetTargets(ctx, *wl, fullAssignment, snap)
 		if len(faPreemptionTargets) > 0 {
-			return fullAssignment, append(preemptionTargets, faPreemptionTargets...)
+			return fullAssignment, append(preemptionTargets, faPreemptionTargets...), false
 		}
+		blockedByMinimumRuntime = protected > 0
 	}
 
 	if features.Enabled(features.PartialAdmission) && wl.CanBePartiallyAdmitted() {
@@ -908,18 +985,19 @@ func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info
 			}
 
 			if mode == flavorassigner.Preempt {
-				preemptionTargets := s.preemptor.GetTargets(ctx, *wl, assignment, snap)
+				preemptionTargets, protected := s.preemptor.GetTargets(ctx, *wl, assignment, snap)
 				if len(preemptionTargets) > 0 {
 					return &partialAssignment{assignment: assignment, preemptionTargets: preemptionTargets}, true
 				}
+				blockedByMinimumRuntime = blockedByMinimumRuntime || protected > 0
 			}
 			return nil, false
 		})
 		if pa, found := reducer.Search(); found {
-			return pa.assignment, append(preemptionTargets, pa.preemptionTargets...)
+			return pa.assignment, append(preemptionTargets, pa.preemptionTargets...), false
 		}
 	}
-	return fullAssignment, nil
+	return fullAssignment, nil, blockedByMinimumRuntime
 }
 
 func (s *Scheduler) evictWorkloadAfterFailedTASReplacement(ctx context.Context, log logr.Logger, wl *kueue.Workload) error {
@@ -993,6 +1071,7 @@ func (s *Scheduler) admit(ctx context.Context, e *entry, cq *schdcache.ClusterQu
 		ClusterQueue:      e.ClusterQueue,
 		PodSetAssignments: e.assignment.ToAPI(log),
 	}
+	e.setSecondaryClusterQueues(admission.PodSetAssignments)
 
 	consideredStr := flavorassigner.FormatFlavorAssignmentAttemptsForEvents(e.assignment)
 	cacheWl, err := s.assumeWorkload(log, e, cq, admission)
@@ -56,6 +56,7 @@ import (
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 	"sigs.k8s.io/kueue/pkg/workload"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
@@ -273,7 +274,7 @@ func runScheduleTestCases(t *testing.T, cfg scheduleTestConfig, cases map[string
 							switch {
 							case !workload.HasQuotaReservation(w.Obj):
 								t.Errorf("Workload %s is not admitted by a clusterQueue, but it is found as member of clusterQueue %s in the cache", name, cqName)
-							case w.Obj.Status.Admission.ClusterQueue != cqName:
+							case w.Obj.Status.Admission.ClusterQueue != cqName && !workload.SecondaryClusterQueues(w.Obj).Has(cqName):
 								t.Errorf("Workload %s is admitted by clusterQueue %s, but it is found as member of clusterQueue %s in the cache", name, w.Obj.Status.Admission.ClusterQueue, cqName)
 							default:
 								gotAssignments[name] = *w.Obj.Status.Admission
@@ -482,6 +483,272 @@ func TestSchedule(t *testing.T) {
 		*utiltestingapi.MakeLocalQueue("lend-b-queue", "lend").ClusterQueue("lend-b").Obj(),
 	}
 	cases := map[string]scheduleTestCase{
+		"multi-queue workload is admitted with its PodSets in ClusterQueues of the cohort": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("gpu", "eng-alpha").ClusterQueue("eng-beta").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionTrue,
+						Reason:             "QuotaReserved",
+						Message:            "Quota reserved in ClusterQueue eng-alpha",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Admitted",
+						Message:            "The workload is admitted",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Admission(utiltestingapi.MakeAdmission("eng-alpha").
+						PodSets(
+							utiltestingapi.MakePodSetAssignment("driver").
+								Assignment(corev1.ResourceCPU, "on-demand", "1").
+								Count(1).
+								Obj(),
+							utiltestingapi.MakePodSetAssignment("workers").
+								Assignment("example.com/gpu", "model-a", "8").
+								Count(4).
+								ClusterQueue("eng-beta").
+								Obj(),
+						).
+						Obj()).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"eng-alpha/multi": {
+					ClusterQueue: "eng-alpha",
+					PodSetAssignments: []kueue.PodSetAssignment{
+						utiltestingapi.MakePodSetAssignment("driver").
+							Assignment(corev1.ResourceCPU, "on-demand", "1").
+							Count(1).
+							Obj(),
+						utiltestingapi.MakePodSetAssignment("workers").
+							Assignment("example.com/gpu", "model-a", "8").
+							Count(4).
+							ClusterQueue("eng-beta").
+							Obj(),
+					},
+				},
+			},
+		},
+		"multi-queue workload is not admitted when one of its PodSets doesn't fit": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("gpu", "eng-alpha").ClusterQueue("eng-beta").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "6").Obj(),
+					).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "6").Obj(),
+					).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadQuotaReservedReasonExceedsMaxQuota,
+						Message:            "couldn't assign flavors to pod set workers: insufficient quota for example.com/gpu in flavor model-a, previously considered podsets requests (0) + current podset request (24) > maximum capacity (20)",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+						Message:            "The workload has no reservation",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					ResourceRequests(
+						kueue.PodSetRequest{
+							Name:      "driver",
+							Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						},
+						kueue.PodSetRequest{
+							Name:      "workers",
+							Resources: corev1.ResourceList{"example.com/gpu": resource.MustParse("24")},
+						},
+					).
+					Obj(),
+			},
+			wantLeft: map[kueue.ClusterQueueReference][]workload.Reference{
+				"eng-alpha": {"eng-alpha/multi"},
+			},
+		},
+		"multi-queue workload is not admitted when the LocalQueue of a PodSet doesn't exist": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadQuotaReservedReasonMisconfigured,
+						Message:            "LocalQueue gpu of podSet workers doesn't exist",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+						Message:            "The workload has no reservation",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					ResourceRequests(
+						kueue.PodSetRequest{
+							Name:      "driver",
+							Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						},
+						kueue.PodSetRequest{
+							Name:      "workers",
+							Resources: corev1.ResourceList{"example.com/gpu": resource.MustParse("8")},
+						},
+					).
+					Obj(),
+			},
+			wantLeft: map[kueue.ClusterQueueReference][]workload.Reference{
+				"eng-alpha": {"eng-alpha/multi"},
+			},
+		},
+		"multi-queue workload preempts in the ClusterQueue of a PodSet": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("gpu", "eng-alpha").ClusterQueue("eng-beta").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("low", "eng-beta").
+					Queue("main").
+					Priority(0).
+					Request("example.com/gpu", "20").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("eng-beta").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment("example.com/gpu", "model-a", "20").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					Priority(100).
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("multi", "eng-alpha").
+					Queue("main").
+					Priority(100).
+					PodSets(
+						*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "1").Obj(),
+						*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "2").Obj(),
+					).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadQuotaReservedReasonWaitingForPreemptedWorkloads,
+						Message:            "couldn't assign flavors to pod set workers: insufficient unused quota for example.com/gpu in flavor model-a, 8 more needed. Pending the preemption of 1 workload(s)",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+						Message:            "The workload has no reservation",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					ResourceRequests(
+						kueue.PodSetRequest{
+							Name:      "driver",
+							Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						},
+						kueue.PodSetRequest{
+							Name:      "workers",
+							Resources: corev1.ResourceList{"example.com/gpu": resource.MustParse("8")},
+						},
+					).
+					Obj(),
+				*utiltestingapi.MakeWorkload("low", "eng-beta").
+					Queue("main").
+					Priority(0).
+					Request("example.com/gpu", "20").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("eng-beta").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment("example.com/gpu", "model-a", "20").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Preempted",
+						Message:            "Preempted to accommodate a workload (UID: UNKNOWN, JobUID: UNKNOWN) due to prioritization in the ClusterQueue; preemptor path: /eng/eng-alpha; preemptee path: /eng/eng-beta",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadPreempted,
+						Status:             metav1.ConditionTrue,
+						Reason:             "InClusterQueue",
+						Message:            "Preempted to accommodate a workload (UID: UNKNOWN, JobUID: UNKNOWN) due to prioritization in the ClusterQueue; preemptor path: /eng/eng-alpha; preemptee path: /eng/eng-beta",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					SchedulingStatsEviction(kueue.WorkloadSchedulingStatsEviction{Reason: "Preempted", Count: 1}).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"eng-beta/low": {
+					ClusterQueue: "eng-beta",
+					PodSetAssignments: []kueue.PodSetAssignment{
+						utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment("example.com/gpu", "model-a", "20").
+							Obj(),
+					},
+				},
+			},
+			wantLeft: map[kueue.ClusterQueueReference][]workload.Reference{
+				"eng-alpha": {"eng-alpha/multi"},
+			},
+		},
 		"use second flavor when the first has no preemption candidates; WhenCanPreempt: MayStopSearch": {
 			featureGates: map[featuregate.Feature]bool{features.PartialAdmission: true},
 			additionalClusterQueues: []kueue.ClusterQueue{
@@ -8948,6 +9215,198 @@ func TestSchedulerNotifiesWatchersWhenAssumedWorkloadAdmissionFailsWithNotFound(
 	}
 }
 
+func TestSchedulerReportsPreemptionBlockedByMinimumRuntimeOnce(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PreemptionMinimumRuntime, true)
+	features.SetFeatureGateDuringTest(t, features.PartialAdmission, true)
+	now := time.Now().Truncate(time.Second)
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	rf := utiltestingapi.MakeResourceFlavor("rf").Obj()
+	cq := utiltestingapi.MakeClusterQueue("minimum-runtime-cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas(rf.Name).
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+		).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue:    kueue.PreemptionPolicyLowerPriority,
+			MinimumRuntimeSeconds: new(int32(300)),
+		}).
+		Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+	running := utiltestingapi.MakeWorkload("running", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Request(corev1.ResourceCPU, "4").
+		ReserveQuotaAt(
+			utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(cq.Name)).
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, kueue.ResourceFlavorReference(rf.Name), "4").
+					Obj()).
+				Obj(),
+			now.Add(-time.Minute),
+		).
+		Obj()
+	// The Workload can be partially admitted, so the scheduler looks for
+	// preemption targets for each of the reduced counts it tries.
+	wl := utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Priority(10).
+		PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 2).
+			SetMinimumCount(1).
+			Request(corev1.ResourceCPU, "2").
+			Obj()).
+		Obj()
+
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(ns, rf, cq, lq, running, wl).
+		WithStatusSubresource(&kueue.Workload{}).
+		Build()
+
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+	cqCache.AddOrUpdateResourceFlavor(log, rf)
+	if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+	}
+	if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+	}
+	if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+		t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+	}
+	cqCache.AddOrUpdateWorkload(log, running)
+
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{}, WithClock(t, testingclock.NewFakeClock(now)), WithPreemptionExpectations(preemptexpectations.New()))
+
+	ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+	go qManager.CleanUpOnContext(ctx)
+	defer cancel()
+
+	before, err := testutil.GetCounterMetricValue(metrics.PreemptionsBlockedByMinimumRuntimeTotal.WithLabelValues(cq.Name, roletracker.RoleStandalone))
+	if err != nil {
+		t.Fatalf("Failed to read the metric: %v", err)
+	}
+	scheduler.schedule(ctx)
+	after, err := testutil.GetCounterMetricValue(metrics.PreemptionsBlockedByMinimumRuntimeTotal.WithLabelValues(cq.Name, roletracker.RoleStandalone))
+	if err != nil {
+		t.Fatalf("Failed to read the metric: %v", err)
+	}
+	if got := after - before; got != 1 {
+		t.Errorf("Unexpected number of preemptions reported as blocked by the minimum runtime, want=1, got=%v", got)
+	}
+}
+
+func TestSchedulerKeepsPreemptionBudgetAcrossPreemptorsInCycle(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PreemptionBudgets, true)
+	now := time.Now().Truncate(time.Second)
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	rf := utiltestingapi.MakeResourceFlavor("rf").Obj()
+	cq := utiltestingapi.MakeClusterQueue("budget-cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas(rf.Name).
+				Resource(corev1.ResourceCPU, "2").
+				Resource(corev1.ResourceMemory, "2Gi").
+				Obj(),
+		).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+			PreemptionBudget: &kueue.PreemptionBudget{
+				Window:       metav1.Duration{Duration: time.Hour},
+				MaxWorkloads: new(int32(1)),
+			},
+		}).
+		Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+	// Each preemptor can only fit by preempting a different running Workload.
+	running := func(name string, resource corev1.ResourceName, quantity string) *kueue.Workload {
+		return utiltestingapi.MakeWorkload(name, metav1.NamespaceDefault).
+			Queue(kueue.LocalQueueName(lq.Name)).
+			Request(resource, quantity).
+			ReserveQuotaAt(
+				utiltestingapi.MakeAdmission(kueue.ClusterQueueReference(cq.Name)).
+					PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(resource, kueue.ResourceFlavorReference(rf.Name), quantity).
+						Obj()).
+					Obj(),
+				now.Add(-time.Minute),
+			).
+			Obj()
+	}
+	runningCPU := running("running-cpu", corev1.ResourceCPU, "2")
+	runningMemory := running("running-memory", corev1.ResourceMemory, "2Gi")
+	preemptorCPU := utiltestingapi.MakeWorkload("preemptor-cpu", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Priority(10).
+		Request(corev1.ResourceCPU, "2").
+		Obj()
+	preemptorMemory := utiltestingapi.MakeWorkload("preemptor-memory", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Priority(10).
+		Request(corev1.ResourceMemory, "2Gi").
+		Obj()
+
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(ns, rf, cq, lq, runningCPU, runningMemory, preemptorCPU, preemptorMemory).
+		WithStatusSubresource(&kueue.Workload{}).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		Build()
+
+	cqCache := schdcache.New(cl, schdcache.WithClock(testingclock.NewFakeClock(now)))
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+	cqCache.AddOrUpdateResourceFlavor(log, rf)
+	if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+	}
+	cqCache.AddOrUpdateWorkload(log, runningCPU)
+	cqCache.AddOrUpdateWorkload(log, runningMemory)
+
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{}, WithClock(t, testingclock.NewFakeClock(now)), WithPreemptionExpectations(preemptexpectations.New()))
+
+	snapshot, err := cqCache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Building the snapshot: %v", err)
+	}
+	var heads []workload.Info
+	for _, wl := range []*kueue.Workload{preemptorCPU, preemptorMemory} {
+		info := workload.NewInfo(wl)
+		info.ClusterQueue = kueue.ClusterQueueReference(cq.Name)
+		heads = append(heads, *info)
+	}
+	// Both preemptors are nominated against the same snapshot of the
+	// preemption budget, which only allows one preemption.
+	entries, _ := scheduler.nominate(ctx, heads, snapshot)
+	for i := range entries {
+		if len(entries[i].preemptionTargets) != 1 {
+			t.Fatalf("Nominated %s with %d preemption targets, want 1", entries[i].Obj.Name, len(entries[i].preemptionTargets))
+		}
+	}
+	preemptedWorkloads := make(preemption.PreemptedWorkloads)
+	skippedPreemptions := make(map[kueue.ClusterQueueReference]int)
+	for i := range entries {
+		scheduler.processEntry(ctx, &entries[i], snapshot, preemptedWorkloads, skippedPreemptions)
+	}
+
+	var workloads kueue.WorkloadList
+	if err := cl.List(ctx, &workloads); err != nil {
+		t.Fatalf("Listing the workloads: %v", err)
+	}
+	evicted := 0
+	for _, wl := range workloads.Items {
+		if workloadevict.IsEvicted(&wl) {
+			evicted++
+		}
+	}
+	if evicted != 1 {
+		t.Errorf("Unexpected number of preempted workloads, want=1, got=%d", evicted)
+	}
+	if got := skippedPreemptions[kueue.ClusterQueueReference(cq.Name)]; got != 1 {
+		t.Errorf("Unexpected number of skipped preemptions, want=1, got=%d", got)
+	}
+}
+
 type workloadUpdateWatcherRecorder struct {
 	oldWl *kueue.Workload
 	newWl *kueue.Workload
@@ -0,0 +1,52 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/kueue/pkg/util/routine"
+)
+
+// WithSimulationClock sets the clock of a scheduler driven by an offline
+// simulation, which advances time on its own instead of waiting for it.
+func WithSimulationClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// ScheduleOnce runs a single scheduling cycle and waits until the admissions
+// started by the cycle are written through the client.
+// It is meant for offline simulations, which drive the scheduler cycle by
+// cycle instead of calling Start. Like Start, it blocks while the queues are
+// empty, so callers should check the queues for heads first.
+func (s *Scheduler) ScheduleOnce(ctx context.Context) {
+	var wg sync.WaitGroup
+	wrapper := s.admissionRoutineWrapper
+	s.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+	defer s.setAdmissionRoutineWrapper(wrapper)
+
+	s.schedule(ctx)
+	wg.Wait()
+}
@@ -0,0 +1,59 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// clientBuilder registers the field indexes of Kueue on the in-memory client.
+type clientBuilder struct {
+	*fake.ClientBuilder
+}
+
+func (b *clientBuilder) IndexField(_ context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	b.ClientBuilder = b.WithIndex(obj, field, extractValue)
+	return nil
+}
+
+// mergedApplyPatch sends a server-side apply patch as a strategic merge
+// patch, since the in-memory client can't apply Kueue objects without their
+// OpenAPI schema. Field ownership is not tracked, which doesn't matter with
+// the scheduler as the only writer.
+type mergedApplyPatch struct {
+	client.Patch
+}
+
+func (*mergedApplyPatch) Type() types.PatchType {
+	return types.StrategicMergePatchType
+}
+
+func subResourcePatch(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if patch.Type() == types.ApplyPatchType {
+		patch = &mergedApplyPatch{Patch: patch}
+		// ForceOwnership is only valid for apply patches.
+		opts = slices.DeleteFunc(opts, func(opt client.SubResourcePatchOption) bool {
+			return opt == client.ForceOwnership
+		})
+	}
+	return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+}
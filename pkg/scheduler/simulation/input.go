@@ -0,0 +1,131 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+var (
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kueue.AddToScheme(scheme))
+}
+
+// Input holds the queue configuration and the trace of Workloads to replay.
+type Input struct {
+	// Namespaces provide the labels matched by the namespaceSelector of the
+	// ClusterQueues. Namespaces referenced by LocalQueues or Workloads that are
+	// not listed are created without labels.
+	Namespaces      []*corev1.Namespace
+	ResourceFlavors []*kueue.ResourceFlavor
+	Cohorts         []*kueue.Cohort
+	ClusterQueues   []*kueue.ClusterQueue
+	LocalQueues     []*kueue.LocalQueue
+	// Workloads is the trace to replay. Each Workload arrives at its
+	// creationTimestamp and, once admitted, runs for its
+	// maximumExecutionTimeSeconds, or until the end of the simulation if unset.
+	Workloads []*kueue.Workload
+}
+
+// Load decodes the YAML or JSON documents from r and adds the objects to the
+// input. Lists, such as the output of "kubectl get -o yaml", are flattened.
+func (in *Input) Load(r io.Reader) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if len(raw.Raw) == 0 {
+			continue
+		}
+		if err := in.add(raw.Raw); err != nil {
+			return err
+		}
+	}
+}
+
+func (in *Input) add(data []byte) error {
+	obj, gvk, err := codecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return err
+	}
+	if list, ok := obj.(*corev1.List); ok {
+		for _, item := range list.Items {
+			if err := in.add(item.Raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if apimeta.IsListType(obj) {
+		items, err := apimeta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := in.addObject(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := in.addObject(obj); err != nil {
+		return fmt.Errorf("%s: %w", gvk.Kind, err)
+	}
+	return nil
+}
+
+func (in *Input) addObject(obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *corev1.Namespace:
+		in.Namespaces = append(in.Namespaces, o)
+	case *kueue.ResourceFlavor:
+		in.ResourceFlavors = append(in.ResourceFlavors, o)
+	case *kueue.Cohort:
+		in.Cohorts = append(in.Cohorts, o)
+	case *kueue.ClusterQueue:
+		in.ClusterQueues = append(in.ClusterQueues, o)
+	case *kueue.LocalQueue:
+		in.LocalQueues = append(in.LocalQueues, o)
+	case *kueue.Workload:
+		in.Workloads = append(in.Workloads, o)
+	default:
+		return fmt.Errorf("unsupported object type %T", obj)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// Workload states reported at the end of a simulation.
+const (
+	WorkloadPending  = "Pending"
+	WorkloadAdmitted = "Admitted"
+	WorkloadFinished = "Finished"
+)
+
+// Result is the outcome of a simulation. All the times are offsets from
+// Start, the arrival of the first Workload.
+type Result struct {
+	Start    metav1.Time     `json:"start"`
+	Duration metav1.Duration `json:"duration"`
+	// Cycles is the number of scheduling cycles run.
+	Cycles        int64                `json:"cycles"`
+	Workloads     []WorkloadResult     `json:"workloads"`
+	Preemptions   []PreemptionResult   `json:"preemptions,omitempty"`
+	ClusterQueues []ClusterQueueResult `json:"clusterQueues"`
+}
+
+// WorkloadResult describes how a Workload of the trace was scheduled.
+type WorkloadResult struct {
+	Namespace  string               `json:"namespace"`
+	Name       string               `json:"name"`
+	LocalQueue kueue.LocalQueueName `json:"localQueue"`
+	Priority   int32                `json:"priority"`
+	State      string               `json:"state"`
+	Arrival    metav1.Duration      `json:"arrival"`
+	// ClusterQueue is the ClusterQueue of the last admission.
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue,omitempty"`
+	// FirstAdmission is the time of the first admission, if any.
+	FirstAdmission *metav1.Duration `json:"firstAdmission,omitempty"`
+	// WaitTime is the time spent pending before the first admission.
+	WaitTime *metav1.Duration `json:"waitTime,omitempty"`
+	// Finished is the time the Workload finished, if it did.
+	Finished *metav1.Duration `json:"finished,omitempty"`
+	// Admissions is the number of times the Workload was admitted.
+	Admissions int32 `json:"admissions"`
+	// Preemptions is the number of times the Workload was preempted.
+	Preemptions int32 `json:"preemptions"`
+}
+
+// PreemptionResult describes the eviction of a Workload by preemption.
+type PreemptionResult struct {
+	Time         metav1.Duration             `json:"time"`
+	Namespace    string                      `json:"namespace"`
+	Name         string                      `json:"name"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	Reason       string                      `json:"reason"`
+}
+
+// ClusterQueueResult describes the usage of a ClusterQueue over the simulation.
+type ClusterQueueResult struct {
+	Name      kueue.ClusterQueueReference `json:"name"`
+	Resources []ResourceUtilization       `json:"resources"`
+}
+
+// ResourceUtilization describes the usage of a resource of a flavor.
+type ResourceUtilization struct {
+	Flavor       kueue.ResourceFlavorReference `json:"flavor"`
+	Resource     corev1.ResourceName           `json:"resource"`
+	NominalQuota resource.Quantity             `json:"nominalQuota"`
+	// AverageUsage is the usage averaged over the duration of the simulation.
+	AverageUsage resource.Quantity `json:"averageUsage"`
+	PeakUsage    resource.Quantity `json:"peakUsage"`
+	// Utilization is the average usage relative to the nominal quota,
+	// as a percentage. It can exceed 100 when the ClusterQueue borrows.
+	Utilization float64 `json:"utilization"`
+}
+
+func newDuration(d time.Duration) *metav1.Duration {
+	return &metav1.Duration{Duration: d}
+}
@@ -0,0 +1,580 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulation replays a trace of Workloads against a queue
+// configuration, running the Kueue scheduler offline with a fake clock and
+// an in-memory client instead of an API server.
+package simulation
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	testingclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
+	workloadfinish "sigs.k8s.io/kueue/pkg/workload/finish"
+)
+
+// maxCyclesPerStep bounds the scheduling cycles run at a single point in
+// time, in case some Workloads keep being requeued without being admitted.
+const maxCyclesPerStep = 1000
+
+// Options configures a simulation.
+type Options struct {
+	// FairSharing enables Fair Sharing when not nil.
+	FairSharing *config.FairSharing
+}
+
+type runningWorkload struct {
+	admission *kueue.Admission
+	// end is the time the Workload finishes, nil if it runs until the end
+	// of the simulation.
+	end *time.Time
+}
+
+type usageStats struct {
+	// total is the usage integrated over time, in units times seconds.
+	total float64
+	peak  int64
+}
+
+type simulation struct {
+	clock     *testingclock.FakeClock
+	start     time.Time
+	client    client.Client
+	cache     *schdcache.Cache
+	queues    *qcache.Manager
+	requeue   func(context.Context) int
+	scheduler *scheduler.Scheduler
+
+	// arrivals holds the Workloads yet to arrive, ordered by arrival.
+	arrivals      []*kueue.Workload
+	clusterQueues []*kueue.ClusterQueue
+	order         []workload.Reference
+	workloads     map[workload.Reference]*WorkloadResult
+	running       map[workload.Reference]*runningWorkload
+	preemptions   []PreemptionResult
+	usage         map[kueue.ClusterQueueReference]map[resources.FlavorResource]*usageStats
+	cycles        int64
+}
+
+// Run replays the Workloads of the input against its queue configuration.
+//
+// AdmissionChecks are not simulated: the ClusterQueues are loaded without
+// them, so Workloads are admitted as soon as quota is reserved. Preempted
+// Workloads are requeued right away and run again from the beginning.
+func Run(ctx context.Context, in *Input, opts Options) (*Result, error) {
+	s, err := newSimulation(ctx, in, opts)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		now := s.clock.Now()
+		if err := s.arrive(ctx, now); err != nil {
+			return nil, err
+		}
+		if err := s.finish(ctx, now); err != nil {
+			return nil, err
+		}
+		if err := s.settle(ctx); err != nil {
+			return nil, err
+		}
+		current := s.currentUsage()
+		s.recordPeaks(current)
+		next, ok := s.nextEvent()
+		if !ok {
+			break
+		}
+		s.accumulate(current, next.Sub(now))
+		s.clock.SetTime(next)
+	}
+	return s.result(), nil
+}
+
+func newSimulation(ctx context.Context, in *Input, opts Options) (*simulation, error) {
+	log := ctrl.LoggerFrom(ctx)
+	s := &simulation{
+		start:     arrivalStart(in.Workloads),
+		workloads: make(map[workload.Reference]*WorkloadResult, len(in.Workloads)),
+		running:   make(map[workload.Reference]*runningWorkload),
+		usage:     make(map[kueue.ClusterQueueReference]map[resources.FlavorResource]*usageStats),
+	}
+	s.clock = testingclock.NewFakeClock(s.start)
+	builder := &clientBuilder{ClientBuilder: fake.NewClientBuilder().WithScheme(scheme)}
+	if err := indexer.Setup(ctx, builder); err != nil {
+		return nil, err
+	}
+	s.client = builder.
+		WithStatusSubresource(&kueue.Workload{}, &kueue.ClusterQueue{}, &kueue.LocalQueue{}).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: subResourcePatch}).
+		Build()
+
+	fairSharing := fairsharing.Enabled(opts.FairSharing)
+	s.cache = schdcache.New(s.client, schdcache.WithClock(s.clock), schdcache.WithFairSharing(fairSharing))
+	preemptionExpectations := expectations.New()
+	s.queues, s.requeue = qcache.NewManagerForSimulation(s.client, s.cache,
+		qcache.WithClock(s.clock), qcache.WithPreemptionExpectations(preemptionExpectations))
+	s.scheduler = scheduler.New(s.queues, s.cache, s.client, &events.FakeRecorder{},
+		scheduler.WithSimulationClock(s.clock),
+		scheduler.WithPreemptionExpectations(preemptionExpectations),
+		scheduler.WithFairSharing(opts.FairSharing))
+
+	if err := s.createNamespaces(ctx, in); err != nil {
+		return nil, err
+	}
+	for _, rf := range in.ResourceFlavors {
+		rf = rf.DeepCopy()
+		if err := s.create(ctx, rf); err != nil {
+			return nil, err
+		}
+		s.cache.AddOrUpdateResourceFlavor(log, rf)
+	}
+	for _, cohort := range in.Cohorts {
+		cohort = cohort.DeepCopy()
+		if err := s.create(ctx, cohort); err != nil {
+			return nil, err
+		}
+		if err := s.cache.AddOrUpdateCohort(cohort); err != nil {
+			return nil, fmt.Errorf("adding Cohort %q: %w", cohort.Name, err)
+		}
+		s.queues.AddOrUpdateCohort(ctx, cohort)
+	}
+	for _, cq := range in.ClusterQueues {
+		cq = cq.DeepCopy()
+		cq.Spec.AdmissionChecksStrategy = nil
+		if err := s.create(ctx, cq); err != nil {
+			return nil, err
+		}
+		if err := s.cache.AddClusterQueue(ctx, cq); err != nil {
+			return nil, fmt.Errorf("adding ClusterQueue %q: %w", cq.Name, err)
+		}
+		if err := s.queues.AddClusterQueue(ctx, cq); err != nil {
+			return nil, fmt.Errorf("adding ClusterQueue %q: %w", cq.Name, err)
+		}
+		s.clusterQueues = append(s.clusterQueues, cq)
+	}
+	for _, lq := range in.LocalQueues {
+		lq = lq.DeepCopy()
+		if err := s.create(ctx, lq); err != nil {
+			return nil, err
+		}
+		if err := s.cache.AddLocalQueue(lq); err != nil {
+			return nil, fmt.Errorf("adding LocalQueue %s/%s: %w", lq.Namespace, lq.Name, err)
+		}
+		if err := s.queues.AddLocalQueue(ctx, lq); err != nil {
+			return nil, fmt.Errorf("adding LocalQueue %s/%s: %w", lq.Namespace, lq.Name, err)
+		}
+	}
+
+	for _, wl := range in.Workloads {
+		if wl.Name == "" {
+			return nil, fmt.Errorf("workload in namespace %q has no name", wl.Namespace)
+		}
+		wl = wl.DeepCopy()
+		wl.ResourceVersion = ""
+		wl.ManagedFields = nil
+		wl.Status = kueue.WorkloadStatus{}
+		if wl.UID == "" {
+			wl.UID = types.UID(wl.Namespace + "/" + wl.Name)
+		}
+		if wl.CreationTimestamp.IsZero() {
+			wl.CreationTimestamp = metav1.NewTime(s.start)
+		}
+		s.arrivals = append(s.arrivals, wl)
+	}
+	slices.SortStableFunc(s.arrivals, func(a, b *kueue.Workload) int {
+		return cmp.Or(
+			a.CreationTimestamp.Compare(b.CreationTimestamp.Time),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return s, nil
+}
+
+// arrivalStart returns the arrival time of the first Workload.
+func arrivalStart(wls []*kueue.Workload) time.Time {
+	var start time.Time
+	for _, wl := range wls {
+		if ts := wl.CreationTimestamp.Time; !ts.IsZero() && (start.IsZero() || ts.Before(start)) {
+			start = ts
+		}
+	}
+	if start.IsZero() {
+		start = time.Now().Truncate(time.Second)
+	}
+	return start
+}
+
+func (s *simulation) createNamespaces(ctx context.Context, in *Input) error {
+	created := sets.New[string]()
+	for _, ns := range in.Namespaces {
+		if err := s.create(ctx, ns.DeepCopy()); err != nil {
+			return err
+		}
+		created.Insert(ns.Name)
+	}
+	referenced := sets.New[string]()
+	for _, lq := range in.LocalQueues {
+		referenced.Insert(lq.Namespace)
+	}
+	for _, wl := range in.Workloads {
+		referenced.Insert(wl.Namespace)
+	}
+	for _, name := range sets.List(referenced.Difference(created)) {
+		if err := s.create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *simulation) create(ctx context.Context, obj client.Object) error {
+	obj.SetResourceVersion("")
+	if err := s.client.Create(ctx, obj); err != nil {
+		return fmt.Errorf("creating %T %q: %w", obj, client.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
+
+// arrive queues the Workloads arriving until now.
+func (s *simulation) arrive(ctx context.Context, now time.Time) error {
+	log := ctrl.LoggerFrom(ctx)
+	for len(s.arrivals) > 0 && !s.arrivals[0].CreationTimestamp.After(now) {
+		wl := s.arrivals[0]
+		s.arrivals = s.arrivals[1:]
+		if err := s.create(ctx, wl); err != nil {
+			return err
+		}
+		key := workload.Key(wl)
+		s.order = append(s.order, key)
+		s.workloads[key] = &WorkloadResult{
+			Namespace:  wl.Namespace,
+			Name:       wl.Name,
+			LocalQueue: wl.Spec.QueueName,
+			Priority:   priority.Priority(wl),
+			State:      WorkloadPending,
+			Arrival:    metav1.Duration{Duration: now.Sub(s.start)},
+		}
+		// As in the workload controller, Workloads whose LocalQueue is
+		// missing stay pending.
+		if err := s.queues.AddOrUpdateWorkload(log, wl); err != nil {
+			log.V(2).Info("Workload not queued", "workload", key, "error", err)
+		}
+	}
+	return nil
+}
+
+// finish completes the Workloads whose execution ends until now.
+func (s *simulation) finish(ctx context.Context, now time.Time) error {
+	log := ctrl.LoggerFrom(ctx)
+	var done []workload.Reference
+	for key, r := range s.running {
+		if r.end != nil && !r.end.After(now) {
+			done = append(done, key)
+		}
+	}
+	slices.Sort(done)
+	for _, key := range done {
+		wl, err := s.getWorkload(ctx, key)
+		if err != nil {
+			return err
+		}
+		apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+			Type:               kueue.WorkloadFinished,
+			Status:             metav1.ConditionTrue,
+			Reason:             kueue.WorkloadFinishedReasonSucceeded,
+			Message:            "Reached the end of its execution time",
+			LastTransitionTime: metav1.NewTime(now),
+			ObservedGeneration: wl.Generation,
+		})
+		if err := s.client.Status().Update(ctx, wl); err != nil {
+			return fmt.Errorf("finishing workload %q: %w", key, err)
+		}
+		s.queues.DeleteWorkload(log, key)
+		s.queues.QueueAssociatedInadmissibleWorkloadsAfter(ctx, key, func() {
+			_ = s.cache.DeleteWorkload(log, key)
+		})
+		delete(s.running, key)
+		r := s.workloads[key]
+		r.State = WorkloadFinished
+		r.Finished = newDuration(now.Sub(s.start))
+	}
+	return nil
+}
+
+// settle runs scheduling cycles until no Workload is left to consider.
+func (s *simulation) settle(ctx context.Context) error {
+	for range maxCyclesPerStep {
+		s.requeue(ctx)
+		if !s.queues.HasHeads() {
+			return nil
+		}
+		s.scheduler.ScheduleOnce(ctx)
+		s.cycles++
+		if err := s.observe(ctx); err != nil {
+			return err
+		}
+	}
+	ctrl.LoggerFrom(ctx).Info("Scheduling did not settle, moving on", "time", s.clock.Now(), "cycles", maxCyclesPerStep)
+	return nil
+}
+
+// observe plays the part of the workload and job controllers for the
+// admissions and preemptions written by the last scheduling cycle.
+func (s *simulation) observe(ctx context.Context) error {
+	var wls kueue.WorkloadList
+	if err := s.client.List(ctx, &wls); err != nil {
+		return err
+	}
+	for i := range wls.Items {
+		wl := &wls.Items[i]
+		key := workload.Key(wl)
+		_, running := s.running[key]
+		switch {
+		case !workload.HasQuotaReservation(wl) || workloadfinish.IsFinished(wl):
+		case running && workloadevict.IsEvicted(wl):
+			if err := s.evict(ctx, wl); err != nil {
+				return err
+			}
+		case !running:
+			s.admit(ctx, wl)
+		}
+	}
+	return nil
+}
+
+func (s *simulation) admit(ctx context.Context, wl *kueue.Workload) {
+	log := ctrl.LoggerFrom(ctx)
+	now := s.clock.Now()
+	key := workload.Key(wl)
+	s.queues.DeleteWorkload(log, key)
+	s.cache.AddOrUpdateWorkload(log, wl.DeepCopy())
+
+	run := &runningWorkload{admission: wl.Status.Admission.DeepCopy()}
+	if secs := wl.Spec.MaximumExecutionTimeSeconds; secs != nil {
+		end := now.Add(time.Duration(*secs) * time.Second)
+		run.end = &end
+	}
+	s.running[key] = run
+
+	r := s.workloads[key]
+	r.State = WorkloadAdmitted
+	r.ClusterQueue = wl.Status.Admission.ClusterQueue
+	r.Admissions++
+	if r.FirstAdmission == nil {
+		r.FirstAdmission = newDuration(now.Sub(s.start))
+		r.WaitTime = newDuration(r.FirstAdmission.Duration - r.Arrival.Duration)
+	}
+}
+
+func (s *simulation) evict(ctx context.Context, wl *kueue.Workload) error {
+	log := ctrl.LoggerFrom(ctx)
+	now := s.clock.Now()
+	key := workload.Key(wl)
+	evicted := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted)
+	r := s.workloads[key]
+	if evicted.Reason == kueue.WorkloadEvictedByPreemption {
+		reason := evicted.Reason
+		if preempted := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreempted); preempted != nil {
+			reason = preempted.Reason
+		}
+		s.preemptions = append(s.preemptions, PreemptionResult{
+			Time:         metav1.Duration{Duration: now.Sub(s.start)},
+			Namespace:    wl.Namespace,
+			Name:         wl.Name,
+			ClusterQueue: wl.Status.Admission.ClusterQueue,
+			Reason:       reason,
+		})
+		r.Preemptions++
+	}
+
+	// As in the job reconciler, once the job is stopped the quota
+	// reservation is released and the Workload is requeued.
+	workload.SetRequeuedCondition(wl, evicted.Reason, evicted.Message, true)
+	workload.UnsetQuotaReservationWithCondition(wl,
+		workload.UnadmittedWorkloadReasonWithFallback(
+			kueue.WorkloadQuotaReservedReasonPendingEvaluation,
+			kueue.WorkloadPending, //nolint:staticcheck // SA1019: fallback
+		),
+		evicted.Message, now)
+	if err := s.client.Status().Update(ctx, wl); err != nil {
+		return fmt.Errorf("releasing the quota of workload %q: %w", key, err)
+	}
+	s.queues.QueueAssociatedInadmissibleWorkloadsAfter(ctx, key, func() {
+		_ = s.cache.DeleteWorkload(log, key)
+		if err := s.queues.AddOrUpdateWorkloadWithoutLock(log, wl); err != nil {
+			log.V(2).Info("Workload not requeued", "workload", key, "error", err)
+		}
+	})
+	delete(s.running, key)
+	r.State = WorkloadPending
+	return nil
+}
+
+func (s *simulation) getWorkload(ctx context.Context, key workload.Reference) (*kueue.Workload, error) {
+	r := s.workloads[key]
+	wl := &kueue.Workload{}
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: r.Name}, wl); err != nil {
+		return nil, fmt.Errorf("getting workload %q: %w", key, err)
+	}
+	return wl, nil
+}
+
+// nextEvent returns the time of the next arrival or completion.
+func (s *simulation) nextEvent() (time.Time, bool) {
+	var next time.Time
+	if len(s.arrivals) > 0 {
+		next = s.arrivals[0].CreationTimestamp.Time
+	}
+	for _, r := range s.running {
+		if r.end != nil && (next.IsZero() || r.end.Before(next)) {
+			next = *r.end
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// currentUsage returns the usage of the running Workloads per ClusterQueue.
+func (s *simulation) currentUsage() map[kueue.ClusterQueueReference]map[resources.FlavorResource]int64 {
+	usage := make(map[kueue.ClusterQueueReference]map[resources.FlavorResource]int64)
+	for _, r := range s.running {
+		cqUsage := usage[r.admission.ClusterQueue]
+		if cqUsage == nil {
+			cqUsage = make(map[resources.FlavorResource]int64)
+			usage[r.admission.ClusterQueue] = cqUsage
+		}
+		for _, psa := range r.admission.PodSetAssignments {
+			for res, flavor := range psa.Flavors {
+				q, found := psa.ResourceUsage[res]
+				if !found {
+					continue
+				}
+				cqUsage[resources.FlavorResource{Flavor: flavor, Resource: res}] += resources.ResourceValue(res, q)
+			}
+		}
+	}
+	return usage
+}
+
+func (s *simulation) stats(cq kueue.ClusterQueueReference, fr resources.FlavorResource) *usageStats {
+	cqStats := s.usage[cq]
+	if cqStats == nil {
+		cqStats = make(map[resources.FlavorResource]*usageStats)
+		s.usage[cq] = cqStats
+	}
+	st := cqStats[fr]
+	if st == nil {
+		st = &usageStats{}
+		cqStats[fr] = st
+	}
+	return st
+}
+
+func (s *simulation) recordPeaks(usage map[kueue.ClusterQueueReference]map[resources.FlavorResource]int64) {
+	for cq, cqUsage := range usage {
+		for fr, v := range cqUsage {
+			st := s.stats(cq, fr)
+			st.peak = max(st.peak, v)
+		}
+	}
+}
+
+func (s *simulation) accumulate(usage map[kueue.ClusterQueueReference]map[resources.FlavorResource]int64, d time.Duration) {
+	for cq, cqUsage := range usage {
+		for fr, v := range cqUsage {
+			s.stats(cq, fr).total += float64(v) * d.Seconds()
+		}
+	}
+}
+
+func (s *simulation) result() *Result {
+	duration := s.clock.Now().Sub(s.start)
+	res := &Result{
+		Start:    metav1.NewTime(s.start),
+		Duration: metav1.Duration{Duration: duration},
+		Cycles:   s.cycles,
+	}
+	for _, key := range s.order {
+		res.Workloads = append(res.Workloads, *s.workloads[key])
+	}
+	res.Preemptions = s.preemptions
+	for _, cq := range s.clusterQueues {
+		cqRes := ClusterQueueResult{Name: kueue.ClusterQueueReference(cq.Name)}
+		for _, rg := range cq.Spec.ResourceGroups {
+			for _, fq := range rg.Flavors {
+				for _, rq := range fq.Resources {
+					fr := resources.FlavorResource{Flavor: fq.Name, Resource: rq.Name}
+					st := s.stats(cqRes.Name, fr)
+					average := float64(st.peak)
+					if duration > 0 {
+						average = st.total / duration.Seconds()
+					}
+					u := ResourceUtilization{
+						Flavor:       fq.Name,
+						Resource:     rq.Name,
+						NominalQuota: rq.NominalQuota,
+						AverageUsage: quantity(rq.Name, int64(average), rq.NominalQuota.Format),
+						PeakUsage:    quantity(rq.Name, st.peak, rq.NominalQuota.Format),
+					}
+					if nominal := resources.ResourceValue(rq.Name, rq.NominalQuota); nominal > 0 {
+						u.Utilization = average * 100 / float64(nominal)
+					}
+					cqRes.Resources = append(cqRes.Resources, u)
+				}
+			}
+		}
+		res.ClusterQueues = append(res.ClusterQueues, cqRes)
+	}
+	slices.SortFunc(res.ClusterQueues, func(a, b ClusterQueueResult) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// quantity is the inverse of resources.ResourceValue.
+func quantity(name corev1.ResourceName, v int64, format resource.Format) resource.Quantity {
+	if name == corev1.ResourceCPU {
+		return *resource.NewMilliQuantity(v, format)
+	}
+	return *resource.NewQuantity(v, format)
+}
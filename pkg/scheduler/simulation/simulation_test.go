@@ -0,0 +1,359 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestRun(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	flavor := utiltestingapi.MakeResourceFlavor("default").Obj()
+	minutes := func(m int) metav1.Duration {
+		return metav1.Duration{Duration: time.Duration(m) * time.Minute}
+	}
+
+	cases := map[string]struct {
+		in   Input
+		want Result
+	}{
+		"preemption within the ClusterQueue": {
+			in: Input{
+				ResourceFlavors: []*kueue.ResourceFlavor{flavor},
+				ClusterQueues: []*kueue.ClusterQueue{
+					utiltestingapi.MakeClusterQueue("cq").
+						ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+						Preemption(kueue.ClusterQueuePreemption{WithinClusterQueue: kueue.PreemptionPolicyLowerPriority}).
+						Obj(),
+				},
+				LocalQueues: []*kueue.LocalQueue{
+					utiltestingapi.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj(),
+				},
+				Workloads: []*kueue.Workload{
+					utiltestingapi.MakeWorkload("a", "default").Queue("lq").Request(corev1.ResourceCPU, "3").
+						Creation(now).MaximumExecutionTimeSeconds(600).Obj(),
+					utiltestingapi.MakeWorkload("b", "default").Queue("lq").Request(corev1.ResourceCPU, "2").
+						Creation(now.Add(time.Minute)).MaximumExecutionTimeSeconds(300).Obj(),
+					utiltestingapi.MakeWorkload("c", "default").Queue("lq").Request(corev1.ResourceCPU, "3").Priority(100).
+						Creation(now.Add(2 * time.Minute)).MaximumExecutionTimeSeconds(300).Obj(),
+				},
+			},
+			want: Result{
+				Duration: minutes(22),
+				Cycles:   9,
+				Workloads: []WorkloadResult{
+					{
+						Namespace:      "default",
+						Name:           "a",
+						LocalQueue:     "lq",
+						State:          WorkloadFinished,
+						Arrival:        minutes(0),
+						ClusterQueue:   "cq",
+						FirstAdmission: ptr.To(minutes(0)),
+						WaitTime:       ptr.To(minutes(0)),
+						Finished:       ptr.To(minutes(17)),
+						Admissions:     2,
+						Preemptions:    1,
+					},
+					{
+						Namespace:      "default",
+						Name:           "b",
+						LocalQueue:     "lq",
+						State:          WorkloadFinished,
+						Arrival:        minutes(1),
+						ClusterQueue:   "cq",
+						FirstAdmission: ptr.To(minutes(17)),
+						WaitTime:       ptr.To(minutes(16)),
+						Finished:       ptr.To(minutes(22)),
+						Admissions:     1,
+					},
+					{
+						Namespace:      "default",
+						Name:           "c",
+						LocalQueue:     "lq",
+						Priority:       100,
+						State:          WorkloadFinished,
+						Arrival:        minutes(2),
+						ClusterQueue:   "cq",
+						FirstAdmission: ptr.To(minutes(2)),
+						WaitTime:       ptr.To(minutes(0)),
+						Finished:       ptr.To(minutes(7)),
+						Admissions:     1,
+					},
+				},
+				Preemptions: []PreemptionResult{
+					{
+						Time:         minutes(2),
+						Namespace:    "default",
+						Name:         "a",
+						ClusterQueue: "cq",
+						Reason:       kueue.InClusterQueueReason,
+					},
+				},
+				ClusterQueues: []ClusterQueueResult{
+					{
+						Name: "cq",
+						Resources: []ResourceUtilization{
+							{
+								Flavor:       "default",
+								Resource:     corev1.ResourceCPU,
+								NominalQuota: resource.MustParse("4"),
+								AverageUsage: resource.MustParse("2772m"),
+								PeakUsage:    resource.MustParse("3"),
+								Utilization:  69.32,
+							},
+						},
+					},
+				},
+			},
+		},
+		"borrowing from the Cohort": {
+			in: Input{
+				ResourceFlavors: []*kueue.ResourceFlavor{flavor},
+				Cohorts:         []*kueue.Cohort{utiltestingapi.MakeCohort("all").Obj()},
+				ClusterQueues: []*kueue.ClusterQueue{
+					utiltestingapi.MakeClusterQueue("cq-a").Cohort("all").
+						ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+						Obj(),
+					utiltestingapi.MakeClusterQueue("cq-b").Cohort("all").
+						ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+						Obj(),
+				},
+				LocalQueues: []*kueue.LocalQueue{
+					utiltestingapi.MakeLocalQueue("lq-a", "team-a").ClusterQueue("cq-a").Obj(),
+					utiltestingapi.MakeLocalQueue("lq-b", "team-b").ClusterQueue("cq-b").Obj(),
+				},
+				Workloads: []*kueue.Workload{
+					utiltestingapi.MakeWorkload("x", "team-a").Queue("lq-a").Request(corev1.ResourceCPU, "3").
+						Creation(now).MaximumExecutionTimeSeconds(600).Obj(),
+					utiltestingapi.MakeWorkload("y", "team-b").Queue("lq-b").Request(corev1.ResourceCPU, "2").
+						Creation(now.Add(time.Minute)).MaximumExecutionTimeSeconds(60).Obj(),
+				},
+			},
+			want: Result{
+				Duration: minutes(11),
+				Cycles:   3,
+				Workloads: []WorkloadResult{
+					{
+						Namespace:      "team-a",
+						Name:           "x",
+						LocalQueue:     "lq-a",
+						State:          WorkloadFinished,
+						Arrival:        minutes(0),
+						ClusterQueue:   "cq-a",
+						FirstAdmission: ptr.To(minutes(0)),
+						WaitTime:       ptr.To(minutes(0)),
+						Finished:       ptr.To(minutes(10)),
+						Admissions:     1,
+					},
+					{
+						Namespace:      "team-b",
+						Name:           "y",
+						LocalQueue:     "lq-b",
+						State:          WorkloadFinished,
+						Arrival:        minutes(1),
+						ClusterQueue:   "cq-b",
+						FirstAdmission: ptr.To(minutes(10)),
+						WaitTime:       ptr.To(minutes(9)),
+						Finished:       ptr.To(minutes(11)),
+						Admissions:     1,
+					},
+				},
+				ClusterQueues: []ClusterQueueResult{
+					{
+						Name: "cq-a",
+						Resources: []ResourceUtilization{
+							{
+								Flavor:       "default",
+								Resource:     corev1.ResourceCPU,
+								NominalQuota: resource.MustParse("2"),
+								AverageUsage: resource.MustParse("2727m"),
+								PeakUsage:    resource.MustParse("3"),
+								Utilization:  136.36,
+							},
+						},
+					},
+					{
+						Name: "cq-b",
+						Resources: []ResourceUtilization{
+							{
+								Flavor:       "default",
+								Resource:     corev1.ResourceCPU,
+								NominalQuota: resource.MustParse("2"),
+								AverageUsage: resource.MustParse("181m"),
+								PeakUsage:    resource.MustParse("2"),
+								Utilization:  9.09,
+							},
+						},
+					},
+				},
+			},
+		},
+		"workload without a LocalQueue stays pending": {
+			in: Input{
+				ResourceFlavors: []*kueue.ResourceFlavor{flavor},
+				ClusterQueues: []*kueue.ClusterQueue{
+					utiltestingapi.MakeClusterQueue("cq").
+						ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+						Obj(),
+				},
+				Workloads: []*kueue.Workload{
+					utiltestingapi.MakeWorkload("a", "default").Queue("missing").Request(corev1.ResourceCPU, "1").
+						Creation(now).Obj(),
+				},
+			},
+			want: Result{
+				Workloads: []WorkloadResult{
+					{
+						Namespace:  "default",
+						Name:       "a",
+						LocalQueue: "missing",
+						State:      WorkloadPending,
+						Arrival:    minutes(0),
+					},
+				},
+				ClusterQueues: []ClusterQueueResult{
+					{
+						Name: "cq",
+						Resources: []ResourceUtilization{
+							{
+								Flavor:       "default",
+								Resource:     corev1.ResourceCPU,
+								NominalQuota: resource.MustParse("4"),
+								AverageUsage: resource.MustParse("0"),
+								PeakUsage:    resource.MustParse("0"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			got, err := Run(ctx, &tc.in, Options{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, *got,
+				cmpopts.IgnoreFields(Result{}, "Start"),
+				cmpopts.EquateEmpty(),
+				cmpopts.EquateApprox(0, 0.01),
+				cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 }),
+			); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInputLoad(t *testing.T) {
+	cases := map[string]struct {
+		data    string
+		want    Input
+		wantErr string
+	}{
+		"documents and lists": {
+			data: `apiVersion: kueue.x-k8s.io/v1beta2
+kind: ResourceFlavor
+metadata:
+  name: default
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: team-a
+- apiVersion: kueue.x-k8s.io/v1beta2
+  kind: LocalQueue
+  metadata:
+    name: lq
+    namespace: team-a
+  spec:
+    clusterQueue: cq
+---
+apiVersion: kueue.x-k8s.io/v1beta2
+kind: WorkloadList
+items:
+- apiVersion: kueue.x-k8s.io/v1beta2
+  kind: Workload
+  metadata:
+    name: a
+    namespace: team-a
+  spec:
+    queueName: lq
+`,
+			want: Input{
+				Namespaces:      []*corev1.Namespace{utiltesting.MakeNamespace("team-a")},
+				ResourceFlavors: []*kueue.ResourceFlavor{utiltestingapi.MakeResourceFlavor("default").Obj()},
+				LocalQueues:     []*kueue.LocalQueue{utiltestingapi.MakeLocalQueue("lq", "team-a").ClusterQueue("cq").Obj()},
+				Workloads: []*kueue.Workload{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "team-a"},
+						Spec:       kueue.WorkloadSpec{QueueName: "lq"},
+					},
+				},
+			},
+		},
+		"unsupported kind": {
+			data: `apiVersion: v1
+kind: Pod
+metadata:
+  name: p
+`,
+			wantErr: "Pod: unsupported object type *v1.Pod",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got Input
+			err := got.Load(strings.NewReader(tc.data))
+			if diff := cmp.Diff(tc.wantErr, errorString(err)); diff != "" {
+				t.Fatalf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if tc.wantErr != "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(metav1.TypeMeta{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected input (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -19,10 +19,13 @@ package priority
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -85,6 +88,52 @@ func EffectivePriority(log logr.Logger, w *kueue.Workload) int64 {
 	return effectivePriority
 }
 
+// Decay returns the amount by which the priority decay lowers the effective
+// priority of the workload at now, one step per window since its quota
+// reservation. Workloads without quota reservation don't decay. When the
+// PriorityDecay feature gate is disabled, 0 is returned.
+func Decay(w *kueue.Workload, decay *kueue.PriorityDecay, now time.Time) int64 {
+	if !features.Enabled(features.PriorityDecay) || decay == nil || decay.WindowSeconds <= 0 {
+		return 0
+	}
+	cond := meta.FindStatusCondition(w.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return 0
+	}
+	windows := int64(now.Sub(cond.LastTransitionTime.Time) / (time.Duration(decay.WindowSeconds) * time.Second))
+	if windows <= 0 {
+		return 0
+	}
+	amount := windows * int64(decay.Step)
+	if decay.MaxDecay != nil {
+		amount = min(amount, int64(*decay.MaxDecay))
+	}
+	return amount
+}
+
+// NextDecay returns the time until the priority decay next lowers the
+// effective priority of the workload, or zero if it won't anymore.
+func NextDecay(w *kueue.Workload, decay *kueue.PriorityDecay, now time.Time) time.Duration {
+	if !features.Enabled(features.PriorityDecay) || decay == nil || decay.WindowSeconds <= 0 {
+		return 0
+	}
+	cond := meta.FindStatusCondition(w.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return 0
+	}
+	if decay.MaxDecay != nil && Decay(w, decay, now) >= int64(*decay.MaxDecay) {
+		return 0
+	}
+	window := time.Duration(decay.WindowSeconds) * time.Second
+	return window - max(now.Sub(cond.LastTransitionTime.Time), 0)%window
+}
+
+// DecayedPriority returns the effective priority of the workload lowered by
+// the priority decay at now.
+func DecayedPriority(log logr.Logger, w *kueue.Workload, decay *kueue.PriorityDecay, now time.Time) int64 {
+	return EffectivePriority(log, w) - Decay(w, decay, now)
+}
+
 // GetPriorityFromPriorityClass returns the priority populated from
 // priority class. If not specified, the priority will be default or
 // zero if there is no default.
@@ -19,6 +19,7 @@ package priority
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -431,3 +432,73 @@ func TestEffectivePriority(t *testing.T) {
 		})
 	}
 }
+
+func TestDecay(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admittedAt := func(at time.Time) *kueue.Workload {
+		return utiltestingapi.MakeWorkload("wl", "ns").Priority(100).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), at).
+			Obj()
+	}
+	decay := &kueue.PriorityDecay{WindowSeconds: 600, Step: 10}
+
+	tests := map[string]struct {
+		disablePriorityDecay bool
+		workload             *kueue.Workload
+		decay                *kueue.PriorityDecay
+		wantDecay            int64
+		wantNextDecay        time.Duration
+		wantPriority         int64
+	}{
+		"feature disabled": {
+			disablePriorityDecay: true,
+			workload:             admittedAt(now.Add(-time.Hour)),
+			decay:                decay,
+			wantPriority:         100,
+		},
+		"no priority decay": {
+			workload:     admittedAt(now.Add(-time.Hour)),
+			wantPriority: 100,
+		},
+		"pending": {
+			workload:     utiltestingapi.MakeWorkload("wl", "ns").Priority(100).Obj(),
+			decay:        decay,
+			wantPriority: 100,
+		},
+		"within the first window": {
+			workload:      admittedAt(now.Add(-4 * time.Minute)),
+			decay:         decay,
+			wantNextDecay: 6 * time.Minute,
+			wantPriority:  100,
+		},
+		"after three windows": {
+			workload:      admittedAt(now.Add(-32 * time.Minute)),
+			decay:         decay,
+			wantDecay:     30,
+			wantNextDecay: 8 * time.Minute,
+			wantPriority:  70,
+		},
+		"capped by maxDecay": {
+			workload:     admittedAt(now.Add(-32 * time.Minute)),
+			decay:        &kueue.PriorityDecay{WindowSeconds: 600, Step: 10, MaxDecay: new(int32(25))},
+			wantDecay:    25,
+			wantPriority: 75,
+		},
+	}
+
+	for desc, tt := range tests {
+		t.Run(desc, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PriorityDecay, !tt.disablePriorityDecay)
+			_, log := utiltesting.ContextWithLog(t)
+			if got := Decay(tt.workload, tt.decay, now); got != tt.wantDecay {
+				t.Errorf("Unexpected Decay, want=%d, got=%d", tt.wantDecay, got)
+			}
+			if got := NextDecay(tt.workload, tt.decay, now); got != tt.wantNextDecay {
+				t.Errorf("Unexpected NextDecay, want=%v, got=%v", tt.wantNextDecay, got)
+			}
+			if got := DecayedPriority(log, tt.workload, tt.decay, now); got != tt.wantPriority {
+				t.Errorf("Unexpected DecayedPriority, want=%d, got=%d", tt.wantPriority, got)
+			}
+		})
+	}
+}
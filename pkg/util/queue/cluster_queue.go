@@ -15,6 +15,8 @@
 package queue
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -33,3 +35,22 @@ func AllFlavors(rgs []kueue.ResourceGroup) sets.Set[kueue.ResourceFlavorReferenc
 		sets.New[kueue.ResourceFlavorReference](),
 	)
 }
+
+const (
+	// ClusterQueuePlaceholder is replaced by the name of the ClusterQueue in
+	// the namePattern of a localQueueTemplate.
+	ClusterQueuePlaceholder = "{clusterQueue}"
+	// NamespacePlaceholder is replaced by the name of the namespace in the
+	// namePattern of a localQueueTemplate.
+	NamespacePlaceholder = "{namespace}"
+)
+
+// ProvisionedLocalQueueName returns the name of the LocalQueue provisioned
+// in the namespace from the localQueueTemplate of the ClusterQueue.
+func ProvisionedLocalQueueName(cq *kueue.ClusterQueue, namespace string) kueue.LocalQueueName {
+	pattern := ClusterQueuePlaceholder
+	if cq.Spec.LocalQueueTemplate != nil && cq.Spec.LocalQueueTemplate.NamePattern != "" {
+		pattern = cq.Spec.LocalQueueTemplate.NamePattern
+	}
+	return kueue.LocalQueueName(strings.NewReplacer(ClusterQueuePlaceholder, cq.Name, NamespacePlaceholder, namespace).Replace(pattern))
+}
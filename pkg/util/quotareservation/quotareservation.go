@@ -0,0 +1,108 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotareservation
+
+import (
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	// RampUpSteps is the number of steps in which the reserved quota is
+	// withheld from lending during the ramp-up.
+	RampUpSteps = 10
+
+	defaultRampUpSeconds = 3600
+)
+
+// Window holds the times at which a kueue.QuotaReservation changes phase.
+type Window struct {
+	RampUpStart time.Time
+	Start       time.Time
+	End         time.Time
+}
+
+// WindowOf returns the window of the kueue.QuotaReservation.
+func WindowOf(qr *kueue.QuotaReservation) Window {
+	start := qr.Spec.StartTime.Time
+	rampUp := time.Duration(ptr.Deref(qr.Spec.RampUpSeconds, defaultRampUpSeconds)) * time.Second
+	return Window{
+		RampUpStart: start.Add(-rampUp),
+		Start:       start,
+		End:         start.Add(time.Duration(qr.Spec.DurationSeconds) * time.Second),
+	}
+}
+
+// Phase returns the reason of the Active condition of the reservation at
+// the given time.
+func (w Window) Phase(now time.Time) string {
+	switch {
+	case !now.Before(w.End):
+		return kueue.QuotaReservationExpired
+	case !now.Before(w.Start):
+		return kueue.QuotaReservationStarted
+	case !now.Before(w.RampUpStart):
+		return kueue.QuotaReservationRampingUp
+	default:
+		return kueue.QuotaReservationPending
+	}
+}
+
+// Active reports whether the reservation window is ongoing at the given time.
+func (w Window) Active(now time.Time) bool {
+	return w.Phase(now) == kueue.QuotaReservationStarted
+}
+
+// WithheldSteps returns how many of the RampUpSteps steps of the reserved
+// quota are withheld from lending at the given time. The last step is
+// withheld one step before the start, so that the reserved quota is fully
+// withheld when the window starts.
+func (w Window) WithheldSteps(now time.Time) int {
+	switch w.Phase(now) {
+	case kueue.QuotaReservationStarted:
+		return RampUpSteps
+	case kueue.QuotaReservationRampingUp:
+		elapsed := now.Sub(w.RampUpStart)
+		return min(RampUpSteps, int(elapsed*RampUpSteps/w.Start.Sub(w.RampUpStart))+1)
+	default:
+		return 0
+	}
+}
+
+// NextBoundary returns the earliest time after now at which the phase or
+// the withheld steps change. It returns the zero time once the reservation
+// expired.
+func (w Window) NextBoundary(now time.Time) time.Time {
+	switch w.Phase(now) {
+	case kueue.QuotaReservationPending:
+		return w.RampUpStart
+	case kueue.QuotaReservationRampingUp:
+		steps := w.WithheldSteps(now)
+		if steps == RampUpSteps {
+			return w.Start
+		}
+		rampUp := w.Start.Sub(w.RampUpStart)
+		return w.RampUpStart.Add(rampUp * time.Duration(steps) / RampUpSteps)
+	case kueue.QuotaReservationStarted:
+		return w.End
+	default:
+		return time.Time{}
+	}
+}
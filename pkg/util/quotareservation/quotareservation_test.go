@@ -0,0 +1,114 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotareservation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func TestWindow(t *testing.T) {
+	start := time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC)
+	qr := &kueue.QuotaReservation{
+		Spec: kueue.QuotaReservationSpec{
+			StartTime:       metav1.NewTime(start),
+			DurationSeconds: 8 * 3600,
+			RampUpSeconds:   ptr.To[int64](1000),
+		},
+	}
+	window := WindowOf(qr)
+
+	cases := map[string]struct {
+		now              time.Time
+		wantPhase        string
+		wantSteps        int
+		wantNextBoundary time.Time
+	}{
+		"before the ramp-up": {
+			now:              start.Add(-time.Hour),
+			wantPhase:        kueue.QuotaReservationPending,
+			wantNextBoundary: start.Add(-1000 * time.Second),
+		},
+		"ramp-up starts": {
+			now:              start.Add(-1000 * time.Second),
+			wantPhase:        kueue.QuotaReservationRampingUp,
+			wantSteps:        1,
+			wantNextBoundary: start.Add(-900 * time.Second),
+		},
+		"middle of the ramp-up": {
+			now:              start.Add(-450 * time.Second),
+			wantPhase:        kueue.QuotaReservationRampingUp,
+			wantSteps:        6,
+			wantNextBoundary: start.Add(-400 * time.Second),
+		},
+		"last step of the ramp-up": {
+			now:              start.Add(-50 * time.Second),
+			wantPhase:        kueue.QuotaReservationRampingUp,
+			wantSteps:        RampUpSteps,
+			wantNextBoundary: start,
+		},
+		"window starts": {
+			now:              start,
+			wantPhase:        kueue.QuotaReservationStarted,
+			wantSteps:        RampUpSteps,
+			wantNextBoundary: start.Add(8 * time.Hour),
+		},
+		"window ends": {
+			now:       start.Add(8 * time.Hour),
+			wantPhase: kueue.QuotaReservationExpired,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := window.Phase(tc.now); got != tc.wantPhase {
+				t.Errorf("Unexpected phase: got %q, want %q", got, tc.wantPhase)
+			}
+			if got := window.WithheldSteps(tc.now); got != tc.wantSteps {
+				t.Errorf("Unexpected withheld steps: got %d, want %d", got, tc.wantSteps)
+			}
+			if got := window.NextBoundary(tc.now); !got.Equal(tc.wantNextBoundary) {
+				t.Errorf("Unexpected next boundary: got %v, want %v", got, tc.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestWindowWithoutRampUp(t *testing.T) {
+	start := time.Date(2026, time.October, 20, 9, 0, 0, 0, time.UTC)
+	window := WindowOf(&kueue.QuotaReservation{
+		Spec: kueue.QuotaReservationSpec{
+			StartTime:       metav1.NewTime(start),
+			DurationSeconds: 3600,
+			RampUpSeconds:   ptr.To[int64](0),
+		},
+	})
+	now := start.Add(-time.Second)
+	if got := window.Phase(now); got != kueue.QuotaReservationPending {
+		t.Errorf("Unexpected phase: got %q, want %q", got, kueue.QuotaReservationPending)
+	}
+	if got := window.WithheldSteps(now); got != 0 {
+		t.Errorf("Unexpected withheld steps: got %d, want 0", got)
+	}
+	if got := window.NextBoundary(now); !got.Equal(start) {
+		t.Errorf("Unexpected next boundary: got %v, want %v", got, start)
+	}
+}
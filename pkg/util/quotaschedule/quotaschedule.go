@@ -0,0 +1,87 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotaschedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+var (
+	errTimeZoneInSchedule  = errors.New("the time zone must be set with timeZone, not in the schedule")
+	errUnsupportedSchedule = errors.New("only schedules with fixed activation times are supported")
+)
+
+// Window is a parsed kueue.QuotaSchedule.
+type Window struct {
+	schedule *cron.SpecSchedule
+	duration time.Duration
+}
+
+// Parse parses the schedule and time zone of a kueue.QuotaSchedule.
+func Parse(s *kueue.QuotaSchedule) (*Window, error) {
+	if strings.Contains(s.Schedule, "TZ=") {
+		return nil, errTimeZoneInSchedule
+	}
+	parsed, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	// Descriptors such as "@every 1h" are relative to the time they are
+	// evaluated at, so they don't define stable window boundaries.
+	spec, ok := parsed.(*cron.SpecSchedule)
+	if !ok {
+		return nil, errUnsupportedSchedule
+	}
+	if s.TimeZone != nil {
+		loc, err := time.LoadLocation(*s.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", *s.TimeZone, err)
+		}
+		spec.Location = loc
+	}
+	return &Window{
+		schedule: spec,
+		duration: time.Duration(s.DurationSeconds) * time.Second,
+	}, nil
+}
+
+// Active reports whether the window is active at the given time.
+func (w *Window) Active(now time.Time) bool {
+	// A window covering now must have started after now-duration.
+	start := w.schedule.Next(now.Add(-w.duration))
+	return !start.IsZero() && !start.After(now)
+}
+
+// NextBoundary returns the earliest time after now at which the window
+// starts or ends. It returns the zero time if the schedule never matches.
+func (w *Window) NextBoundary(now time.Time) time.Time {
+	next := w.schedule.Next(now)
+	if w.Active(now) {
+		end := w.schedule.Next(now.Add(-w.duration)).Add(w.duration)
+		if next.IsZero() || end.Before(next) {
+			return end
+		}
+	}
+	return next
+}
@@ -0,0 +1,134 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotaschedule
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		schedule kueue.QuotaSchedule
+		wantErr  bool
+	}{
+		"valid schedule": {
+			schedule: kueue.QuotaSchedule{Schedule: "0 20 * * 1-5", DurationSeconds: 3600},
+		},
+		"valid schedule with time zone": {
+			schedule: kueue.QuotaSchedule{Schedule: "0 20 * * *", TimeZone: ptr.To("Europe/Warsaw"), DurationSeconds: 3600},
+		},
+		"descriptor": {
+			schedule: kueue.QuotaSchedule{Schedule: "@daily", DurationSeconds: 3600},
+		},
+		"invalid schedule": {
+			schedule: kueue.QuotaSchedule{Schedule: "0 25 * * *", DurationSeconds: 3600},
+			wantErr:  true,
+		},
+		"relative descriptor": {
+			schedule: kueue.QuotaSchedule{Schedule: "@every 1h", DurationSeconds: 3600},
+			wantErr:  true,
+		},
+		"time zone in the schedule": {
+			schedule: kueue.QuotaSchedule{Schedule: "CRON_TZ=Europe/Warsaw 0 20 * * *", DurationSeconds: 3600},
+			wantErr:  true,
+		},
+		"unknown time zone": {
+			schedule: kueue.QuotaSchedule{Schedule: "0 20 * * *", TimeZone: ptr.To("Mars/Olympus"), DurationSeconds: 3600},
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(&tc.schedule)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Unexpected error: %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	// Every day from 20:00 to 08:00 on the next day.
+	nightly := kueue.QuotaSchedule{Schedule: "0 20 * * *", DurationSeconds: 12 * 3600}
+	day := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		schedule         kueue.QuotaSchedule
+		now              time.Time
+		wantActive       bool
+		wantNextBoundary time.Time
+	}{
+		"before the window": {
+			schedule:         nightly,
+			now:              day.Add(12 * time.Hour),
+			wantNextBoundary: day.Add(20 * time.Hour),
+		},
+		"at the start of the window": {
+			schedule:         nightly,
+			now:              day.Add(20 * time.Hour),
+			wantActive:       true,
+			wantNextBoundary: day.Add(32 * time.Hour),
+		},
+		"inside the window, after midnight": {
+			schedule:         nightly,
+			now:              day.Add(3 * time.Hour),
+			wantActive:       true,
+			wantNextBoundary: day.Add(8 * time.Hour),
+		},
+		"at the end of the window": {
+			schedule:         nightly,
+			now:              day.Add(8 * time.Hour),
+			wantNextBoundary: day.Add(20 * time.Hour),
+		},
+		"in a time zone": {
+			schedule: kueue.QuotaSchedule{
+				Schedule:        "0 20 * * *",
+				TimeZone:        ptr.To("Asia/Tokyo"),
+				DurationSeconds: 3600,
+			},
+			// 20:00 in Tokyo is 11:00 UTC.
+			now:              day.Add(11*time.Hour + 30*time.Minute),
+			wantActive:       true,
+			wantNextBoundary: day.Add(12 * time.Hour),
+		},
+		"window longer than the period": {
+			schedule:         kueue.QuotaSchedule{Schedule: "0 * * * *", DurationSeconds: 2 * 3600},
+			now:              day.Add(30 * time.Minute),
+			wantActive:       true,
+			wantNextBoundary: day.Add(time.Hour),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w, err := Parse(&tc.schedule)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := w.Active(tc.now); got != tc.wantActive {
+				t.Errorf("Unexpected Active: got %v, want %v", got, tc.wantActive)
+			}
+			if got := w.NextBoundary(tc.now); !got.Equal(tc.wantNextBoundary) {
+				t.Errorf("Unexpected NextBoundary: got %v, want %v", got, tc.wantNextBoundary)
+			}
+		})
+	}
+}
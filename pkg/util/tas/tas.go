@@ -105,6 +105,17 @@ func Levels(topology *kueue.Topology) []string {
 	return result
 }
 
+// DeviceLevels returns the levels of the topology backed by a device attribute.
+func DeviceLevels(topology *kueue.Topology) []kueue.TopologyLevel {
+	var levels []kueue.TopologyLevel
+	for _, level := range topology.Spec.Levels {
+		if level.DeviceAttribute != nil {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
 func IsNodeStatusConditionTrue(conditions []corev1.NodeCondition, conditionType corev1.NodeConditionType) bool {
 	for _, cond := range conditions {
 		if cond.Type == conditionType {
@@ -394,6 +394,18 @@ func (w *WorkloadWrapper) MaximumExecutionTimeSeconds(v int32) *WorkloadWrapper
 	return w
 }
 
+func (w *WorkloadWrapper) PreemptionGracePeriodSeconds(v int32) *WorkloadWrapper {
+	w.Spec.PreemptionGracePeriodSeconds = &v
+	return w
+}
+
+func (w *WorkloadWrapper) DependsOn(names ...string) *WorkloadWrapper {
+	for _, name := range names {
+		w.Spec.DependsOn = append(w.Spec.DependsOn, kueue.WorkloadDependency{Name: name})
+	}
+	return w
+}
+
 func (w *WorkloadWrapper) PastAdmittedTime(v int32) *WorkloadWrapper {
 	w.Status.AccumulatedPastExecutionTimeSeconds = &v
 	return w
@@ -422,6 +434,16 @@ func (w *WorkloadWrapper) PreemptionGates(preemptionGates ...kueue.PreemptionGat
 	return w
 }
 
+func (w *WorkloadWrapper) ResourceRecommendations(recommendations ...kueue.PodSetResourceRecommendation) *WorkloadWrapper {
+	w.Status.ResourceRecommendations = recommendations
+	return w
+}
+
+func (w *WorkloadWrapper) MigrationHistory(migrations ...kueue.WorkloadMigration) *WorkloadWrapper {
+	w.Status.MigrationHistory = migrations
+	return w
+}
+
 func (w *WorkloadWrapper) PreemptionGateStates(preemptionGateStates ...kueue.PreemptionGateState) *WorkloadWrapper {
 	w.Status.PreemptionGates = preemptionGateStates
 	return w
@@ -625,6 +647,11 @@ func (p *PodSetWrapper) SetMinimumCount(mc int32) *PodSetWrapper {
 	return p
 }
 
+func (p *PodSetWrapper) QueueName(name kueue.LocalQueueName) *PodSetWrapper {
+	p.PodSet.QueueName = &name
+	return p
+}
+
 func (p *PodSetWrapper) Toleration(t corev1.Toleration) *PodSetWrapper {
 	p.Template.Spec.Tolerations = append(p.Template.Spec.Tolerations, t)
 	return p
@@ -849,6 +876,24 @@ func (q *LocalQueueWrapper) FairSharing(fs *kueue.FairSharing) *LocalQueueWrappe
 	return q
 }
 
+// Budget sets the budget.
+func (q *LocalQueueWrapper) Budget(budget *kueue.LocalQueueBudget) *LocalQueueWrapper {
+	q.Spec.Budget = budget
+	return q
+}
+
+// BudgetStatus updates the budget in status.
+func (q *LocalQueueWrapper) BudgetStatus(status *kueue.LocalQueueBudgetStatus) *LocalQueueWrapper {
+	q.Status.Budget = status
+	return q
+}
+
+// ResourceRecommendations sets the aggregated resource recommendations in status.
+func (q *LocalQueueWrapper) ResourceRecommendations(recommendations *kueue.LocalQueueResourceRecommendations) *LocalQueueWrapper {
+	q.Status.ResourceRecommendations = recommendations
+	return q
+}
+
 // PendingWorkloads updates the pendingWorkloads in status.
 func (q *LocalQueueWrapper) PendingWorkloads(n int32) *LocalQueueWrapper {
 	q.Status.PendingWorkloads = n
@@ -1115,6 +1160,12 @@ func (c *ClusterQueueWrapper) NamespaceSelector(s *metav1.LabelSelector) *Cluste
 	return c
 }
 
+// LocalQueueTemplate sets the template of the provisioned LocalQueues.
+func (c *ClusterQueueWrapper) LocalQueueTemplate(t kueue.LocalQueueTemplate) *ClusterQueueWrapper {
+	c.Spec.LocalQueueTemplate = &t
+	return c
+}
+
 // Preemption sets the preemption policies.
 func (c *ClusterQueueWrapper) Preemption(p kueue.ClusterQueuePreemption) *ClusterQueueWrapper {
 	c.Spec.Preemption = &p
@@ -1260,6 +1311,16 @@ func (rq *ResourceQuotaWrapper) LendingLimit(quantity string) *ResourceQuotaWrap
 	return rq
 }
 
+// Schedule appends a quota schedule overriding the nominal quota.
+func (rq *ResourceQuotaWrapper) Schedule(schedule string, durationSeconds int32, nominalQuota string) *ResourceQuotaWrapper {
+	rq.Schedules = append(rq.Schedules, kueue.QuotaSchedule{
+		Schedule:        schedule,
+		DurationSeconds: durationSeconds,
+		NominalQuota:    new(resource.MustParse(nominalQuota)),
+	})
+	return rq
+}
+
 // Append appends the ResourceQuotaWrapper to its parent
 func (rq *ResourceQuotaWrapper) Append() *FlavorQuotasWrapper {
 	rq.parent.Resources = append(rq.parent.Resources, rq.ResourceQuota)
@@ -1325,6 +1386,25 @@ func (rf *ResourceFlavorWrapper) Toleration(t corev1.Toleration) *ResourceFlavor
 	return rf
 }
 
+// Price adds a price per hour for one unit of the resource to the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) Price(name corev1.ResourceName, pricePerHour string) *ResourceFlavorWrapper {
+	rf.Spec.Prices = append(rf.Spec.Prices, kueue.ResourcePrice{
+		Name:         name,
+		PricePerHour: resource.MustParse(pricePerHour),
+	})
+	return rf
+}
+
+// PriceWithUnit adds a price per hour for the given amount of the resource to the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) PriceWithUnit(name corev1.ResourceName, pricePerHour, unit string) *ResourceFlavorWrapper {
+	rf.Spec.Prices = append(rf.Spec.Prices, kueue.ResourcePrice{
+		Name:         name,
+		PricePerHour: resource.MustParse(pricePerHour),
+		Unit:         new(resource.MustParse(unit)),
+	})
+	return rf
+}
+
 // Creation sets the creation timestamp of the LocalQueue.
 func (rf *ResourceFlavorWrapper) Creation(t time.Time) *ResourceFlavorWrapper {
 	rf.CreationTimestamp = metav1.NewTime(t)
@@ -1366,6 +1446,17 @@ func (t *TopologyWrapper) Levels(levels ...string) *TopologyWrapper {
 	return t
 }
 
+// DeviceAttribute reads the values of the level from an attribute of the
+// devices published by the driver.
+func (t *TopologyWrapper) DeviceAttribute(level, driver, name string) *TopologyWrapper {
+	for i := range t.Spec.Levels {
+		if t.Spec.Levels[i].NodeLabel == level {
+			t.Spec.Levels[i].DeviceAttribute = &kueue.TopologyDeviceAttribute{Driver: driver, Name: name}
+		}
+	}
+	return t
+}
+
 // Label adds a label to a Topology.
 func (t *TopologyWrapper) Label(k, v string) *TopologyWrapper {
 	if t.Labels == nil {
@@ -1477,6 +1568,11 @@ func (p *PodSetAssignmentWrapper) DelayedTopologyRequest(state kueue.DelayedTopo
 	return p
 }
 
+func (p *PodSetAssignmentWrapper) ClusterQueue(cq kueue.ClusterQueueReference) *PodSetAssignmentWrapper {
+	p.PodSetAssignment.ClusterQueue = &cq
+	return p
+}
+
 func (p *PodSetAssignmentWrapper) Assignment(r corev1.ResourceName, f kueue.ResourceFlavorReference, value string) *PodSetAssignmentWrapper {
 	return p.Flavor(r, f).ResourceUsage(r, value)
 }
@@ -1680,6 +1776,11 @@ func (mkc *MultiKueueClusterWrapper) Active(state metav1.ConditionStatus, reason
 }
 
 // Generation sets the generation of the MultiKueueCluster.
+func (mkc *MultiKueueClusterWrapper) Cordoned(cordoned bool) *MultiKueueClusterWrapper {
+	mkc.Spec.Cordoned = &cordoned
+	return mkc
+}
+
 func (mkc *MultiKueueClusterWrapper) Generation(num int64) *MultiKueueClusterWrapper {
 	mkc.ObjectMeta.Generation = num
 	return mkc
@@ -263,6 +263,22 @@ func (p *PodTemplateWrapper) NodeSelector(k, v string) *PodTemplateWrapper {
 	return p
 }
 
+func (p *PodTemplateWrapper) TemplateLabel(k, v string) *PodTemplateWrapper {
+	if p.Template.Labels == nil {
+		p.Template.Labels = make(map[string]string)
+	}
+	p.Template.Labels[k] = v
+	return p
+}
+
+func (p *PodTemplateWrapper) PodAffinity(podAffinity *corev1.PodAffinity) *PodTemplateWrapper {
+	if p.Template.Spec.Affinity == nil {
+		p.Template.Spec.Affinity = &corev1.Affinity{}
+	}
+	p.Template.Spec.Affinity.PodAffinity = podAffinity
+	return p
+}
+
 func (p *PodTemplateWrapper) Toleration(toleration corev1.Toleration) *PodTemplateWrapper {
 	p.Template.Spec.Tolerations = append(p.Template.Spec.Tolerations, toleration)
 	return p
@@ -884,6 +900,11 @@ func (w *ResourceSliceWrapper) Pool(name string, generation int64, sliceCount in
 	return w
 }
 
+func (w *ResourceSliceWrapper) NodeName(name string) *ResourceSliceWrapper {
+	w.Spec.NodeName = &name
+	return w
+}
+
 func (w *ResourceSliceWrapper) Device(name string) *ResourceSliceWrapper {
 	w.Spec.Devices = append(w.Spec.Devices, resourcev1.Device{
 		Name:       name,
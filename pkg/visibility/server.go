@@ -46,6 +46,7 @@ import (
 	visibilityv1beta1 "sigs.k8s.io/kueue/apis/visibility/v1beta1"
 	visibilityv1beta2 "sigs.k8s.io/kueue/apis/visibility/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/tlsconfig"
 	"sigs.k8s.io/kueue/pkg/visibility/storage"
 
@@ -78,8 +79,10 @@ func init() {
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas,verbs=list;watch
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas/status,verbs=patch
 
-// CreateAndStartVisibilityServer creates a visibility server injecting KueueManager and starts it
-func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, cfg *configapi.Configuration, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS) error {
+// CreateAndStartVisibilityServer creates a visibility server injecting KueueManager and starts it.
+// The preemption explainer is optional, the preemption explanation subresource is only installed
+// when it's provided and the VisibilityPreemptionExplanation feature gate is enabled.
+func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, explainer storage.PreemptionExplainer, cfg *configapi.Configuration, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS) error {
 	config := newVisibilityServerConfig(kubeConfig)
 	if err := applyVisibilityServerOptions(config, cfg, tlsOpts); err != nil {
 		return fmt.Errorf("unable to apply VisibilityServerOptions: %w", err)
@@ -90,7 +93,7 @@ func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manage
 		return fmt.Errorf("unable to create visibility server: %w", err)
 	}
 
-	if err := install(visibilityServer, kueueMgr); err != nil {
+	if err := install(visibilityServer, kueueMgr, explainer); err != nil {
 		return fmt.Errorf("unable to install visibility.kueue.x-k8s.io API: %w", err)
 	}
 
@@ -183,11 +186,14 @@ func newVisibilityServerConfig(kubeConfig *rest.Config) *genericapiserver.Recomm
 }
 
 // install installs API scheme and registers storages
-func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager) error {
+func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager, explainer storage.PreemptionExplainer) error {
 	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(visibilityv1beta2.SchemeGroupVersion.Group, scheme, parameterCodec, codecs)
-	storage := storage.NewStorage(kueueMgr)
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.SchemeGroupVersion.Version] = storage
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.SchemeGroupVersion.Version] = storage
+	if !features.Enabled(features.VisibilityPreemptionExplanation) {
+		explainer = nil
+	}
+	// The preemption explanation is only served by v1beta2.
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.SchemeGroupVersion.Version] = storage.NewStorage(kueueMgr, explainer)
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.SchemeGroupVersion.Version] = storage.NewStorage(kueueMgr, nil)
 	apiGroupInfo.PrioritizedVersions = []schema.GroupVersion{visibilityv1beta2.SchemeGroupVersion, visibilityv1beta1.SchemeGroupVersion}
 	return server.InstallAPIGroups(&apiGroupInfo)
 }
@@ -0,0 +1,121 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// PreemptionExplainer runs a dry run of the preemption target selection for
+// a pending workload.
+type PreemptionExplainer interface {
+	ExplainPreemption(ctx context.Context, wlKey workload.Reference) (*preemption.Explanation, error)
+}
+
+type preemptionExplanationREST struct {
+	explainer PreemptionExplainer
+	log       logr.Logger
+}
+
+var _ rest.Storage = &preemptionExplanationREST{}
+var _ rest.Getter = &preemptionExplanationREST{}
+var _ rest.Scoper = &preemptionExplanationREST{}
+
+func NewPreemptionExplanationREST(explainer PreemptionExplainer) *preemptionExplanationREST {
+	return &preemptionExplanationREST{
+		explainer: explainer,
+		log:       ctrl.Log.WithName("preemption-explanation"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *preemptionExplanationREST) New() runtime.Object {
+	return &visibility.PreemptionExplanation{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *preemptionExplanationREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It runs the preemption target selection for the pending workload and returns its outcome
+func (m *preemptionExplanationREST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	namespace := genericapirequest.NamespaceValue(ctx)
+	explanation, err := m.explainer.ExplainPreemption(ctrl.LoggerInto(ctx, m.log), workload.NewReference(namespace, name))
+	if err != nil {
+		if errors.Is(err, scheduler.ErrWorkloadNotPending) {
+			return nil, apierrors.NewNotFound(visibility.Resource("workload"), name)
+		}
+		return nil, err
+	}
+	return newPreemptionExplanation(namespace, name, explanation), nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *preemptionExplanationREST) NamespaceScoped() bool {
+	return true
+}
+
+func newPreemptionExplanation(namespace, name string, explanation *preemption.Explanation) *visibility.PreemptionExplanation {
+	assignment := explanation.Assignment
+	result := &visibility.PreemptionExplanation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		ClusterQueue: explanation.ClusterQueue,
+		Mode:         assignment.RepresentativeMode().String(),
+		Message:      assignment.Message(),
+	}
+	for _, ps := range assignment.PodSets {
+		podSet := visibility.PodSetFlavors{Name: ps.Name}
+		for res, flv := range ps.Flavors {
+			podSet.Flavors = append(podSet.Flavors, visibility.ResourceFlavorAssignment{
+				Resource: res,
+				Flavor:   flv.Name,
+				Mode:     flv.Mode.String(),
+			})
+		}
+		slices.SortFunc(podSet.Flavors, func(a, b visibility.ResourceFlavorAssignment) int {
+			return cmp.Compare(a.Resource, b.Resource)
+		})
+		result.PodSets = append(result.PodSets, podSet)
+	}
+	for _, target := range explanation.Targets {
+		result.Victims = append(result.Victims, newPreemptionCandidate(target.WorkloadInfo, target.WorkloadCq.Name, target.Reason,
+			preemption.HumanReadablePreemptionReasons[target.Reason]))
+	}
+	for _, rejected := range explanation.Rejected {
+		result.RejectedCandidates = append(result.RejectedCandidates, newPreemptionCandidate(rejected.WorkloadInfo, rejected.WorkloadCq.Name, rejected.Reason, rejected.Message))
+	}
+	return result
+}
@@ -0,0 +1,186 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type fakePreemptionExplainer struct {
+	explanations map[workload.Reference]*preemption.Explanation
+}
+
+func (f *fakePreemptionExplainer) ExplainPreemption(_ context.Context, wlKey workload.Reference) (*preemption.Explanation, error) {
+	explanation, found := f.explanations[wlKey]
+	if !found {
+		return nil, scheduler.ErrWorkloadNotPending
+	}
+	return explanation, nil
+}
+
+func TestPreemptionExplanation(t *testing.T) {
+	const nsName = "ns"
+	cqSnapshot := &schdcache.ClusterQueueSnapshot{Name: "cq"}
+	otherCqSnapshot := &schdcache.ClusterQueueSnapshot{Name: "other-cq"}
+
+	explainer := &fakePreemptionExplainer{
+		explanations: map[workload.Reference]*preemption.Explanation{
+			workload.NewReference(nsName, "fits"): {
+				ClusterQueue: "cq",
+				Assignment: flavorassigner.Assignment{
+					PodSets: []flavorassigner.PodSetAssignment{{
+						Name: kueue.DefaultPodSetName,
+						Flavors: flavorassigner.ResourceAssignment{
+							corev1.ResourceMemory: {Name: "default", Mode: flavorassigner.Fit},
+							corev1.ResourceCPU:    {Name: "default", Mode: flavorassigner.Fit},
+						},
+					}},
+				},
+			},
+			workload.NewReference(nsName, "preempts"): {
+				ClusterQueue: "cq",
+				Assignment: flavorassigner.Assignment{
+					PodSets: []flavorassigner.PodSetAssignment{{
+						Name: kueue.DefaultPodSetName,
+						Flavors: flavorassigner.ResourceAssignment{
+							corev1.ResourceCPU: {Name: "default", Mode: flavorassigner.Preempt},
+						},
+						Status: *flavorassigner.NewStatus("insufficient unused quota for cpu in flavor default, 1 more needed"),
+					}},
+				},
+				Targets: []*preemption.Target{{
+					WorkloadInfo: workload.NewInfo(utiltestingapi.MakeWorkload("low", nsName).Priority(-1).Obj()),
+					Reason:       kueue.InClusterQueueReason,
+					WorkloadCq:   cqSnapshot,
+				}},
+				Rejected: []preemption.RejectedCandidate{
+					{
+						WorkloadInfo: workload.NewInfo(utiltestingapi.MakeWorkload("high", nsName).Priority(1).Obj()),
+						WorkloadCq:   cqSnapshot,
+						Reason:       preemption.RejectedByWithinClusterQueuePolicy,
+						Message:      "The withinClusterQueue policy LowerPriority doesn't allow preempting the workload",
+					},
+					{
+						WorkloadInfo: workload.NewInfo(utiltestingapi.MakeWorkload("other", "other-ns").Obj()),
+						WorkloadCq:   otherCqSnapshot,
+						Reason:       preemption.RejectedNotBorrowing,
+						Message:      "The ClusterQueue other-cq isn't borrowing the resources that need preemption",
+					},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		workloadName string
+		want         *visibility.PreemptionExplanation
+		wantErrMatch func(error) bool
+	}{
+		"workload fits": {
+			workloadName: "fits",
+			want: &visibility.PreemptionExplanation{
+				ObjectMeta:   metav1.ObjectMeta{Name: "fits", Namespace: nsName},
+				ClusterQueue: "cq",
+				Mode:         "Fit",
+				PodSets: []visibility.PodSetFlavors{{
+					Name: kueue.DefaultPodSetName,
+					Flavors: []visibility.ResourceFlavorAssignment{
+						{Resource: corev1.ResourceCPU, Flavor: "default", Mode: "Fit"},
+						{Resource: corev1.ResourceMemory, Flavor: "default", Mode: "Fit"},
+					},
+				}},
+			},
+		},
+		"workload needs preemption": {
+			workloadName: "preempts",
+			want: &visibility.PreemptionExplanation{
+				ObjectMeta:   metav1.ObjectMeta{Name: "preempts", Namespace: nsName},
+				ClusterQueue: "cq",
+				Mode:         "Preempt",
+				Message:      "couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default, 1 more needed",
+				PodSets: []visibility.PodSetFlavors{{
+					Name: kueue.DefaultPodSetName,
+					Flavors: []visibility.ResourceFlavorAssignment{
+						{Resource: corev1.ResourceCPU, Flavor: "default", Mode: "Preempt"},
+					},
+				}},
+				Victims: []visibility.PreemptionCandidate{{
+					ObjectMeta:   metav1.ObjectMeta{Name: "low", Namespace: nsName},
+					ClusterQueue: "cq",
+					Priority:     -1,
+					Reason:       kueue.InClusterQueueReason,
+					Message:      "prioritization in the ClusterQueue",
+				}},
+				RejectedCandidates: []visibility.PreemptionCandidate{
+					{
+						ObjectMeta:   metav1.ObjectMeta{Name: "high", Namespace: nsName},
+						ClusterQueue: "cq",
+						Priority:     1,
+						Reason:       preemption.RejectedByWithinClusterQueuePolicy,
+						Message:      "The withinClusterQueue policy LowerPriority doesn't allow preempting the workload",
+					},
+					{
+						ObjectMeta:   metav1.ObjectMeta{Name: "other", Namespace: "other-ns"},
+						ClusterQueue: "other-cq",
+						Reason:       preemption.RejectedNotBorrowing,
+						Message:      "The ClusterQueue other-cq isn't borrowing the resources that need preemption",
+					},
+				},
+			},
+		},
+		"workload not pending": {
+			workloadName: "admitted",
+			wantErrMatch: apierrors.IsNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			ctx = request.WithNamespace(ctx, nsName)
+			got, err := NewPreemptionExplanationREST(explainer).Get(ctx, tc.workloadName, &metav1.GetOptions{})
+			switch {
+			case tc.wantErrMatch != nil:
+				if !tc.wantErrMatch(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			case err != nil:
+				t.Error(err)
+			default:
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("Preemption explanation differs: (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -22,11 +22,16 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 )
 
-func NewStorage(mgr *qcache.Manager) map[string]rest.Storage {
-	return map[string]rest.Storage{
+func NewStorage(mgr *qcache.Manager, explainer PreemptionExplainer) map[string]rest.Storage {
+	storage := map[string]rest.Storage{
 		"clusterqueues":                  NewCqREST(),
 		"clusterqueues/pendingworkloads": NewPendingWorkloadsInCqREST(mgr),
 		"localqueues":                    NewLqREST(),
 		"localqueues/pendingworkloads":   NewPendingWorkloadsInLqREST(mgr),
 	}
+	if explainer != nil {
+		storage["workloads"] = NewWorkloadREST()
+		storage["workloads/preemptionexplanation"] = NewPreemptionExplanationREST(explainer)
+	}
+	return storage
 }
@@ -19,6 +19,7 @@ package storage
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/util/priority"
@@ -55,3 +56,16 @@ func newPendingWorkload(wlInfo *workload.Info, positionInLq int32, positionInCq
 		PositionInLocalQueue:   positionInLq,
 	}
 }
+
+func newPreemptionCandidate(wlInfo *workload.Info, cqName kueue.ClusterQueueReference, reason, message string) visibility.PreemptionCandidate {
+	return visibility.PreemptionCandidate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      wlInfo.Obj.Name,
+			Namespace: wlInfo.Obj.Namespace,
+		},
+		ClusterQueue: cqName,
+		Priority:     priority.Priority(wlInfo.Obj),
+		Reason:       reason,
+		Message:      message,
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+)
+
+// WorkloadREST type is used only to install workloads/ resource, so we can install workloads/preemptionexplanation subresource.
+// It implements the necessary interfaces for genericapiserver but does not provide any actual functionalities.
+type WorkloadREST struct{}
+
+// Those interfaces are necessary for genericapiserver to work properly
+var _ rest.Storage = &WorkloadREST{}
+var _ rest.Scoper = &WorkloadREST{}
+var _ rest.SingularNameProvider = &WorkloadREST{}
+
+func NewWorkloadREST() *WorkloadREST {
+	return &WorkloadREST{}
+}
+
+// New implements rest.Storage interface
+func (m *WorkloadREST) New() runtime.Object {
+	return &visibility.PreemptionExplanation{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *WorkloadREST) Destroy() {}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *WorkloadREST) NamespaceScoped() bool {
+	return true
+}
+
+// GetSingularName implements rest.SingularNameProvider interface
+func (m *WorkloadREST) GetSingularName() string {
+	return "workload"
+}
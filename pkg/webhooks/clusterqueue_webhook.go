@@ -25,6 +25,7 @@ import (
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -33,6 +34,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/util/quotaschedule"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 )
@@ -132,6 +134,22 @@ func validateClusterQueueSpec(cq *kueue.ClusterQueue) field.ErrorList {
 	allErrs = append(allErrs, validateTotalCoveredResources(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateFlavorResourceCombinations(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateConcurrentAdmissionPolicy(cq, path)...)
+	if cq.Spec.LocalQueueTemplate != nil {
+		allErrs = append(allErrs, validateLocalQueueTemplate(cq, path.Child("localQueueTemplate"))...)
+	}
+	return allErrs
+}
+
+func validateLocalQueueTemplate(cq *kueue.ClusterQueue, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	template := cq.Spec.LocalQueueTemplate
+	// The name of the namespace isn't known upfront, so the pattern is
+	// validated with a sample one.
+	name := utilqueue.ProvisionedLocalQueueName(cq, "namespace")
+	for _, msg := range utilvalidation.IsDNS1123Subdomain(string(name)) {
+		allErrs = append(allErrs, field.Invalid(path.Child("namePattern"), template.NamePattern, msg))
+	}
+	allErrs = append(allErrs, validateFairSharing(template.FairSharing, path.Child("fairSharing"))...)
 	return allErrs
 }
 
@@ -282,6 +300,22 @@ func validatePreemption(preemption *kueue.ClusterQueuePreemption, path *field.Pa
 		preemption.BorrowWithinCohort.Policy != kueue.BorrowWithinCohortPolicyNever {
 		allErrs = append(allErrs, field.Invalid(path, preemption, "reclaimWithinCohort=Never and borrowWithinCohort.Policy!=Never"))
 	}
+	if preemption.PreemptionBudget != nil {
+		allErrs = append(allErrs, validatePreemptionBudget(preemption.PreemptionBudget, path.Child("preemptionBudget"))...)
+	}
+	return allErrs
+}
+
+func validatePreemptionBudget(budget *kueue.PreemptionBudget, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if budget.Window.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("window"), budget.Window.Duration.String(), "must be greater than 0"))
+	}
+	for name, quantity := range budget.MaxResources {
+		path := path.Child("maxResources").Key(string(name))
+		allErrs = append(allErrs, validateResourceName(name, path)...)
+		allErrs = append(allErrs, validateResourceQuantity(quantity, path)...)
+	}
 	return allErrs
 }
 
@@ -351,6 +385,37 @@ func validateFlavorQuotas(flavorQuotas kueue.FlavorQuotas, coveredResources []co
 			allErrs = append(allErrs, validateLimit(*rq.LendingLimit, config, lendingLimitPath, isCohort)...)
 			allErrs = append(allErrs, validateLendingLimit(*rq.LendingLimit, rq.NominalQuota, config, lendingLimitPath)...)
 		}
+		for j := range rq.Schedules {
+			allErrs = append(allErrs, validateQuotaSchedule(&rq.Schedules[j], rq, config, path.Child("schedules").Index(j), isCohort)...)
+		}
+	}
+	return allErrs
+}
+
+func validateQuotaSchedule(schedule *kueue.QuotaSchedule, rq kueue.ResourceQuota, config validationConfig, path *field.Path, isCohort bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, err := quotaschedule.Parse(schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("schedule"), schedule.Schedule, err.Error()))
+	}
+	nominal := rq.NominalQuota
+	if schedule.NominalQuota != nil {
+		nominal = *schedule.NominalQuota
+		allErrs = append(allErrs, validateResourceQuantity(nominal, path.Child("nominalQuota"))...)
+	}
+	if schedule.BorrowingLimit != nil {
+		borrowingLimitPath := path.Child("borrowingLimit")
+		allErrs = append(allErrs, validateLimit(*schedule.BorrowingLimit, config, borrowingLimitPath, isCohort)...)
+		allErrs = append(allErrs, validateResourceQuantity(*schedule.BorrowingLimit, borrowingLimitPath)...)
+	}
+	lendingLimit := rq.LendingLimit
+	if schedule.LendingLimit != nil {
+		lendingLimit = schedule.LendingLimit
+		lendingLimitPath := path.Child("lendingLimit")
+		allErrs = append(allErrs, validateResourceQuantity(*lendingLimit, lendingLimitPath)...)
+		allErrs = append(allErrs, validateLimit(*lendingLimit, config, lendingLimitPath, isCohort)...)
+	}
+	if lendingLimit != nil && (schedule.NominalQuota != nil || schedule.LendingLimit != nil) {
+		allErrs = append(allErrs, validateLendingLimit(*lendingLimit, nominal, config, path.Child("lendingLimit"))...)
 	}
 	return allErrs
 }
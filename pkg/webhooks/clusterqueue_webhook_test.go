@@ -19,6 +19,7 @@ package webhooks
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -322,6 +323,70 @@ func TestValidateClusterQueue(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid preemption budget",
+			clusterQueue: &kueue.ClusterQueue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-queue",
+				},
+				Spec: kueue.ClusterQueueSpec{
+					Preemption: &kueue.ClusterQueuePreemption{
+						WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+						PreemptionBudget: &kueue.PreemptionBudget{
+							Window:       metav1.Duration{Duration: time.Hour},
+							MaxWorkloads: new(int32(5)),
+							MaxResources: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("10"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid preemption budget",
+			clusterQueue: &kueue.ClusterQueue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-queue",
+				},
+				Spec: kueue.ClusterQueueSpec{
+					Preemption: &kueue.ClusterQueuePreemption{
+						WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+						PreemptionBudget: &kueue.PreemptionBudget{
+							MaxResources: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("-1"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("preemption", "preemptionBudget", "window"), "0s", ""),
+				field.Invalid(specPath.Child("preemption", "preemptionBudget", "maxResources").Key("cpu"), "-1", ""),
+			},
+		},
+		{
+			name: "valid localQueueTemplate",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				LocalQueueTemplate(kueue.LocalQueueTemplate{
+					NamePattern: "{namespace}-{clusterQueue}",
+					FairSharing: &kueue.FairSharing{Weight: new(resource.MustParse("2"))},
+				}).
+				Obj(),
+		},
+		{
+			name: "invalid localQueueTemplate",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				LocalQueueTemplate(kueue.LocalQueueTemplate{
+					NamePattern: "{clusterQueue}_queue",
+					FairSharing: &kueue.FairSharing{Weight: new(resource.MustParse("-1"))},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("localQueueTemplate", "namePattern"), "{clusterQueue}_queue", ""),
+				field.Invalid(specPath.Child("localQueueTemplate", "fairSharing"), "-1", ""),
+			},
+		},
 		{
 			name: "flavorFungibility preference set but whenCanPreempt != TryNextFlavor",
 			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
@@ -469,6 +534,52 @@ func TestValidateClusterQueue(t *testing.T) {
 				LastAcceptableFlavorName("flavor1").
 				Obj(),
 		},
+		{
+			name: "quota schedule",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").Schedule("0 20 * * *", 3600, "2").Append().
+						Obj()).
+				Obj(),
+		},
+		{
+			name: "quota schedule with invalid schedule",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").Schedule("0 25 * * *", 3600, "2").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("schedules").Index(0).Child("schedule"), "0 25 * * *", ""),
+			},
+		},
+		{
+			name: "quota schedule with negative nominal quota",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").Schedule("0 20 * * *", 3600, "-1").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("schedules").Index(0).Child("nominalQuota"), "-1", ""),
+			},
+		},
+		{
+			name: "quota schedule with nominal quota below the lendingLimit",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("4").LendingLimit("2").Schedule("0 20 * * *", 3600, "1").Append().
+						Obj()).
+				Cohort("cohort").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("schedules").Index(0).Child("lendingLimit"), "2", ""),
+			},
+		},
 		{
 			name: "ConcurrentAdmissionPolicy with invalid LastAcceptableFlavorName",
 			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
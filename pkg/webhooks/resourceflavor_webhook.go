@@ -22,6 +22,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/validate/content"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -89,6 +90,21 @@ func ValidateResourceFlavor(rf *kueue.ResourceFlavor) field.ErrorList {
 
 	allErrs = append(allErrs, validateNodeTaints(rf.Spec.NodeTaints, specPath.Child("nodeTaints"))...)
 	allErrs = append(allErrs, validateTolerations(rf.Spec.Tolerations, specPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validateResourcePrices(rf.Spec.Prices, specPath.Child("prices"))...)
+	return allErrs
+}
+
+func validateResourcePrices(prices []kueue.ResourcePrice, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, price := range prices {
+		idxPath := fldPath.Index(i)
+		if price.PricePerHour.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pricePerHour"), price.PricePerHour.String(), apimachineryvalidation.IsNegativeErrorMsg))
+		}
+		if price.Unit != nil && price.Unit.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("unit"), price.Unit.String(), "must be greater than 0"))
+		}
+	}
 	return allErrs
 }
 
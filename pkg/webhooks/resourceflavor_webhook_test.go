@@ -65,6 +65,31 @@ func TestValidateResourceFlavor(t *testing.T) {
 					WithOrigin("format=k8s-label-value"),
 			},
 		},
+		{
+			name: "valid prices",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Price("nvidia.com/gpu", "2.5").
+				PriceWithUnit(corev1.ResourceMemory, "0.01", "1Gi").
+				Obj(),
+		},
+		{
+			name: "negative price",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Price("nvidia.com/gpu", "-1").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "prices").Index(0).Child("pricePerHour"), "-1", ""),
+			},
+		},
+		{
+			name: "zero price unit",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				PriceWithUnit(corev1.ResourceMemory, "0.01", "0").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "prices").Index(0).Child("unit"), "0", ""),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
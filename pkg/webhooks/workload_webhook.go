@@ -26,6 +26,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,6 +46,7 @@ import (
 
 // priorityBoostAnnotationPath is the field path for the priority-boost annotation, used in validation errors.
 var priorityBoostAnnotationPath = field.NewPath("metadata", "annotations").Key(controllerconstants.PriorityBoostAnnotationKey)
+var deadlineAnnotationPath = field.NewPath("metadata", "annotations").Key(controllerconstants.DeadlineAnnotationKey)
 
 type WorkloadWebhook struct{}
 
@@ -73,6 +75,13 @@ func (w *WorkloadWebhook) Default(ctx context.Context, wl *kueue.Workload) error
 		}
 	}
 
+	// drop podSet queueNames if MultiQueueWorkloads is not enabled
+	if !features.Enabled(features.MultiQueueWorkloads) {
+		for i := range wl.Spec.PodSets {
+			wl.Spec.PodSets[i].QueueName = nil
+		}
+	}
+
 	return nil
 }
 
@@ -128,6 +137,10 @@ func ValidateWorkload(obj, oldObj *kueue.Workload) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("podSets"), variableCountPodSets, "partial admission and elastic job cannot be used together"))
 	}
 
+	if workload.IsMultiQueue(obj) {
+		allErrs = append(allErrs, validateMultiQueuePodSets(obj, specPath)...)
+	}
+
 	statusPath := field.NewPath("status")
 	if workload.HasQuotaReservation(obj) {
 		allErrs = append(allErrs, validateAdmission(obj, oldObj, statusPath.Child("admission"))...)
@@ -154,6 +167,30 @@ func ValidateWorkload(obj, oldObj *kueue.Workload) field.ErrorList {
 		}
 	}
 
+	if features.Enabled(features.DeadlineAwareQueueing) {
+		if _, _, err := workload.ParseDeadline(obj); err != nil {
+			allErrs = append(allErrs, field.Invalid(deadlineAnnotationPath, obj.Annotations[controllerconstants.DeadlineAnnotationKey], "must be a time in RFC 3339 format"))
+		}
+	}
+
+	if features.Enabled(features.WorkloadDependencies) {
+		allErrs = append(allErrs, validateDependsOn(obj, specPath.Child("dependsOn"))...)
+	}
+
+	return allErrs
+}
+
+func validateDependsOn(obj *kueue.Workload, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, dep := range obj.Spec.DependsOn {
+		namePath := path.Index(i).Child("name")
+		for _, msg := range validation.IsDNS1123Subdomain(dep.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, dep.Name, msg))
+		}
+		if dep.Name == obj.Name {
+			allErrs = append(allErrs, field.Invalid(namePath, dep.Name, "a workload can't depend on itself"))
+		}
+	}
 	return allErrs
 }
 
@@ -190,6 +227,30 @@ func validatePodSet(ps *kueue.PodSet, path *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// validateMultiQueuePodSets validates a Workload whose PodSets are charged to
+// more than one LocalQueue. Partial admission, topology requests and elastic
+// jobs are not supported for such Workloads.
+func validateMultiQueuePodSets(obj *kueue.Workload, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if obj.Spec.QueueName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("queueName"), "must be set when a podSet specifies a queueName"))
+	}
+	if workloadslicing.Enabled(obj) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("podSets"), len(obj.Spec.PodSets), "podSets in multiple queues and elastic job cannot be used together"))
+	}
+	for i := range obj.Spec.PodSets {
+		ps := &obj.Spec.PodSets[i]
+		psPath := specPath.Child("podSets").Index(i)
+		if ps.MinCount != nil {
+			allErrs = append(allErrs, field.Forbidden(psPath.Child("minCount"), "cannot be used with podSets in multiple queues"))
+		}
+		if ps.TopologyRequest != nil {
+			allErrs = append(allErrs, field.Forbidden(psPath.Child("topologyRequest"), "cannot be used with podSets in multiple queues"))
+		}
+	}
+	return allErrs
+}
+
 func validateContainer(c *corev1.Container, path *field.Path) field.ErrorList {
 	requestErrors := validateResourceList(c.Resources.Requests, path.Child("resources", "requests"))
 	limitErrors := validateResourceList(c.Resources.Limits, path.Child("resources", "limits"))
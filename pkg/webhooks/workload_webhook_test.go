@@ -575,6 +575,48 @@ func TestValidateWorkload(t *testing.T) {
 				Obj(),
 			wantErr: nil,
 		},
+		"valid deadline": {
+			featureGates: map[featuregate.Feature]bool{features.DeadlineAwareQueueing: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+				Annotation(controllerconstants.DeadlineAnnotationKey, "2026-03-10T13:00:00Z").
+				Obj(),
+		},
+		"invalid deadline": {
+			featureGates: map[featuregate.Feature]bool{features.DeadlineAwareQueueing: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+				Annotation(controllerconstants.DeadlineAnnotationKey, "tomorrow").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(deadlineAnnotationPath, "tomorrow", "must be a time in RFC 3339 format"),
+			}.ToAggregate(),
+		},
+		"invalid deadline when feature off": {
+			featureGates: map[featuregate.Feature]bool{features.DeadlineAwareQueueing: false},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+				Annotation(controllerconstants.DeadlineAnnotationKey, "tomorrow").
+				Obj(),
+		},
+		"valid dependencies": {
+			featureGates: map[featuregate.Feature]bool{features.WorkloadDependencies: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+				DependsOn("preprocess", "train").
+				Obj(),
+		},
+		"invalid dependencies": {
+			featureGates: map[featuregate.Feature]bool{features.WorkloadDependencies: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Obj()).
+				DependsOn("Preprocess", testWorkloadName).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "dependsOn").Index(0).Child("name"), "Preprocess", ""),
+				field.Invalid(field.NewPath("spec", "dependsOn").Index(1).Child("name"), testWorkloadName, "a workload can't depend on itself"),
+			}.ToAggregate(),
+		},
 		"valid AdmissionGatedBy annotation with single gate": {
 			featureGates: map[featuregate.Feature]bool{features.AdmissionGatedBy: true},
 			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
@@ -705,6 +747,42 @@ func TestValidateWorkload(t *testing.T) {
 				field.Invalid(specPath.Child("podSets"), 1, ""),
 			}.ToAggregate(),
 		},
+		"podSets in multiple queues": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Queue("cpu-queue").
+				PodSets(
+					*utiltestingapi.MakePodSet("driver", 1).Obj(),
+					*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu-queue").Obj(),
+				).
+				Obj(),
+		},
+		"podSets in multiple queues require the workload queueName": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("driver", 1).Obj(),
+					*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu-queue").Obj(),
+				).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Required(specPath.Child("queueName"), ""),
+			}.ToAggregate(),
+		},
+		"podSets in multiple queues cannot use partial admission or topology requests": {
+			featureGates: map[featuregate.Feature]bool{features.MultiQueueWorkloads: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Queue("cpu-queue").
+				PodSets(
+					*utiltestingapi.MakePodSet("driver", 1).RequiredTopologyRequest("rack").Obj(),
+					*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu-queue").SetMinimumCount(2).Obj(),
+				).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(podSetsPath.Index(0).Child("topologyRequest"), ""),
+				field.Forbidden(podSetsPath.Index(1).Child("minCount"), ""),
+			}.ToAggregate(),
+		},
 		"non-negative subGroupCount is accepted without warning": {
 			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).PodSets(
 				*utiltestingapi.MakePodSet("main", 1).SubGroupCount(new(int32(0))).Obj(),
@@ -0,0 +1,86 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// FlavorPrices returns the prices declared by a ResourceFlavor.
+type FlavorPrices func(kueue.ResourceFlavorReference) []kueue.ResourcePrice
+
+// CostPerHour returns the cost of running the admitted PodSets for one hour,
+// according to the prices of their assigned flavors. Resources without a
+// price don't contribute to the cost.
+func CostPerHour(admission *kueue.Admission, prices FlavorPrices) float64 {
+	if admission == nil {
+		return 0
+	}
+	var cost float64
+	for _, psa := range admission.PodSetAssignments {
+		for res, flavor := range psa.Flavors {
+			usage, found := psa.ResourceUsage[res]
+			if !found {
+				continue
+			}
+			for _, price := range prices(flavor) {
+				if price.Name != res {
+					continue
+				}
+				units := usage.AsApproximateFloat64()
+				if price.Unit != nil {
+					units /= price.Unit.AsApproximateFloat64()
+				}
+				cost += units * price.PricePerHour.AsApproximateFloat64()
+				break
+			}
+		}
+	}
+	return cost
+}
+
+// AdmissionPeriodEnd returns when the admission period of a workload, which
+// was admitted before the update to wl, ended. That is when the workload
+// finished or lost its admission, or now if neither is recorded.
+func AdmissionPeriodEnd(wl *kueue.Workload, now time.Time) time.Time {
+	if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadFinished); c != nil && c.Status == metav1.ConditionTrue {
+		return c.LastTransitionTime.Time
+	}
+	if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted); c != nil && c.Status == metav1.ConditionFalse {
+		return c.LastTransitionTime.Time
+	}
+	return now
+}
+
+// AdmissionPeriodCost returns the cost of the current admission period of
+// the workload, ending at end. It returns 0 if the workload is not admitted.
+func AdmissionPeriodCost(wl *kueue.Workload, prices FlavorPrices, end time.Time) float64 {
+	c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted)
+	if c == nil || c.Status != metav1.ConditionTrue {
+		return 0
+	}
+	hours := end.Sub(c.LastTransitionTime.Time).Hours()
+	if hours <= 0 {
+		return 0
+	}
+	return CostPerHour(wl.Status.Admission, prices) * hours
+}
@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestCostPerHour(t *testing.T) {
+	flavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+		"gpu": utiltestingapi.MakeResourceFlavor("gpu").
+			Price("nvidia.com/gpu", "2.5").
+			PriceWithUnit(corev1.ResourceMemory, "0.01", "1Gi").
+			Obj(),
+		"cpu": utiltestingapi.MakeResourceFlavor("cpu").
+			Price(corev1.ResourceCPU, "0.04").
+			Obj(),
+		"free": utiltestingapi.MakeResourceFlavor("free").Obj(),
+	}
+	prices := func(name kueue.ResourceFlavorReference) []kueue.ResourcePrice {
+		if rf, found := flavors[name]; found {
+			return rf.Spec.Prices
+		}
+		return nil
+	}
+	cases := map[string]struct {
+		admission *kueue.Admission
+		want      float64
+	}{
+		"no admission": {},
+		"single podset": {
+			admission: utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment("nvidia.com/gpu", "gpu", "4").
+					Assignment(corev1.ResourceMemory, "gpu", "100Gi").
+					Obj()).
+				Obj(),
+			want: 4*2.5 + 100*0.01,
+		},
+		"multiple podsets and flavors": {
+			admission: utiltestingapi.MakeAdmission("cq").
+				PodSets(
+					utiltestingapi.MakePodSetAssignment("launcher").
+						Assignment(corev1.ResourceCPU, "cpu", "500m").
+						Obj(),
+					utiltestingapi.MakePodSetAssignment("workers").
+						Assignment("nvidia.com/gpu", "gpu", "8").
+						Assignment(corev1.ResourceCPU, "cpu", "16").
+						Obj(),
+				).
+				Obj(),
+			want: 0.5*0.04 + 8*2.5 + 16*0.04,
+		},
+		"resources without a price": {
+			admission: utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "gpu", "4").
+					Assignment(corev1.ResourceCPU, "free", "4").
+					Assignment(corev1.ResourceMemory, "unknown", "1Gi").
+					Obj()).
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := CostPerHour(tc.admission, prices); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("CostPerHour() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAdmissionPeriodCost(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	prices := func(kueue.ResourceFlavorReference) []kueue.ResourcePrice {
+		return utiltestingapi.MakeResourceFlavor("rf").Price("nvidia.com/gpu", "3").Obj().Spec.Prices
+	}
+	admission := utiltestingapi.MakeAdmission("cq").
+		PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment("nvidia.com/gpu", "rf", "2").
+			Obj()).
+		Obj()
+	cases := map[string]struct {
+		wl      *kueue.Workload
+		wantEnd time.Time
+		want    float64
+	}{
+		"not admitted": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(admission, now.Add(-2*time.Hour)).
+				Obj(),
+			wantEnd: now,
+		},
+		"admitted and running": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(admission, now.Add(-2*time.Hour)).
+				AdmittedAt(true, now.Add(-2*time.Hour)).
+				Obj(),
+			wantEnd: now,
+			want:    2 * 3 * 2,
+		},
+		"finished": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(admission, now.Add(-2*time.Hour)).
+				AdmittedAt(true, now.Add(-2*time.Hour)).
+				FinishedAt(now.Add(-90 * time.Minute)).
+				Obj(),
+			wantEnd: now.Add(-90 * time.Minute),
+			want:    0.5 * 3 * 2,
+		},
+		"lost admission": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(admission, now.Add(-2*time.Hour)).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadAdmitted,
+					Status:             metav1.ConditionFalse,
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+					Reason:             "ByTest",
+				}).
+				Obj(),
+			wantEnd: now.Add(-time.Hour),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			end := AdmissionPeriodEnd(tc.wl, now)
+			if !end.Equal(tc.wantEnd) {
+				t.Errorf("AdmissionPeriodEnd() = %v, want %v", end, tc.wantEnd)
+			}
+			if got := AdmissionPeriodCost(tc.wl, prices, end); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("AdmissionPeriodCost() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/api"
+)
+
+// ParseDeadline returns the deadline declared in the deadline annotation of
+// the workload. It returns false if the annotation is missing.
+func ParseDeadline(wl *kueue.Workload) (time.Time, bool, error) {
+	value, found := wl.Annotations[controllerconstants.DeadlineAnnotationKey]
+	if !found {
+		return time.Time{}, false, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return deadline, true, nil
+}
+
+// Deadline returns the deadline of the workload. Invalid values are rejected
+// by the webhook, and are treated as no deadline.
+func Deadline(wl *kueue.Workload) (time.Time, bool) {
+	deadline, found, err := ParseDeadline(wl)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return deadline, found
+}
+
+// LatestStartTime returns the latest time at which the workload can be
+// admitted to finish before its deadline, accounting for its maximum
+// execution time. It returns false if the workload has no deadline.
+func LatestStartTime(wl *kueue.Workload) (time.Time, bool) {
+	deadline, found := Deadline(wl)
+	if !found {
+		return time.Time{}, false
+	}
+	maxExecutionTime := time.Duration(ptr.Deref(wl.Spec.MaximumExecutionTimeSeconds, 0)) * time.Second
+	return deadline.Add(-maxExecutionTime), true
+}
+
+// SyncDeadlineUnattainableCondition sets the DeadlineUnattainable condition of
+// a pending workload that can no longer finish before its deadline, and sets
+// it to false if the deadline was extended. It returns whether the condition
+// changed, and the time until the deadline becomes unattainable; zero if it
+// already is, or if the workload has no deadline.
+func SyncDeadlineUnattainableCondition(wl *kueue.Workload, now time.Time) (bool, time.Duration) {
+	latestStart, found := LatestStartTime(wl)
+	if !found {
+		return false, 0
+	}
+	if now.Before(latestStart) {
+		if !apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadDeadlineUnattainable) {
+			return false, latestStart.Sub(now)
+		}
+		return setDeadlineUnattainableCondition(wl, metav1.ConditionFalse, kueue.WorkloadDeadlineAttainable,
+			"The deadline was extended and can be met"), latestStart.Sub(now)
+	}
+	deadline, _ := Deadline(wl)
+	if !now.Before(deadline) {
+		return setDeadlineUnattainableCondition(wl, metav1.ConditionTrue, kueue.WorkloadDeadlinePassed,
+			fmt.Sprintf("The deadline %s passed before the workload was admitted", deadline.Format(time.RFC3339))), 0
+	}
+	return setDeadlineUnattainableCondition(wl, metav1.ConditionTrue, kueue.WorkloadInsufficientTimeBeforeDeadline,
+		fmt.Sprintf("The workload can't finish within its maximum execution time of %ds before the deadline %s",
+			ptr.Deref(wl.Spec.MaximumExecutionTimeSeconds, 0), deadline.Format(time.RFC3339))), 0
+}
+
+func setDeadlineUnattainableCondition(wl *kueue.Workload, status metav1.ConditionStatus, reason, message string) bool {
+	return apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadDeadlineUnattainable,
+		Status:             status,
+		Reason:             reason,
+		Message:            api.TruncateConditionMessage(message),
+		ObservedGeneration: wl.Generation,
+	})
+}
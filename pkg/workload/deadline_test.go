@@ -0,0 +1,154 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestSyncDeadlineUnattainableCondition(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	deadline := now.Add(time.Hour).Format(time.RFC3339)
+
+	cases := map[string]struct {
+		workload         *kueue.Workload
+		now              time.Time
+		wantChanged      bool
+		wantRecheckAfter time.Duration
+		wantConditions   []metav1.Condition
+	}{
+		"no deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").Obj(),
+			now:      now,
+		},
+		"invalid deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, "tomorrow").
+				Obj(),
+			now: now,
+		},
+		"deadline can be met": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, deadline).
+				MaximumExecutionTimeSeconds(600).
+				Obj(),
+			now:              now,
+			wantRecheckAfter: 50 * time.Minute,
+		},
+		"not enough time before the deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, deadline).
+				MaximumExecutionTimeSeconds(3600).
+				Obj(),
+			now:         now.Add(time.Minute),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadDeadlineUnattainable,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadInsufficientTimeBeforeDeadline,
+				Message: "The workload can't finish within its maximum execution time of 3600s before the deadline 2026-03-10T13:00:00Z",
+			}},
+		},
+		"deadline passed": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, deadline).
+				Obj(),
+			now:         now.Add(time.Hour),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadDeadlineUnattainable,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadDeadlinePassed,
+				Message: "The deadline 2026-03-10T13:00:00Z passed before the workload was admitted",
+			}},
+		},
+		"deadline extended": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, deadline).
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadDeadlineUnattainable,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadDeadlinePassed,
+				}).
+				Obj(),
+			now:              now,
+			wantChanged:      true,
+			wantRecheckAfter: time.Hour,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadDeadlineUnattainable,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.WorkloadDeadlineAttainable,
+				Message: "The deadline was extended and can be met",
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed, recheckAfter := SyncDeadlineUnattainableCondition(tc.workload, tc.now)
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed: got %v, want %v", changed, tc.wantChanged)
+			}
+			if recheckAfter != tc.wantRecheckAfter {
+				t.Errorf("Unexpected recheckAfter: got %v, want %v", recheckAfter, tc.wantRecheckAfter)
+			}
+			if diff := cmp.Diff(tc.wantConditions, tc.workload.Status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected conditions (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInfoDeadline(t *testing.T) {
+	deadline := time.Date(2026, time.March, 10, 13, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		workload     *kueue.Workload
+		wantDeadline *time.Time
+	}{
+		"no deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").Obj(),
+		},
+		"invalid deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, "tomorrow").
+				Obj(),
+		},
+		"deadline": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Annotation(controllerconstants.DeadlineAnnotationKey, deadline.Format(time.RFC3339)).
+				Obj(),
+			wantDeadline: &deadline,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			info := NewInfo(tc.workload)
+			if diff := cmp.Diff(tc.wantDeadline, info.Deadline); diff != "" {
+				t.Errorf("Unexpected deadline (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
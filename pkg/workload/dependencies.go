@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/api"
+)
+
+// HasDependencies returns true if the workload declares dependencies and the
+// WorkloadDependencies feature is on.
+func HasDependencies(wl *kueue.Workload) bool {
+	return features.Enabled(features.WorkloadDependencies) && len(wl.Spec.DependsOn) > 0
+}
+
+// IsWaitingForDependencies returns true if the workload declares dependencies
+// which weren't observed to finish successfully for its current generation.
+func IsWaitingForDependencies(wl *kueue.Workload) bool {
+	if !HasDependencies(wl) {
+		return false
+	}
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadWaitingForDependencies)
+	return cond == nil || cond.ObservedGeneration != wl.Generation || cond.Reason != kueue.WorkloadDependenciesFinished
+}
+
+// DependenciesStatus is the state of the dependencies of a workload.
+type DependenciesStatus struct {
+	// Pending lists the dependencies which didn't finish yet, including the
+	// ones which don't exist.
+	Pending []string
+	// Failed lists the dependencies which finished with a failure, or were
+	// deactivated because of a failed dependency of their own.
+	Failed []string
+}
+
+// GetDependenciesStatus looks up the dependencies of the workload, in its
+// namespace.
+func GetDependenciesStatus(ctx context.Context, c client.Reader, wl *kueue.Workload) (DependenciesStatus, error) {
+	var status DependenciesStatus
+	for _, dep := range wl.Spec.DependsOn {
+		var depWl kueue.Workload
+		if err := c.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: dep.Name}, &depWl); err != nil {
+			if apierrors.IsNotFound(err) {
+				status.Pending = append(status.Pending, dep.Name)
+				continue
+			}
+			return status, err
+		}
+		finishedCond := apimeta.FindStatusCondition(depWl.Status.Conditions, kueue.WorkloadFinished)
+		switch {
+		case finishedCond != nil && finishedCond.Status == metav1.ConditionTrue:
+			if finishedCond.Reason != kueue.WorkloadFinishedReasonSucceeded {
+				status.Failed = append(status.Failed, dep.Name)
+			}
+		case !IsActive(&depWl) && HasFailedDependency(&depWl):
+			status.Failed = append(status.Failed, dep.Name)
+		default:
+			status.Pending = append(status.Pending, dep.Name)
+		}
+	}
+	return status, nil
+}
+
+// HasFailedDependency returns true if the workload was observed to have a
+// dependency which finished with a failure.
+func HasFailedDependency(wl *kueue.Workload) bool {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadWaitingForDependencies)
+	return cond != nil && cond.Reason == kueue.WorkloadDependencyFailed
+}
+
+// SyncWaitingForDependenciesCondition updates the WaitingForDependencies
+// condition of the workload according to the status of its dependencies.
+// It sets the DeactivationTarget condition if any dependency failed.
+// It returns true if the workload was updated.
+func SyncWaitingForDependenciesCondition(wl *kueue.Workload, status DependenciesStatus) bool {
+	switch {
+	case len(status.Failed) > 0:
+		message := fmt.Sprintf("The dependencies %s finished with a failure", strings.Join(status.Failed, ", "))
+		updated := setWaitingForDependenciesCondition(wl, metav1.ConditionFalse, kueue.WorkloadDependencyFailed, message)
+		if SetDeactivationTarget(wl, kueue.WorkloadDependencyFailed, message) {
+			updated = true
+		}
+		return updated
+	case len(status.Pending) > 0:
+		return setWaitingForDependenciesCondition(wl, metav1.ConditionTrue, kueue.WorkloadDependenciesPending,
+			fmt.Sprintf("Waiting for the dependencies %s to finish", strings.Join(status.Pending, ", ")))
+	default:
+		return setWaitingForDependenciesCondition(wl, metav1.ConditionFalse, kueue.WorkloadDependenciesFinished,
+			"All the dependencies finished successfully")
+	}
+}
+
+func setWaitingForDependenciesCondition(wl *kueue.Workload, status metav1.ConditionStatus, reason, message string) bool {
+	return apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadWaitingForDependencies,
+		Status:             status,
+		Reason:             reason,
+		Message:            api.TruncateConditionMessage(message),
+		ObservedGeneration: wl.Generation,
+	})
+}
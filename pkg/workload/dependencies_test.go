@@ -0,0 +1,267 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestIsWaitingForDependencies(t *testing.T) {
+	finishedCondition := metav1.Condition{
+		Type:               kueue.WorkloadWaitingForDependencies,
+		Status:             metav1.ConditionFalse,
+		Reason:             kueue.WorkloadDependenciesFinished,
+		ObservedGeneration: 1,
+	}
+
+	cases := map[string]struct {
+		disableWorkloadDependencies bool
+		workload                    *kueue.Workload
+		want                        bool
+	}{
+		"no dependencies": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").Obj(),
+		},
+		"feature disabled": {
+			disableWorkloadDependencies: true,
+			workload:                    utiltestingapi.MakeWorkload("wl", "ns").DependsOn("dep").Obj(),
+		},
+		"dependencies not evaluated": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("dep").Obj(),
+			want:     true,
+		},
+		"dependencies pending": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("dep").
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadWaitingForDependencies,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadDependenciesPending,
+				}).
+				Obj(),
+			want: true,
+		},
+		"dependencies finished": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Generation(1).
+				DependsOn("dep").
+				Condition(finishedCondition).
+				Obj(),
+		},
+		"dependencies changed after they finished": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				Generation(2).
+				DependsOn("dep", "other").
+				Condition(finishedCondition).
+				Obj(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadDependencies, !tc.disableWorkloadDependencies)
+			if got := IsWaitingForDependencies(tc.workload); got != tc.want {
+				t.Errorf("Unexpected IsWaitingForDependencies: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetDependenciesStatus(t *testing.T) {
+	finished := func(name, reason string) *kueue.Workload {
+		return utiltestingapi.MakeWorkload(name, "ns").
+			Condition(metav1.Condition{
+				Type:   kueue.WorkloadFinished,
+				Status: metav1.ConditionTrue,
+				Reason: reason,
+			}).
+			Obj()
+	}
+
+	cases := map[string]struct {
+		workloads []client.Object
+		workload  *kueue.Workload
+		want      DependenciesStatus
+	}{
+		"missing dependency": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("dep").Obj(),
+			want:     DependenciesStatus{Pending: []string{"dep"}},
+		},
+		"dependency in another namespace": {
+			workloads: []client.Object{
+				utiltestingapi.MakeWorkload("dep", "other").Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("dep").Obj(),
+			want:     DependenciesStatus{Pending: []string{"dep"}},
+		},
+		"running dependency": {
+			workloads: []client.Object{
+				utiltestingapi.MakeWorkload("dep", "ns").ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").Obj(), time.Now()).Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("dep").Obj(),
+			want:     DependenciesStatus{Pending: []string{"dep"}},
+		},
+		"succeeded and failed dependencies": {
+			workloads: []client.Object{
+				finished("preprocess", kueue.WorkloadFinishedReasonSucceeded),
+				finished("train", kueue.WorkloadFinishedReasonFailed),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("preprocess", "train").Obj(),
+			want:     DependenciesStatus{Failed: []string{"train"}},
+		},
+		"succeeded dependencies": {
+			workloads: []client.Object{
+				finished("preprocess", kueue.WorkloadFinishedReasonSucceeded),
+				finished("train", kueue.WorkloadFinishedReasonSucceeded),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("preprocess", "train").Obj(),
+		},
+		"dependency deactivated because of a failed dependency": {
+			workloads: []client.Object{
+				utiltestingapi.MakeWorkload("train", "ns").
+					Active(false).
+					DependsOn("preprocess").
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadWaitingForDependencies,
+						Status: metav1.ConditionFalse,
+						Reason: kueue.WorkloadDependencyFailed,
+					}).
+					Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("train").Obj(),
+			want:     DependenciesStatus{Failed: []string{"train"}},
+		},
+		"deactivated dependency": {
+			workloads: []client.Object{
+				utiltestingapi.MakeWorkload("train", "ns").Active(false).Obj(),
+			},
+			workload: utiltestingapi.MakeWorkload("wl", "ns").DependsOn("train").Obj(),
+			want:     DependenciesStatus{Pending: []string{"train"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithObjects(tc.workloads...).Build()
+			got, err := GetDependenciesStatus(ctx, cl, tc.workload)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncWaitingForDependenciesCondition(t *testing.T) {
+	cases := map[string]struct {
+		workload       *kueue.Workload
+		status         DependenciesStatus
+		wantChanged    bool
+		wantConditions []metav1.Condition
+	}{
+		"dependencies pending": {
+			workload:    utiltestingapi.MakeWorkload("wl", "ns").DependsOn("preprocess", "train").Obj(),
+			status:      DependenciesStatus{Pending: []string{"preprocess", "train"}},
+			wantChanged: true,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadWaitingForDependencies,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadDependenciesPending,
+				Message: "Waiting for the dependencies preprocess, train to finish",
+			}},
+		},
+		"dependencies still pending": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies preprocess to finish",
+				}).
+				Obj(),
+			status: DependenciesStatus{Pending: []string{"preprocess"}},
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadWaitingForDependencies,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadDependenciesPending,
+				Message: "Waiting for the dependencies preprocess to finish",
+			}},
+		},
+		"dependencies finished": {
+			workload: utiltestingapi.MakeWorkload("wl", "ns").
+				DependsOn("preprocess").
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependenciesPending,
+					Message: "Waiting for the dependencies preprocess to finish",
+				}).
+				Obj(),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.WorkloadWaitingForDependencies,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.WorkloadDependenciesFinished,
+				Message: "All the dependencies finished successfully",
+			}},
+		},
+		"dependency failed": {
+			workload:    utiltestingapi.MakeWorkload("wl", "ns").DependsOn("preprocess", "train").Obj(),
+			status:      DependenciesStatus{Pending: []string{"preprocess"}, Failed: []string{"train"}},
+			wantChanged: true,
+			wantConditions: []metav1.Condition{
+				{
+					Type:    kueue.WorkloadWaitingForDependencies,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadDependencyFailed,
+					Message: "The dependencies train finished with a failure",
+				},
+				{
+					Type:    kueue.WorkloadDeactivationTarget,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadDependencyFailed,
+					Message: "The dependencies train finished with a failure",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := SyncWaitingForDependenciesCondition(tc.workload, tc.status)
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed: got %v, want %v", changed, tc.wantChanged)
+			}
+			if diff := cmp.Diff(tc.wantConditions, tc.workload.Status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected conditions (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
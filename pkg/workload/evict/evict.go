@@ -209,6 +209,7 @@ func prepareForEviction(w *kueue.Workload, now time.Time, reason, message string
 	resetUnhealthyNodes(w)
 	unsetBlockedOnPreemptionGatesCondition(w, now, reason, message)
 	closeAllPreemptionGates(w, now)
+	apimeta.RemoveStatusCondition(&w.Status.Conditions, kueue.WorkloadPreemptionPending)
 }
 
 func resetClusterNomination(w *kueue.Workload) {
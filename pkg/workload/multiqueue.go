@@ -0,0 +1,94 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// PodSetQueueName returns the name of the LocalQueue that provides the quota
+// for the PodSet, falling back to the Workload's queueName.
+func PodSetQueueName(wl *kueue.Workload, ps *kueue.PodSet) kueue.LocalQueueName {
+	if ps.QueueName != nil && *ps.QueueName != "" {
+		return *ps.QueueName
+	}
+	return wl.Spec.QueueName
+}
+
+// IsMultiQueue reports whether some PodSet of the Workload is charged to a
+// LocalQueue other than the Workload's queueName.
+func IsMultiQueue(wl *kueue.Workload) bool {
+	for i := range wl.Spec.PodSets {
+		if PodSetQueueName(wl, &wl.Spec.PodSets[i]) != wl.Spec.QueueName {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignedClusterQueue returns the ClusterQueue charged for the PodSetAssignment.
+func AssignedClusterQueue(admission *kueue.Admission, psa *kueue.PodSetAssignment) kueue.ClusterQueueReference {
+	return ptr.Deref(psa.ClusterQueue, admission.ClusterQueue)
+}
+
+// SecondaryClusterQueues returns the ClusterQueues, other than the one in
+// the admission, that are charged for some PodSet of the Workload.
+func SecondaryClusterQueues(wl *kueue.Workload) sets.Set[kueue.ClusterQueueReference] {
+	if wl.Status.Admission == nil {
+		return nil
+	}
+	var result sets.Set[kueue.ClusterQueueReference]
+	for i := range wl.Status.Admission.PodSetAssignments {
+		cq := AssignedClusterQueue(wl.Status.Admission, &wl.Status.Admission.PodSetAssignments[i])
+		if cq == wl.Status.Admission.ClusterQueue {
+			continue
+		}
+		if result == nil {
+			result = sets.New[kueue.ClusterQueueReference]()
+		}
+		result.Insert(cq)
+	}
+	return result
+}
+
+// ForPodSets returns a copy of the Info, for the given ClusterQueue, that only
+// holds the named PodSets. The PodSets in the copied Workload and the
+// TotalRequests keep their relative order, so they can still be indexed
+// together.
+func (i *Info) ForPodSets(cq kueue.ClusterQueueReference, names sets.Set[kueue.PodSetReference]) *Info {
+	wl := i.Obj.DeepCopy()
+	wl.Spec.PodSets = nil
+	for _, ps := range i.Obj.Spec.PodSets {
+		if names.Has(ps.Name) {
+			wl.Spec.PodSets = append(wl.Spec.PodSets, *ps.DeepCopy())
+		}
+	}
+	result := *i
+	result.Obj = wl
+	result.ClusterQueue = cq
+	result.LastAssignment = nil
+	result.TotalRequests = nil
+	for _, psr := range i.TotalRequests {
+		if names.Has(psr.Name) {
+			result.TotalRequests = append(result.TotalRequests, psr)
+		}
+	}
+	return &result
+}
@@ -0,0 +1,117 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestIsMultiQueue(t *testing.T) {
+	cases := map[string]struct {
+		wl   *kueue.Workload
+		want bool
+	}{
+		"no podSet queueName": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").Queue("cpu").Obj(),
+		},
+		"podSet queueName equal to the workload's": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				Queue("cpu").
+				PodSets(*utiltestingapi.MakePodSet("driver", 1).QueueName("cpu").Obj()).
+				Obj(),
+		},
+		"podSet queueName different from the workload's": {
+			wl: utiltestingapi.MakeWorkload("wl", "ns").
+				Queue("cpu").
+				PodSets(
+					*utiltestingapi.MakePodSet("driver", 1).Obj(),
+					*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Obj(),
+				).
+				Obj(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsMultiQueue(tc.wl); got != tc.want {
+				t.Errorf("IsMultiQueue() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewInfoWithClusterQueue(t *testing.T) {
+	wl := utiltestingapi.MakeWorkload("wl", "ns").
+		Queue("cpu").
+		PodSets(
+			*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "2").Obj(),
+			*utiltestingapi.MakePodSet("workers", 4).QueueName("gpu").Request("example.com/gpu", "1").Obj(),
+		).
+		ReserveQuotaAt(utiltestingapi.MakeAdmission("cpu-cq").
+			PodSets(
+				utiltestingapi.MakePodSetAssignment("driver").
+					Assignment(corev1.ResourceCPU, "default", "2").
+					Obj(),
+				utiltestingapi.MakePodSetAssignment("workers").
+					Assignment("example.com/gpu", "gpu", "4").
+					Count(4).
+					ClusterQueue("gpu-cq").
+					Obj(),
+			).
+			Obj(), time.Now()).
+		Obj()
+	cases := map[string]struct {
+		opts             []InfoOption
+		wantClusterQueue kueue.ClusterQueueReference
+		wantPodSets      []kueue.PodSetReference
+	}{
+		"admission ClusterQueue": {
+			wantClusterQueue: "cpu-cq",
+			wantPodSets:      []kueue.PodSetReference{"driver"},
+		},
+		"secondary ClusterQueue": {
+			opts:             []InfoOption{WithClusterQueue("gpu-cq")},
+			wantClusterQueue: "gpu-cq",
+			wantPodSets:      []kueue.PodSetReference{"workers"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			info := NewInfo(wl, tc.opts...)
+			if info.ClusterQueue != tc.wantClusterQueue {
+				t.Errorf("Unexpected ClusterQueue, got %q, want %q", info.ClusterQueue, tc.wantClusterQueue)
+			}
+			var gotPodSets []kueue.PodSetReference
+			for _, psr := range info.TotalRequests {
+				gotPodSets = append(gotPodSets, psr.Name)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, gotPodSets); diff != "" {
+				t.Errorf("Unexpected PodSets in TotalRequests (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]kueue.ClusterQueueReference{"gpu-cq"}, SecondaryClusterQueues(wl).UnsortedList()); diff != "" {
+				t.Errorf("Unexpected secondary ClusterQueues (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
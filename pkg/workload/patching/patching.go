@@ -40,10 +40,13 @@ var (
 		kueue.WorkloadEvicted,
 		kueue.WorkloadAdmitted,
 		kueue.WorkloadPreempted,
+		kueue.WorkloadPreemptionPending,
 		kueue.WorkloadRequeued,
 		kueue.WorkloadDeactivationTarget,
 		kueue.WorkloadFinished,
 		kueue.WorkloadPodsReady,
+		kueue.WorkloadDeadlineUnattainable,
+		kueue.WorkloadWaitingForDependencies,
 	}
 )
 
@@ -135,6 +138,7 @@ func admissionStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload) {
 	wlCopy.Status.NominatedClusterNames = w.Status.NominatedClusterNames
 	wlCopy.Status.UnhealthyNodes = w.Status.UnhealthyNodes
 	wlCopy.Status.PreemptionGates = w.Status.PreemptionGates
+	wlCopy.Status.MigrationHistory = w.Status.MigrationHistory
 }
 
 func admissionChecksStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload, c clock.Clock) {
@@ -0,0 +1,98 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/api"
+)
+
+// PreemptionNoticeTimeout is how long the preemption notice of a workload is
+// kept after its deadline, for the pending workloads still targeting it to
+// evict it. Past it, the notice is withdrawn.
+const PreemptionNoticeTimeout = time.Minute
+
+// PreemptionGracePeriod returns the time the workload is given to checkpoint
+// before it's evicted by a preemption; zero if it's evicted immediately.
+func PreemptionGracePeriod(wl *kueue.Workload) time.Duration {
+	return time.Duration(ptr.Deref(wl.Spec.PreemptionGracePeriodSeconds, 0)) * time.Second
+}
+
+// PreemptionNoticeDeadline returns the time at which the workload, notified of
+// its preemption, is evicted unless it acknowledges the checkpoint earlier. It
+// returns false if the workload wasn't notified.
+func PreemptionNoticeDeadline(wl *kueue.Workload) (time.Time, bool) {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreemptionPending)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return time.Time{}, false
+	}
+	return cond.LastTransitionTime.Add(PreemptionGracePeriod(wl)), true
+}
+
+// PreemptionNoticeExpiry returns the time at which the preemption notice of
+// the workload is withdrawn, unless the workload is evicted earlier. It
+// returns false if the workload wasn't notified.
+func PreemptionNoticeExpiry(wl *kueue.Workload) (time.Time, bool) {
+	deadline, notified := PreemptionNoticeDeadline(wl)
+	if !notified {
+		return time.Time{}, false
+	}
+	return deadline.Add(PreemptionNoticeTimeout), true
+}
+
+// PreemptionNoticeWithdrawn returns whether the preemption notice of the
+// workload expired without the workload being evicted.
+func PreemptionNoticeWithdrawn(wl *kueue.Workload) bool {
+	return apimeta.IsStatusConditionFalse(wl.Status.Conditions, kueue.WorkloadPreemptionPending)
+}
+
+// CheckpointAcknowledged returns whether the workload acknowledged the
+// checkpoint requested by its preemption notice.
+func CheckpointAcknowledged(wl *kueue.Workload) bool {
+	return wl.Annotations[controllerconstants.CheckpointAcknowledgedAnnotation] == "true"
+}
+
+// SetPreemptionPendingCondition notifies the workload of its upcoming
+// preemption.
+func SetPreemptionPendingCondition(wl *kueue.Workload, now time.Time, reason, message string) bool {
+	return apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadPreemptionPending,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             reason,
+		Message:            api.TruncateConditionMessage(message),
+	})
+}
+
+// WithdrawPreemptionNotice sets the PreemptionPending condition of the
+// workload to false once its preemption notice expired.
+func WithdrawPreemptionNotice(wl *kueue.Workload, now time.Time) bool {
+	return apimeta.SetStatusCondition(&wl.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadPreemptionPending,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             kueue.WorkloadPreemptionNoticeExpired,
+		Message:            "No pending workload evicted the workload within the grace period",
+	})
+}
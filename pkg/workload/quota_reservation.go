@@ -0,0 +1,34 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// QuotaReservationName returns the name of the QuotaReservation declared in
+// the quota-reservation annotation of the workload. It returns an empty
+// string if the annotation is missing or the QuotaReservations feature is
+// disabled.
+func QuotaReservationName(wl *kueue.Workload) string {
+	if wl == nil || !features.Enabled(features.QuotaReservations) {
+		return ""
+	}
+	return wl.Annotations[controllerconstants.QuotaReservationAnnotationKey]
+}
@@ -135,6 +135,7 @@ type InfoOptions struct {
 	excludedResourcePrefixes []string
 	resourceTransformations  map[corev1.ResourceName]*config.ResourceTransformation
 	preserveTotalRequests    bool
+	clusterQueue             kueue.ClusterQueueReference
 	dra
 }
 
@@ -165,6 +166,15 @@ func WithPreserveTotalRequests() InfoOption {
 	}
 }
 
+// WithClusterQueue restricts the Info of an admitted Workload to the PodSets
+// charged to the given ClusterQueue. Used by the cache to account the share of
+// a multi-queue Workload in each of its ClusterQueues.
+func WithClusterQueue(cq kueue.ClusterQueueReference) InfoOption {
+	return func(o *InfoOptions) {
+		o.clusterQueue = cq
+	}
+}
+
 // WithPreprocessedDRAResources provides DRA resources to add and extended resources to remove.
 func WithPreprocessedDRAResources(
 	draResources map[kueue.PodSetReference]corev1.ResourceList,
@@ -255,6 +265,17 @@ type Info struct {
 	// AdmissionFairSharing feature, it is only populated for Infos in cache.Snapshot (not in queue manager).
 	LocalQueueFSUsage *float64
 
+	// Deadline is the deadline of the workload, or nil if it has none. It's
+	// parsed once from the annotation, which is only set when the Workload is
+	// created, so that ordering by deadline doesn't parse it on every
+	// comparison.
+	Deadline *time.Time
+
+	// PriorityDecay is the priorityDecay policy of the ClusterQueue, lowering
+	// the effective priority of the workload as it runs. It is only populated
+	// for Infos in cache.Snapshot.
+	PriorityDecay *kueue.PriorityDecay
+
 	// SecondPassIteration indicates the current iteration of the second pass scheduling.
 	SecondPassIteration int
 
@@ -358,6 +379,10 @@ func (i *Info) Update(log logr.Logger, wl *kueue.Workload, opts ...InfoOption) {
 	i.Obj = wl
 	i.rebuildTotalRequests(opts...)
 	i.UpdateSchedulingHash(log)
+	i.Deadline = nil
+	if deadline, found := Deadline(wl); found {
+		i.Deadline = &deadline
+	}
 }
 
 // rebuildTotalRequests refreshes ClusterQueue and recomputes TotalRequests
@@ -371,12 +396,15 @@ func (i *Info) rebuildTotalRequests(opts ...InfoOption) {
 	admitted := i.Obj.Status.Admission != nil
 	if admitted {
 		i.ClusterQueue = i.Obj.Status.Admission.ClusterQueue
+		if options.clusterQueue != "" {
+			i.ClusterQueue = options.clusterQueue
+		}
 	} else {
 		i.ClusterQueue = ""
 	}
 	if !options.preserveTotalRequests {
 		if admitted {
-			i.TotalRequests = totalRequestsFromAdmission(i.Obj)
+			i.TotalRequests = totalRequestsFromAdmission(i.Obj, i.ClusterQueue)
 		} else {
 			i.TotalRequests = totalRequestsFromPodSets(i.Obj, &options)
 		}
@@ -721,7 +749,7 @@ func totalRequestsFromPodSets(wl *kueue.Workload, info *InfoOptions) []PodSetRes
 	return res
 }
 
-func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
+func totalRequestsFromAdmission(wl *kueue.Workload, cq kueue.ClusterQueueReference) []PodSetResources {
 	if wl.Status.Admission == nil {
 		return nil
 	}
@@ -729,6 +757,9 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 	currentCounts := podSetsCountsAfterReclaim(wl)
 	totalCounts := podSetsCounts(wl)
 	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if AssignedClusterQueue(wl.Status.Admission, &psa) != cq {
+			continue
+		}
 		setRes := PodSetResources{
 			Name:     psa.Name,
 			Flavors:  psa.Flavors,
@@ -1282,7 +1313,7 @@ func IsActive(w *kueue.Workload) bool {
 // IsAdmissible returns true if the workload can be added to the queue.
 func IsAdmissible(w *kueue.Workload) bool {
 	return !HasAdmissionGate(w) && !workloadfinish.IsFinished(w) && IsActive(w) && !HasQuotaReservation(w) && !IsOnHold(w) &&
-		!apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadWaitingForReplacementPods)
+		!apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadWaitingForReplacementPods) && !IsWaitingForDependencies(w)
 }
 
 // HasAdmissionGate returns true if the workload has an admission gate annotation and the AdmissionGatedBy feature is on
@@ -110,7 +110,7 @@ var _ = ginkgo.Describe("Importer", func() {
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 				gomega.Expect(mapping).ToNot(gomega.BeNil())
 
-				gomega.Expect(importerpod.Check(ctx, k8sClient, mapping, 8)).To(gomega.Succeed())
+				gomega.Expect(importerpod.Check(ctx, k8sClient, mapping, nil, 8)).To(gomega.Succeed())
 				gomega.Expect(importerpod.Import(ctx, k8sClient, mapping, 8)).To(gomega.Succeed())
 			})
 